@@ -5,60 +5,190 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"runtime"
+	"strings"
+	"time"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/commands"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/events"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/metrics"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/tui"
 	"github.com/spf13/cobra"
 )
 
+// Build info (set via -ldflags "-X main.version=... -X main.commit=... -X main.buildDate=...")
+var (
+	version   = "dev"
+	commit    = "unknown"
+	buildDate = "unknown"
+)
+
 var (
 	// Global flags
-	backendURL        string
-	authMode          string
-	eventHandlerURL   string
-	userID            string
-	namespace         string
-	email             string
-	password          string
-	clientID          string
-	clientSecret      string
-	iamURL            string
-	platformURL       string
-	format            string
-	adminClientID     string
-	adminClientSecret string
+	backendURL         string
+	mockBackend        bool
+	authMode           string
+	eventHandlerURL    string
+	userID             string
+	namespace          string
+	email              string
+	password           string
+	mfaToken           string
+	accessToken        string
+	clientID           string
+	clientSecret       string
+	iamURL             string
+	platformURL        string
+	format             string
+	adminClientID      string
+	adminClientSecret  string
+	refreshInterval    time.Duration
+	tokenRefreshBuffer time.Duration
+	refreshOnEvent     bool
+	maxStatValue       int
+	switchUsers        []string
+	asciiMode          bool
+	auditLogPath       string
+	profileMode        string
+	profileDir         string
+	configPath         string
+	outputPath         string
+	templateText       string
+	templateFile       string
+	outputWidth        int
+	quiet              bool
+	passwordStdin      bool
+	clientSecretStdin  bool
+	verbosity          int
+
+	// Audit logger (set when --audit-log is provided)
+	auditLog *cli.AuditLogger
+
+	// Profiler (set when --profile is provided)
+	profiler *cli.Profiler
+
+	// Metrics server (set when --metrics-port is provided)
+	metricsServer *metrics.Server
 )
 
 func main() {
 	rootCmd := &cobra.Command{
-		Use:   "challenge-demo",
-		Short: "Challenge Service Demo CLI",
-		Long:  "Interactive TUI and CLI tool for testing AccelByte Challenge Service.",
+		Use:     "challenge-demo",
+		Short:   "Challenge Service Demo CLI",
+		Long:    "Interactive TUI and CLI tool for testing AccelByte Challenge Service.",
+		Version: version,
+		// Load the config file, select glyph set and start auditing before any command runs
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Fill global flags not given on the command line from the config file
+			configErr := cli.LoadAndApplyConfig(cmd.Root().PersistentFlags(), configPath)
+
+			// Shell completion requests must not be audited, profiled, or fail on a bad config
+			if cmd.Name() == cobra.ShellCompRequestCmd || cmd.Name() == cobra.ShellCompNoDescRequestCmd {
+				return nil
+			}
+			if configErr != nil {
+				return configErr
+			}
+
+			// Set the log level before the container logs its setup
+			if err := cli.ApplyLogFlags(cmd.Root().PersistentFlags(), os.Stderr); err != nil {
+				return err
+			}
+
+			// --mock is shorthand for --backend-url mock (the bound variable is read as the flag value)
+			if mockBackend {
+				backendURL = app.MockBackendURL
+			}
+
+			// Secrets from stdin or the environment override the config file
+			if err := cli.ResolveSecrets(cmd.Root().PersistentFlags(), os.Stdin, os.Getenv, os.Stderr); err != nil {
+				return err
+			}
+
+			// Report missing required flags as usage errors (exit code 2)
+			if err := cli.ValidateFlags(cmd); err != nil {
+				return err
+			}
+			if quiet {
+				cmd.SilenceUsage = true
+			}
+			if asciiMode {
+				glyph.Use(glyph.ASCII)
+			}
+			if err := cli.ApplyTemplateFlags(cmd.Root().PersistentFlags()); err != nil {
+				return err
+			}
+			if err := cli.ValidateCommandFormat(cmd); err != nil {
+				return err
+			}
+			if err := cli.ValidateNamespaceFlag(cmd.Root().PersistentFlags()); err != nil {
+				return err
+			}
+			if err := cli.ValidateRequestIDFlag(cmd.Root().PersistentFlags()); err != nil {
+				return err
+			}
+			if err := cli.ValidateCacheTTLFlag(cmd.Root().PersistentFlags()); err != nil {
+				return err
+			}
+			if err := cli.ApplyOutputWidthFlag(cmd.Root().PersistentFlags()); err != nil {
+				return err
+			}
+			if err := cli.ApplyProgressBarWidthFlag(cmd.Root().PersistentFlags()); err != nil {
+				return err
+			}
+			cli.ApplyColorFlags(cmd.Root().PersistentFlags(), os.Getenv)
+			if auditLogPath != "" {
+				auditLog = cli.NewAuditLogger(auditLogPath)
+				auditLog.Begin(cmd, args)
+			}
+			if profileMode != "" {
+				p, err := cli.StartProfiler(profileMode, profileDir)
+				if err != nil {
+					return err
+				}
+				profiler = p
+			}
+			server, err := cli.StartMetricsServer(cmd.Root().PersistentFlags())
+			if err != nil {
+				return err
+			}
+			metricsServer = server
+			return nil
+		},
+		// Finish successful invocations (failures are finished after Execute returns)
+		PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+			return finishRun(nil)
+		},
 		// If no subcommand, launch TUI (default behavior)
 		Run: func(cmd *cobra.Command, args []string) {
 			// Create dependency container
-			container := app.NewContainer(
-				backendURL,
-				authMode,
-				eventHandlerURL,
-				userID,
-				namespace,
-				email,
-				password,
-				clientID,
-				clientSecret,
-				iamURL,
-				platformURL,
-				adminClientID,
-				adminClientSecret,
-			)
+			container := app.NewContainer(cli.ContainerConfigFromFlags(cmd.Flags()))
+			cli.ConfigureAPIClient(cmd.Flags(), container)
+			cli.CacheAPIClient(cmd.Flags(), container)
 
 			// Create and run TUI application
 			application := tui.NewApp(container)
-			if err := application.Run(); err != nil {
+			application.SetRefreshInterval(refreshInterval)
+			application.SetRefreshOnEvent(refreshOnEvent)
+			application.SetMaxStatValue(maxStatValue)
+			application.SetUsers(switchUsers)
+			application.SetWidth(outputWidth)
+
+			// Log lines written to stderr would corrupt the alt-screen; show them after exit
+			logBuffer := cli.BufferLogsForTUI(cmd.Flags())
+			err := application.Run()
+			if logBuffer != nil {
+				_ = logBuffer.Flush(os.Stderr)
+			}
+			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
 			}
@@ -66,27 +196,80 @@ func main() {
 	}
 
 	// Global flags (available to all commands)
-	rootCmd.PersistentFlags().StringVar(&backendURL, "backend-url", "http://localhost:8000/challenge", "Challenge service backend URL (gRPC Gateway)")
-	rootCmd.PersistentFlags().StringVar(&authMode, "auth-mode", "mock", "Authentication mode (mock|password|client)")
+	rootCmd.PersistentFlags().StringVar(&configPath, cli.ConfigFlag, "", "YAML file of global flag defaults (default ~/.challenge-demo/config.yaml if present)")
+	rootCmd.PersistentFlags().StringVar(&backendURL, "backend-url", "http://localhost:8000/challenge", "Challenge service backend URL (gRPC Gateway), or \"mock\" for an offline in-memory backend")
+	rootCmd.PersistentFlags().BoolVar(&mockBackend, "mock", false, "Run offline against an in-memory backend with demo challenges (same as --backend-url mock)")
+	rootCmd.PersistentFlags().StringVar(&authMode, "auth-mode", "mock", "Authentication mode (mock|password|client|token)")
 	rootCmd.PersistentFlags().StringVar(&eventHandlerURL, "event-handler-url", "localhost:6566", "Event handler gRPC address (for event simulation)")
+	rootCmd.PersistentFlags().Bool(cli.EventHandlerTLSFlag, false, "Connect to the event handler over TLS (plaintext by default)")
+	rootCmd.PersistentFlags().String(cli.EventHandlerCAFlag, "", "PEM CA bundle to verify the event handler certificate (implies --event-handler-tls; default system roots)")
+	rootCmd.PersistentFlags().String(cli.EventHandlerServerNameFlag, "", "Override the server name verified against the event handler certificate (implies --event-handler-tls)")
+	rootCmd.PersistentFlags().Duration(cli.EventHandlerTimeoutFlag, events.DefaultDialTimeout, "How long to wait for the event handler connection")
 	rootCmd.PersistentFlags().StringVar(&userID, "user-id", "test-user-123", "User ID for mock mode")
-	rootCmd.PersistentFlags().StringVar(&namespace, "namespace", "test", "AccelByte namespace")
+	rootCmd.PersistentFlags().StringVar(&namespace, cli.NamespaceFlag, "test", "AccelByte namespace (lowercase letters, digits, and hyphens); may follow the subcommand to override the config file")
 	rootCmd.PersistentFlags().StringVar(&email, "email", "", "User email for password mode")
-	rootCmd.PersistentFlags().StringVar(&password, "password", "", "User password for password mode")
+	rootCmd.PersistentFlags().StringVar(&password, "password", "", "User password for password mode (prefer --password-stdin or $CHALLENGE_PASSWORD)")
+	rootCmd.PersistentFlags().StringVar(&mfaToken, cli.MFATokenFlag, "", "MFA code for password mode, for accounts with two-factor login")
+	rootCmd.PersistentFlags().BoolVar(&passwordStdin, "password-stdin", false, "Read the user password from the first line of stdin")
+	rootCmd.PersistentFlags().StringVar(&accessToken, cli.AccessTokenFlag, "", "User access token (JWT) for token mode, e.g. minted by an earlier CI step (prefer $CHALLENGE_ACCESS_TOKEN)")
 	rootCmd.PersistentFlags().StringVar(&clientID, "client-id", "", "OAuth2 client ID (for password or client mode)")
-	rootCmd.PersistentFlags().StringVar(&clientSecret, "client-secret", "", "OAuth2 client secret (for password or client mode)")
+	rootCmd.PersistentFlags().StringVar(&clientSecret, "client-secret", "", "OAuth2 client secret (for password or client mode; prefer --client-secret-stdin or $CHALLENGE_CLIENT_SECRET)")
+	rootCmd.PersistentFlags().BoolVar(&clientSecretStdin, "client-secret-stdin", false, "Read the OAuth2 client secret from the first line of stdin")
 	rootCmd.PersistentFlags().StringVar(&iamURL, "iam-url", "https://demo.accelbyte.io/iam", "AGS IAM URL (for password or client mode)")
 	rootCmd.PersistentFlags().StringVar(&platformURL, "platform-url", "https://demo.accelbyte.io/platform", "AGS Platform URL (for reward verification)")
 	rootCmd.PersistentFlags().StringVar(&adminClientID, "admin-client-id", "", "Admin OAuth2 client ID (optional - for AGS Platform verification)")
 	rootCmd.PersistentFlags().StringVar(&adminClientSecret, "admin-client-secret", "", "Admin OAuth2 client secret (optional - for AGS Platform verification)")
-	rootCmd.PersistentFlags().StringVar(&format, "format", "json", "Output format (json|table|text)")
+	rootCmd.PersistentFlags().DurationVar(&tokenRefreshBuffer, cli.TokenRefreshBufferFlag, auth.DefaultRefreshBuffer, "Refresh the access token in the background once it expires within this (lower it for short-lived tokens)")
+	rootCmd.PersistentFlags().StringVar(&format, cli.FormatFlag, "json", "Output format ("+strings.Join(output.FormatterNames(), "|")+"|"+output.TemplateFormat+" with --template)")
+	rootCmd.PersistentFlags().StringVarP(&outputPath, cli.OutputFlag, "o", "", "Write the command result to this file instead of stdout (watch commands still stream to stdout)")
+	rootCmd.PersistentFlags().StringVar(&templateText, cli.TemplateFlag, "", "Render results through this Go template instead of --format")
+	rootCmd.PersistentFlags().StringVar(&templateFile, cli.TemplateFileFlag, "", "Render results through the Go template in this file instead of --format")
+	rootCmd.PersistentFlags().IntVar(&outputWidth, cli.OutputWidthFlag, 0, "Wrap text/table output and the TUI at this width instead of the detected terminal width")
+	rootCmd.PersistentFlags().Int(cli.ProgressBarWidthFlag, output.DefaultGoalProgressWidth, "Width of goal progress bars in text/table output (0 shows just the count)")
+	rootCmd.PersistentFlags().Bool(cli.NoColorFlag, false, "Don't color statuses in table output (also $NO_COLOR; piped output is never colored)")
+	rootCmd.PersistentFlags().CountVarP(&verbosity, cli.VerbosityFlag, "v", "Increase log detail on stderr: -v info, -vv debug and request timing, -vvv request/response bodies")
+	rootCmd.PersistentFlags().String(cli.LogLevelFlag, "", "Log level ("+strings.Join(cli.LogLevelNames, "|")+"); overrides -v (default warn)")
+	rootCmd.PersistentFlags().String(cli.LogFileFlag, "", "Append logs to this file instead of stderr (in the TUI, logs are otherwise shown after it exits)")
+	rootCmd.PersistentFlags().Bool(cli.VerboseFlag, false, "Print each API request and response (method, URL, status, timing, redacted headers) to stderr")
+	rootCmd.PersistentFlags().Bool(cli.DumpCurlFlag, false, "Print each API request to stderr as an equivalent curl command (Authorization redacted)")
+	rootCmd.PersistentFlags().Bool(cli.DumpCurlTokenFlag, false, "With --dump-curl, include the real bearer token so the command can be run as-is")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, cli.QuietFlag, "q", false, "Suppress result output; only the exit code reports success (errors still go to stderr)")
+	rootCmd.PersistentFlags().StringVar(&auditLogPath, "audit-log", "", "Append an audit entry for each command invocation to this file")
+	rootCmd.PersistentFlags().StringVar(&profileMode, "profile", "", "Capture pprof profiles while the command runs (cpu|heap|both)")
+	rootCmd.PersistentFlags().StringVar(&profileDir, "profile-dir", "profiles", "Directory for --profile output files")
+	rootCmd.PersistentFlags().String(cli.RequestIDFlag, "", "Send this X-Request-ID with every Challenge Service request instead of a random one per request")
+	rootCmd.PersistentFlags().Duration(cli.CacheTTLFlag, 0, "Reuse Challenge Service read results for this long, e.g. 5s (0 = off); claims and goal selection clear the cache")
+	rootCmd.PersistentFlags().Int(cli.MetricsPortFlag, 0, "Serve Prometheus metrics of Challenge Service requests at :PORT/metrics while running (0 = off; useful with the TUI and watch)")
+	rootCmd.PersistentFlags().BoolVar(&asciiMode, "ascii", false, "Use ASCII instead of Unicode glyphs (auto-enabled for non-UTF-8 locales)")
+
+	// --version prints the same build info as the version command
+	rootCmd.SetVersionTemplate(fmt.Sprintf("challenge-demo %s (commit %s, built %s)\n", version, commit, buildDate))
+
+	// TUI flags (root command launches the TUI by default)
+	rootCmd.Flags().DurationVar(&refreshInterval, "refresh-interval", 0, "Dashboard auto-refresh interval (0 = off, toggle with 'f')")
+	rootCmd.Flags().BoolVar(&refreshOnEvent, "refresh-on-event", false, "Reload dashboard challenges after each successful event simulator trigger")
+	rootCmd.Flags().IntVar(&maxStatValue, "max-stat-value", tui.DefaultMaxStatValue, "Largest stat value (or increment) the event simulator will send")
+	rootCmd.Flags().StringSliceVar(&switchUsers, "users", nil, "Other user IDs to switch to with 'u' in the TUI (mock auth only)")
 
 	// Add subcommands
 	rootCmd.AddCommand(commands.NewListCommand())
 	rootCmd.AddCommand(commands.NewGetCommand())
 	rootCmd.AddCommand(commands.NewTriggerCommand())
 	rootCmd.AddCommand(commands.NewClaimCommand())
+	rootCmd.AddCommand(commands.NewClaimBatchCommand())
+	rootCmd.AddCommand(commands.NewClaimAllCommand())
+	rootCmd.AddCommand(commands.NewClaimHistoryCommand())
+	rootCmd.AddCommand(commands.NewStatusCommand())
+	rootCmd.AddCommand(commands.NewSummaryCommand())
+	rootCmd.AddCommand(commands.NewGraphCommand())
+	rootCmd.AddCommand(commands.NewWhoAmICommand())
+	rootCmd.AddCommand(commands.NewLoginCommand())
+	rootCmd.AddCommand(commands.NewSeedCommand())
+	rootCmd.AddCommand(commands.NewRunScenarioCommand())
 	rootCmd.AddCommand(commands.NewWatchCommand())
+	rootCmd.AddCommand(commands.NewVersionCommand(buildInfo()))
+	rootCmd.AddCommand(commands.NewCompletionCommand())
+	rootCmd.CompletionOptions.DisableDefaultCmd = true // Replaced by the completion command above
 
 	// M3: Add goal assignment commands
 	rootCmd.AddCommand(commands.NewInitializeCommand())
@@ -102,8 +285,12 @@ func main() {
 	// Add reward verification commands
 	rootCmd.AddCommand(commands.NewVerifyEntitlementCommand())
 	rootCmd.AddCommand(commands.NewVerifyWalletCommand())
+	rootCmd.AddCommand(commands.NewVerifyRewardCommand())
 	rootCmd.AddCommand(commands.NewListInventoryCommand())
 	rootCmd.AddCommand(commands.NewListWalletsCommand())
+	rootCmd.AddCommand(commands.NewWalletHistoryCommand())
+	rootCmd.AddCommand(commands.NewWatchInventoryCommand())
+	rootCmd.AddCommand(commands.NewListNamespacesCommand())
 
 	// Add explicit TUI command (optional, since it's the default)
 	tuiCmd := &cobra.Command{
@@ -112,33 +299,80 @@ func main() {
 		Long:  "Launch the interactive terminal user interface for the Challenge Service demo app.",
 		Run: func(cmd *cobra.Command, args []string) {
 			// Same as root command - launch TUI
-			container := app.NewContainer(
-				backendURL,
-				authMode,
-				eventHandlerURL,
-				userID,
-				namespace,
-				email,
-				password,
-				clientID,
-				clientSecret,
-				iamURL,
-				platformURL,
-				adminClientID,
-				adminClientSecret,
-			)
+			container := app.NewContainer(cli.ContainerConfigFromFlags(cmd.Flags()))
+			cli.ConfigureAPIClient(cmd.Flags(), container)
+			cli.CacheAPIClient(cmd.Flags(), container)
 
 			application := tui.NewApp(container)
-			if err := application.Run(); err != nil {
+			application.SetRefreshInterval(refreshInterval)
+			application.SetRefreshOnEvent(refreshOnEvent)
+			application.SetMaxStatValue(maxStatValue)
+			application.SetUsers(switchUsers)
+			application.SetWidth(outputWidth)
+
+			// Log lines written to stderr would corrupt the alt-screen; show them after exit
+			logBuffer := cli.BufferLogsForTUI(cmd.Flags())
+			err := application.Run()
+			if logBuffer != nil {
+				_ = logBuffer.Flush(os.Stderr)
+			}
+			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
 			}
 		},
 	}
+	tuiCmd.Flags().DurationVar(&refreshInterval, "refresh-interval", 0, "Dashboard auto-refresh interval (0 = off, toggle with 'f')")
+	tuiCmd.Flags().BoolVar(&refreshOnEvent, "refresh-on-event", false, "Reload dashboard challenges after each successful event simulator trigger")
+	tuiCmd.Flags().IntVar(&maxStatValue, "max-stat-value", tui.DefaultMaxStatValue, "Largest stat value (or increment) the event simulator will send")
+	tuiCmd.Flags().StringSliceVar(&switchUsers, "users", nil, "Other user IDs to switch to with 'u' in the TUI (mock auth only)")
 	rootCmd.AddCommand(tuiCmd)
 
+	// Invalid flags and arguments exit with ExitUsageError
+	rootCmd.Args = cobra.NoArgs
+	cli.MarkUsageErrors(rootCmd)
+
 	// Execute
 	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+		cli.PrintRequestID(os.Stderr, err)
+		if finishErr := finishRun(err); finishErr != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", finishErr)
+		}
+		os.Exit(cli.ExitCode(err))
+	}
+}
+
+// buildInfo returns the version information injected at build time
+func buildInfo() output.VersionInfo {
+	return output.VersionInfo{
+		Version:   version,
+		Commit:    commit,
+		BuildDate: buildDate,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+}
+
+// finishRun stops the profiler and metrics server and writes the audit entry (all no-ops if already done)
+func finishRun(cmdErr error) error {
+	if metricsServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = metricsServer.Close(ctx)
+		metricsServer = nil
+	}
+
+	if profiler != nil {
+		if err := profiler.Stop(); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Profiles written: %v\n", profiler.Files())
+		profiler = nil
 	}
+
+	if auditLog != nil {
+		return auditLog.End(cmdErr)
+	}
+
+	return nil
 }
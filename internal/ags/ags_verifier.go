@@ -7,11 +7,16 @@ package ags
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/entitlement"
 	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/wallet"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
 )
 
 // AGSRewardVerifier implements RewardVerifier using AccelByte Platform SDK
@@ -19,9 +24,37 @@ type AGSRewardVerifier struct {
 	entitlementSvc    *platform.EntitlementService
 	walletSvc         *platform.WalletService
 	userID            string
+	userMu            sync.RWMutex // Protects userID; the TUI switches users while requests run
 	namespace         string
 	maxRetries        int
 	initialRetryDelay time.Duration
+
+	rng   *rand.Rand // Jitter source (not safe for concurrent use, guarded by rngMu)
+	rngMu sync.Mutex
+}
+
+// VerifierOption configures an AGSRewardVerifier
+type VerifierOption func(*AGSRewardVerifier)
+
+// WithMaxRetries sets the number of retries after the first attempt
+func WithMaxRetries(maxRetries int) VerifierOption {
+	return func(v *AGSRewardVerifier) {
+		v.maxRetries = maxRetries
+	}
+}
+
+// WithInitialRetryDelay sets the backoff ceiling for the first retry
+func WithInitialRetryDelay(delay time.Duration) VerifierOption {
+	return func(v *AGSRewardVerifier) {
+		v.initialRetryDelay = delay
+	}
+}
+
+// WithRandSource sets the jitter source (use a fixed seed for deterministic tests)
+func WithRandSource(src rand.Source) VerifierOption {
+	return func(v *AGSRewardVerifier) {
+		v.rng = rand.New(src)
+	}
 }
 
 // NewAGSRewardVerifier creates a new AGS reward verifier
@@ -30,13 +63,15 @@ type AGSRewardVerifier struct {
 //   - walletSvc: Platform SDK wallet service (pre-configured with auth)
 //   - userID: User ID to query rewards for
 //   - namespace: AGS namespace
+//   - opts: Optional retry settings (defaults: 3 retries, 500ms initial delay)
 func NewAGSRewardVerifier(
 	entitlementSvc *platform.EntitlementService,
 	walletSvc *platform.WalletService,
 	userID string,
 	namespace string,
+	opts ...VerifierOption,
 ) *AGSRewardVerifier {
-	return &AGSRewardVerifier{
+	v := &AGSRewardVerifier{
 		entitlementSvc:    entitlementSvc,
 		walletSvc:         walletSvc,
 		userID:            userID,
@@ -44,64 +79,153 @@ func NewAGSRewardVerifier(
 		maxRetries:        3,
 		initialRetryDelay: 500 * time.Millisecond,
 	}
-}
 
-// GetUserEntitlement retrieves a single entitlement by item ID
-func (v *AGSRewardVerifier) GetUserEntitlement(itemID string) (*Entitlement, error) {
-	return v.getUserEntitlementWithRetry(itemID)
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	if v.rng == nil {
+		v.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return v
 }
 
-// QueryUserEntitlements retrieves all entitlements for the user
-func (v *AGSRewardVerifier) QueryUserEntitlements(filters map[string]string) ([]*Entitlement, error) {
-	return v.queryUserEntitlementsWithRetry(filters)
+// SetUserID makes later lookups query rewards of another user
+func (v *AGSRewardVerifier) SetUserID(userID string) {
+	v.userMu.Lock()
+	defer v.userMu.Unlock()
+	v.userID = userID
 }
 
-// GetUserWallet retrieves a single wallet by currency code
-func (v *AGSRewardVerifier) GetUserWallet(currencyCode string) (*Wallet, error) {
-	return v.getUserWalletWithRetry(currencyCode)
+// currentUserID returns the user whose rewards are queried
+func (v *AGSRewardVerifier) currentUserID() string {
+	v.userMu.RLock()
+	defer v.userMu.RUnlock()
+	return v.userID
 }
 
-// QueryUserWallets retrieves all wallets for the user
-func (v *AGSRewardVerifier) QueryUserWallets() ([]*Wallet, error) {
-	return v.queryUserWalletsWithRetry()
+// backoffDelay returns the sleep before the given retry attempt (1-based)
+// Uses full jitter: a random duration in [0, initialRetryDelay * 2^(attempt-1)],
+// so parallel verifications don't retry against Platform in lockstep.
+func (v *AGSRewardVerifier) backoffDelay(attempt int) time.Duration {
+	ceiling := v.initialRetryDelay << (attempt - 1)
+	if ceiling <= 0 {
+		return 0
+	}
+
+	v.rngMu.Lock()
+	defer v.rngMu.Unlock()
+
+	return time.Duration(v.rng.Int63n(int64(ceiling) + 1))
 }
 
-// getUserEntitlementWithRetry implements retry logic for GetUserEntitlement
-func (v *AGSRewardVerifier) getUserEntitlementWithRetry(itemID string) (*Entitlement, error) {
-	var lastErr error
-	retryDelay := v.initialRetryDelay
+// withRetry runs call, retrying transient failures with exponential backoff and jitter
+// ctx bounds the whole loop: no attempt starts once it is done, a backoff is cut short when
+// it is cancelled (e.g. Ctrl+C), and a backoff that would outlast its deadline is skipped,
+// since the retry could never finish in time.
+func withRetry[T any](ctx context.Context, v *AGSRewardVerifier, call func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
 
+	var lastErr error
 	for attempt := 0; attempt <= v.maxRetries; attempt++ {
 		if attempt > 0 {
-			time.Sleep(retryDelay)
-			retryDelay *= 2 // Exponential backoff
+			delay := v.backoffDelay(attempt)
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+				return zero, fmt.Errorf("failed after %d attempts (deadline reached): %w", attempt, lastErr)
+			}
+			if err := waitForRetry(ctx, delay); err != nil {
+				return zero, fmt.Errorf("failed after %d attempts (%w): %w", attempt, err, lastErr)
+			}
 		}
 
-		ent, err := v.doGetUserEntitlement(itemID)
+		result, err := call(ctx)
 		if err == nil {
-			return ent, nil
+			return result, nil
 		}
 
-		// Check if error is retryable
-		if !isRetryable(err) {
-			return nil, err
+		// A cancelled or expired ctx fails every later attempt too
+		if !isRetryable(err) || ctx.Err() != nil {
+			return zero, err
 		}
 
 		lastErr = err
 	}
 
-	return nil, fmt.Errorf("failed after %d retries: %w", v.maxRetries, lastErr)
+	return zero, fmt.Errorf("failed after %d retries: %w", v.maxRetries, lastErr)
+}
+
+// waitForRetry sleeps for delay, returning ctx's error as soon as ctx is done
+func waitForRetry(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// GetUserEntitlement retrieves a single entitlement by item ID
+func (v *AGSRewardVerifier) GetUserEntitlement(ctx context.Context, itemID string) (*Entitlement, error) {
+	return withRetry(ctx, v, func(ctx context.Context) (*Entitlement, error) {
+		return v.doGetUserEntitlement(ctx, itemID)
+	})
+}
+
+// QueryUserEntitlements retrieves all entitlements for the user
+func (v *AGSRewardVerifier) QueryUserEntitlements(ctx context.Context, filters map[string]string) ([]*Entitlement, error) {
+	page, err := withRetry(ctx, v, func(ctx context.Context) (*EntitlementPage, error) {
+		return v.doQueryUserEntitlements(ctx, filters, 0, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return page.Entitlements, nil
+}
+
+// QueryUserEntitlementsPage retrieves up to limit entitlements starting at offset
+func (v *AGSRewardVerifier) QueryUserEntitlementsPage(ctx context.Context, filters map[string]string, offset, limit int) (*EntitlementPage, error) {
+	return withRetry(ctx, v, func(ctx context.Context) (*EntitlementPage, error) {
+		return v.doQueryUserEntitlements(ctx, filters, offset, limit)
+	})
+}
+
+// GetUserWallet retrieves a single wallet by currency code
+func (v *AGSRewardVerifier) GetUserWallet(ctx context.Context, currencyCode string) (*Wallet, error) {
+	return withRetry(ctx, v, func(ctx context.Context) (*Wallet, error) {
+		return v.doGetUserWallet(ctx, currencyCode)
+	})
+}
+
+// QueryUserWallets retrieves all wallets for the user
+func (v *AGSRewardVerifier) QueryUserWallets(ctx context.Context) ([]*Wallet, error) {
+	return withRetry(ctx, v, func(ctx context.Context) ([]*Wallet, error) {
+		return v.doQueryUserWallets(ctx)
+	})
+}
+
+// QueryWalletTransactions retrieves the most recent transactions for a currency
+func (v *AGSRewardVerifier) QueryWalletTransactions(ctx context.Context, currencyCode string, limit int) ([]*WalletTransaction, error) {
+	return withRetry(ctx, v, func(ctx context.Context) ([]*WalletTransaction, error) {
+		return v.doQueryWalletTransactions(ctx, currencyCode, limit)
+	})
 }
 
 // doGetUserEntitlement performs the actual API call
-func (v *AGSRewardVerifier) doGetUserEntitlement(itemID string) (*Entitlement, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (v *AGSRewardVerifier) doGetUserEntitlement(ctx context.Context, itemID string) (*Entitlement, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// Create params
 	params := &entitlement.GetUserEntitlementByItemIDParams{
 		Namespace: v.namespace,
-		UserID:    v.userID,
+		UserID:    v.currentUserID(),
 		ItemID:    itemID,
 	}
 	params.SetContext(ctx)
@@ -128,6 +252,7 @@ func (v *AGSRewardVerifier) doGetUserEntitlement(itemID string) (*Entitlement, e
 	if resp.Status != nil {
 		ent.Status = string(*resp.Status)
 	}
+	ent.AppType = resp.AppType
 	if resp.UseCount != 0 {
 		ent.Quantity = resp.UseCount
 	}
@@ -142,50 +267,39 @@ func (v *AGSRewardVerifier) doGetUserEntitlement(itemID string) (*Entitlement, e
 	return ent, nil
 }
 
-// queryUserEntitlementsWithRetry implements retry logic for QueryUserEntitlements
-func (v *AGSRewardVerifier) queryUserEntitlementsWithRetry(filters map[string]string) ([]*Entitlement, error) {
-	var lastErr error
-	retryDelay := v.initialRetryDelay
-
-	for attempt := 0; attempt <= v.maxRetries; attempt++ {
-		if attempt > 0 {
-			time.Sleep(retryDelay)
-			retryDelay *= 2
-		}
-
-		ents, err := v.doQueryUserEntitlements(filters)
-		if err == nil {
-			return ents, nil
-		}
-
-		if !isRetryable(err) {
-			return nil, err
-		}
-
-		lastErr = err
-	}
-
-	return nil, fmt.Errorf("failed after %d retries: %w", v.maxRetries, lastErr)
-}
-
 // doQueryUserEntitlements performs the actual API call
-func (v *AGSRewardVerifier) doQueryUserEntitlements(filters map[string]string) ([]*Entitlement, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// offset and limit page the query when positive; otherwise Platform's defaults apply.
+func (v *AGSRewardVerifier) doQueryUserEntitlements(ctx context.Context, filters map[string]string, offset, limit int) (*EntitlementPage, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// Prepare params
 	params := &entitlement.QueryUserEntitlementsParams{
 		Namespace: v.namespace,
-		UserID:    v.userID,
+		UserID:    v.currentUserID(),
 	}
 	params.SetContext(ctx)
+	if offset > 0 {
+		offset32 := int32(offset)
+		params.Offset = &offset32
+	}
+	if limit > 0 {
+		limit32 := int32(limit)
+		params.Limit = &limit32
+	}
 
-	// Apply filters
-	if entitlementClass, ok := filters["entitlementClass"]; ok {
+	// Apply the filters Platform supports; ACTIVE narrows the query server-side, and the
+	// exact status and item ID prefix are checked on the results
+	if entitlementClass, ok := filters[FilterEntitlementClass]; ok {
 		params.EntitlementClazz = &entitlementClass
 	}
-	if status, ok := filters["status"]; ok {
-		params.EntitlementName = &status
+	if appType, ok := filters[FilterAppType]; ok {
+		appType = strings.ToUpper(appType)
+		params.AppType = &appType
+	}
+	if status, ok := filters[FilterStatus]; ok && strings.EqualFold(status, "ACTIVE") {
+		activeOnly := true
+		params.ActiveOnly = &activeOnly
 	}
 
 	// Call SDK
@@ -196,7 +310,7 @@ func (v *AGSRewardVerifier) doQueryUserEntitlements(filters map[string]string) (
 
 	if resp == nil || resp.Data == nil {
 		// Empty list is valid
-		return []*Entitlement{}, nil
+		return emptyEntitlementPage(offset), nil
 	}
 
 	// Convert to our domain models
@@ -219,6 +333,7 @@ func (v *AGSRewardVerifier) doQueryUserEntitlements(filters map[string]string) (
 		if e.Status != nil {
 			ent.Status = string(*e.Status)
 		}
+		ent.AppType = e.AppType
 		if e.UseCount != 0 {
 			ent.Quantity = e.UseCount
 		}
@@ -230,43 +345,40 @@ func (v *AGSRewardVerifier) doQueryUserEntitlements(filters map[string]string) (
 			}
 		}
 
+		if !matchesEntitlementFilters(ent, filters) {
+			continue
+		}
 		entitlements = append(entitlements, ent)
 	}
 
-	return entitlements, nil
+	// Platform only reports whether another page follows, so the total is known
+	// once the first page is also the last
+	page := &EntitlementPage{
+		Entitlements: entitlements,
+		NextOffset:   offset + len(resp.Data),
+		HasMore:      resp.Paging != nil && resp.Paging.Next != "",
+		Total:        -1,
+	}
+	if !page.HasMore && offset == 0 {
+		page.Total = len(entitlements)
+	}
+	return page, nil
 }
 
-// getUserWalletWithRetry implements retry logic for GetUserWallet
-func (v *AGSRewardVerifier) getUserWalletWithRetry(currencyCode string) (*Wallet, error) {
-	var lastErr error
-	retryDelay := v.initialRetryDelay
-
-	for attempt := 0; attempt <= v.maxRetries; attempt++ {
-		if attempt > 0 {
-			time.Sleep(retryDelay)
-			retryDelay *= 2
-		}
-
-		w, err := v.doGetUserWallet(currencyCode)
-		if err == nil {
-			return w, nil
-		}
-
-		if !isRetryable(err) {
-			return nil, err
-		}
-
-		lastErr = err
+// emptyEntitlementPage returns the last, empty page at offset
+func emptyEntitlementPage(offset int) *EntitlementPage {
+	page := &EntitlementPage{Entitlements: []*Entitlement{}, NextOffset: offset, Total: -1}
+	if offset == 0 {
+		page.Total = 0
 	}
-
-	return nil, fmt.Errorf("failed after %d retries: %w", v.maxRetries, lastErr)
+	return page
 }
 
 // doGetUserWallet performs the actual API call
-func (v *AGSRewardVerifier) doGetUserWallet(currencyCode string) (*Wallet, error) {
+func (v *AGSRewardVerifier) doGetUserWallet(ctx context.Context, currencyCode string) (*Wallet, error) {
 	// Note: The admin wallet endpoint requires wallet UUID, not currency code.
 	// Instead, we query all wallets and filter by currency code.
-	wallets, err := v.doQueryUserWallets()
+	wallets, err := v.doQueryUserWallets(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("query wallets failed: %w", err)
 	}
@@ -282,41 +394,15 @@ func (v *AGSRewardVerifier) doGetUserWallet(currencyCode string) (*Wallet, error
 	return nil, fmt.Errorf("wallet with currency code %s not found", currencyCode)
 }
 
-// queryUserWalletsWithRetry implements retry logic for QueryUserWallets
-func (v *AGSRewardVerifier) queryUserWalletsWithRetry() ([]*Wallet, error) {
-	var lastErr error
-	retryDelay := v.initialRetryDelay
-
-	for attempt := 0; attempt <= v.maxRetries; attempt++ {
-		if attempt > 0 {
-			time.Sleep(retryDelay)
-			retryDelay *= 2
-		}
-
-		wallets, err := v.doQueryUserWallets()
-		if err == nil {
-			return wallets, nil
-		}
-
-		if !isRetryable(err) {
-			return nil, err
-		}
-
-		lastErr = err
-	}
-
-	return nil, fmt.Errorf("failed after %d retries: %w", v.maxRetries, lastErr)
-}
-
 // doQueryUserWallets performs the actual API call
-func (v *AGSRewardVerifier) doQueryUserWallets() ([]*Wallet, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func (v *AGSRewardVerifier) doQueryUserWallets(ctx context.Context) ([]*Wallet, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	// Call SDK
 	params := &wallet.QueryUserCurrencyWalletsParams{
 		Namespace: v.namespace,
-		UserID:    v.userID,
+		UserID:    v.currentUserID(),
 	}
 	params.SetContext(ctx)
 
@@ -365,49 +451,74 @@ func (v *AGSRewardVerifier) doQueryUserWallets() ([]*Wallet, error) {
 	return wallets, nil
 }
 
-// isRetryable checks if an error should trigger a retry
-func isRetryable(err error) bool {
-	if err == nil {
-		return false
+// doQueryWalletTransactions performs the actual API calls
+// The transaction endpoint does not return balances, so BalanceAfter is derived
+// from the current wallet balance.
+func (v *AGSRewardVerifier) doQueryWalletTransactions(ctx context.Context, currencyCode string, limit int) ([]*WalletTransaction, error) {
+	current, err := v.doGetUserWallet(ctx, currencyCode)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check error message for retryable conditions
-	errStr := err.Error()
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
 
-	// Timeout errors are retryable
-	if contains(errStr, "timeout") || contains(errStr, "deadline exceeded") {
-		return true
+	// Call SDK
+	params := &wallet.ListUserCurrencyTransactionsParams{
+		Namespace:    v.namespace,
+		UserID:       v.currentUserID(),
+		CurrencyCode: currencyCode,
 	}
-
-	// Connection errors are retryable
-	if contains(errStr, "connection refused") || contains(errStr, "no such host") {
-		return true
+	if limit > 0 {
+		limit32 := int32(limit)
+		params.Limit = &limit32
 	}
+	params.SetContext(ctx)
 
-	// 5xx errors are retryable
-	if contains(errStr, "500") || contains(errStr, "502") || contains(errStr, "503") || contains(errStr, "504") {
-		return true
+	resp, err := v.walletSvc.ListUserCurrencyTransactionsShort(params)
+	if err != nil {
+		return nil, fmt.Errorf("query wallet transactions failed: %w", err)
 	}
 
-	// 429 rate limit is retryable
-	if contains(errStr, "429") || contains(errStr, "rate limit") {
-		return true
+	if resp == nil || resp.Data == nil {
+		// Empty list is valid
+		return []*WalletTransaction{}, nil
 	}
 
-	// Default: not retryable (4xx client errors, 404, etc.)
-	return false
-}
+	// Convert to our domain models
+	txs := make([]*WalletTransaction, 0, len(resp.Data))
+	for _, t := range resp.Data {
+		if t == nil {
+			continue
+		}
 
-// contains checks if a string contains a substring (case-insensitive)
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || len(substr) > 0 && containsImpl(s, substr))
-}
+		tx := &WalletTransaction{
+			CurrencyCode: currencyCode,
+			Action:       t.WalletAction,
+			Reason:       t.Reason,
+		}
+
+		if t.Amount != nil {
+			tx.Amount = *t.Amount
+			if t.WalletAction != "CREDIT" {
+				tx.Amount = -tx.Amount
+			}
+		}
 
-func containsImpl(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
+		createdAt, err := time.Parse(time.RFC3339, t.CreatedAt.String())
+		if err == nil {
+			tx.CreatedAt = createdAt
 		}
+
+		txs = append(txs, tx)
 	}
-	return false
+
+	applyBalanceAfter(txs, current.Balance)
+
+	return txs, nil
+}
+
+// isRetryable checks if an error should be retried (see retry.IsRetryable)
+func isRetryable(err error) bool {
+	return retry.IsRetryable(err)
 }
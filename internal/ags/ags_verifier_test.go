@@ -0,0 +1,281 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/factory"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	sdkAuth "github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth"
+)
+
+func TestAGSRewardVerifier_Options(t *testing.T) {
+	v := NewAGSRewardVerifier(nil, nil, "user", "demo",
+		WithMaxRetries(5),
+		WithInitialRetryDelay(100*time.Millisecond),
+	)
+
+	if v.maxRetries != 5 {
+		t.Errorf("Expected maxRetries 5, got %d", v.maxRetries)
+	}
+	if v.initialRetryDelay != 100*time.Millisecond {
+		t.Errorf("Expected initialRetryDelay 100ms, got %v", v.initialRetryDelay)
+	}
+
+	defaults := NewAGSRewardVerifier(nil, nil, "user", "demo")
+	if defaults.maxRetries != 3 || defaults.initialRetryDelay != 500*time.Millisecond {
+		t.Errorf("Expected defaults 3/500ms, got %d/%v", defaults.maxRetries, defaults.initialRetryDelay)
+	}
+}
+
+func TestAGSRewardVerifier_BackoffDelay(t *testing.T) {
+	initial := 100 * time.Millisecond
+	v := NewAGSRewardVerifier(nil, nil, "user", "demo",
+		WithInitialRetryDelay(initial),
+		WithRandSource(rand.NewSource(42)),
+	)
+
+	distinct := make(map[time.Duration]bool)
+	for attempt := 1; attempt <= 4; attempt++ {
+		ceiling := initial << (attempt - 1)
+
+		for i := 0; i < 50; i++ {
+			delay := v.backoffDelay(attempt)
+			if delay < 0 || delay > ceiling {
+				t.Fatalf("Attempt %d: expected delay in [0, %v], got %v", attempt, ceiling, delay)
+			}
+			distinct[delay] = true
+		}
+	}
+
+	// Jitter should spread delays rather than repeating a fixed schedule
+	if len(distinct) < 100 {
+		t.Errorf("Expected jittered delays to vary, got only %d distinct values", len(distinct))
+	}
+
+	// Same seed yields the same sequence
+	a := NewAGSRewardVerifier(nil, nil, "user", "demo", WithRandSource(rand.NewSource(7)))
+	b := NewAGSRewardVerifier(nil, nil, "user", "demo", WithRandSource(rand.NewSource(7)))
+	for attempt := 1; attempt <= 3; attempt++ {
+		if da, db := a.backoffDelay(attempt), b.backoffDelay(attempt); da != db {
+			t.Errorf("Attempt %d: expected identical delays for same seed, got %v and %v", attempt, da, db)
+		}
+	}
+}
+
+// newTestRewardVerifier points the SDK entitlement and wallet services at a stub Platform server
+// The handler's request count is returned so tests can check how often Platform was called.
+func newTestRewardVerifier(t *testing.T, handler http.HandlerFunc, opts ...VerifierOption) (*AGSRewardVerifier, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	configRepo := &sdkAuth.ConfigRepositoryImpl{ClientId: "admin", ClientSecret: "secret", BaseUrl: server.URL}
+	tokenRepo := sdkAuth.DefaultTokenRepositoryImpl()
+	accessToken, expiresIn := "admin-token", int32(3600)
+	if err := tokenRepo.Store(iamclientmodels.OauthmodelTokenResponseV3{AccessToken: &accessToken, ExpiresIn: &expiresIn}); err != nil {
+		t.Fatalf("Failed to store token: %v", err)
+	}
+
+	entitlementSvc := &platform.EntitlementService{
+		Client:           factory.NewPlatformClient(configRepo),
+		ConfigRepository: configRepo,
+		TokenRepository:  tokenRepo,
+	}
+	walletSvc := &platform.WalletService{
+		Client:           factory.NewPlatformClient(configRepo),
+		ConfigRepository: configRepo,
+		TokenRepository:  tokenRepo,
+	}
+	return NewAGSRewardVerifier(entitlementSvc, walletSvc, "user", "demo", opts...), &requests
+}
+
+// unavailable is a Platform handler that always fails transiently
+func unavailable(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusServiceUnavailable)
+}
+
+func TestAGSRewardVerifier_CancelledContext(t *testing.T) {
+	v, requests := newTestRewardVerifier(t, unavailable)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := v.QueryUserWallets(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("Expected no Platform calls with a cancelled context, got %d", n)
+	}
+}
+
+func TestAGSRewardVerifier_CancelDuringBackoff(t *testing.T) {
+	v, requests := newTestRewardVerifier(t, unavailable,
+		WithInitialRetryDelay(time.Hour),
+		WithRandSource(rand.NewSource(1)),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := v.QueryUserWallets(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected cancellation to cut the backoff short, took %v", elapsed)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("Expected 1 Platform call before the backoff, got %d", n)
+	}
+}
+
+func TestAGSRewardVerifier_DeadlineSkipsRetry(t *testing.T) {
+	v, requests := newTestRewardVerifier(t, unavailable,
+		WithInitialRetryDelay(time.Hour),
+		WithRandSource(rand.NewSource(1)),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err := v.QueryUserWallets(ctx)
+	if err == nil || !strings.Contains(err.Error(), "deadline reached") {
+		t.Errorf("Expected a deadline reached error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the retry to be skipped rather than slept, took %v", elapsed)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("Expected 1 Platform call, got %d", n)
+	}
+}
+
+func TestAGSRewardVerifier_SetUserID(t *testing.T) {
+	var path string
+	v, _ := newTestRewardVerifier(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	v.SetUserID("bob")
+	if _, err := v.QueryUserWallets(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(path, "/users/bob/") {
+		t.Errorf("Expected wallets of user bob to be queried, got %s", path)
+	}
+}
+
+func TestAGSRewardVerifier_QueryUserEntitlementsFilters(t *testing.T) {
+	v, _ := newTestRewardVerifier(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if got := query.Get("activeOnly"); got != "true" {
+			t.Errorf("Expected activeOnly=true for status ACTIVE, got %q", got)
+		}
+		if got := query.Get("appType"); got != "DLC" {
+			t.Errorf("Expected appType=DLC, got %q", got)
+		}
+		if query.Has("entitlementName") {
+			t.Errorf("Status must not be sent as the entitlement name, got %q", query.Get("entitlementName"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": "e1", "itemId": "winter_dlc", "status": "ACTIVE", "appType": "DLC", "clazz": "APP", "useCount": 1},
+			{"id": "e2", "itemId": "summer_dlc", "status": "ACTIVE", "appType": "DLC", "clazz": "APP", "useCount": 1}
+		], "paging": {}}`))
+	})
+
+	ents, err := v.QueryUserEntitlements(context.Background(), map[string]string{
+		FilterStatus:       "ACTIVE",
+		FilterAppType:      "dlc",
+		FilterItemIDPrefix: "winter",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(ents) != 1 || ents[0].ItemID != "winter_dlc" || ents[0].AppType != "DLC" {
+		t.Errorf("Expected only winter_dlc (DLC) after the prefix filter, got %+v", ents)
+	}
+}
+
+func TestAGSRewardVerifier_QueryUserEntitlementsPage(t *testing.T) {
+	v, _ := newTestRewardVerifier(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("offset") != "2" || query.Get("limit") != "2" {
+			t.Errorf("Expected offset=2&limit=2, got %q", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": "e3", "itemId": "winter_sword", "status": "ACTIVE", "clazz": "ENTITLEMENT", "useCount": 1},
+			{"id": "e4", "itemId": "summer_sword", "status": "ACTIVE", "clazz": "ENTITLEMENT", "useCount": 1}
+		], "paging": {"next": "/platform/admin/namespaces/demo/users/user/entitlements?offset=4&limit=2"}}`))
+	})
+
+	page, err := v.QueryUserEntitlementsPage(context.Background(), map[string]string{FilterItemIDPrefix: "winter"}, 2, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(page.Entitlements) != 1 || page.Entitlements[0].ItemID != "winter_sword" {
+		t.Errorf("Expected only winter_sword after the prefix filter, got %+v", page.Entitlements)
+	}
+	// The next page starts after every entitlement Platform returned, including filtered ones
+	if page.NextOffset != 4 || !page.HasMore {
+		t.Errorf("Expected more entitlements from offset 4, got NextOffset %d, HasMore %v", page.NextOffset, page.HasMore)
+	}
+	if page.Total != -1 {
+		t.Errorf("Expected an unknown total while pages remain, got %d", page.Total)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil error", err: nil, expected: false},
+		{name: "lowercase timeout", err: errors.New("request timeout"), expected: true},
+		{name: "mixed-case timeout", err: errors.New("Timeout awaiting response headers"), expected: true},
+		{name: "deadline exceeded", err: errors.New("Context Deadline Exceeded"), expected: true},
+		{name: "mixed-case connection refused", err: errors.New("dial tcp: Connection Refused"), expected: true},
+		{name: "uppercase connection refused", err: errors.New("CONNECTION REFUSED"), expected: true},
+		{name: "503 service unavailable", err: errors.New("HTTP 503 Service Unavailable"), expected: true},
+		{name: "429 too many requests", err: errors.New("HTTP 429 Too Many Requests"), expected: true},
+		{name: "mixed-case rate limit", err: errors.New("Rate Limit exceeded"), expected: true},
+		{name: "404 not found", err: errors.New("HTTP 404 Not Found"), expected: false},
+		{name: "400 bad request", err: errors.New("Bad Request"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.expected {
+				t.Errorf("Expected isRetryable=%v for '%v', got %v", tt.expected, tt.err, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import "strings"
+
+// InventoryFilter narrows queried entitlements and wallets client-side
+// It is applied to the results of QueryUserEntitlements/QueryUserWallets so the
+// mock and AGS verifiers filter identically.
+type InventoryFilter struct {
+	Currency  string // Keep only wallets in this currency (case-insensitive); empty keeps all
+	HideEmpty bool   // Drop zero-balance wallets and zero-quantity entitlements
+}
+
+// Entitlements returns the entitlements that pass the filter
+func (f InventoryFilter) Entitlements(ents []*Entitlement) []*Entitlement {
+	if !f.HideEmpty {
+		return ents
+	}
+
+	filtered := make([]*Entitlement, 0, len(ents))
+	for _, ent := range ents {
+		if ent.Quantity > 0 {
+			filtered = append(filtered, ent)
+		}
+	}
+	return filtered
+}
+
+// Wallets returns the wallets that pass the filter
+func (f InventoryFilter) Wallets(wallets []*Wallet) []*Wallet {
+	if f.Currency == "" && !f.HideEmpty {
+		return wallets
+	}
+
+	filtered := make([]*Wallet, 0, len(wallets))
+	for _, wallet := range wallets {
+		if f.Currency != "" && !strings.EqualFold(wallet.CurrencyCode, f.Currency) {
+			continue
+		}
+		if f.HideEmpty && wallet.Balance == 0 {
+			continue
+		}
+		filtered = append(filtered, wallet)
+	}
+	return filtered
+}
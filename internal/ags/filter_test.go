@@ -0,0 +1,68 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import (
+	"strings"
+	"testing"
+)
+
+func walletCodes(wallets []*Wallet) string {
+	codes := make([]string, len(wallets))
+	for i, w := range wallets {
+		codes[i] = w.CurrencyCode
+	}
+	return strings.Join(codes, ",")
+}
+
+func TestInventoryFilter_Wallets(t *testing.T) {
+	wallets := []*Wallet{
+		{CurrencyCode: "GOLD", Balance: 150},
+		{CurrencyCode: "GEMS", Balance: 0},
+		{CurrencyCode: "SILVER", Balance: 5},
+	}
+
+	tests := []struct {
+		name   string
+		filter InventoryFilter
+		want   string
+	}{
+		{name: "no filter", filter: InventoryFilter{}, want: "GOLD,GEMS,SILVER"},
+		{name: "currency", filter: InventoryFilter{Currency: "gems"}, want: "GEMS"},
+		{name: "hide empty", filter: InventoryFilter{HideEmpty: true}, want: "GOLD,SILVER"},
+		{name: "currency and hide empty", filter: InventoryFilter{Currency: "GEMS", HideEmpty: true}, want: ""},
+		{name: "unknown currency", filter: InventoryFilter{Currency: "COINS"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := walletCodes(tt.filter.Wallets(wallets)); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestInventoryFilter_Entitlements(t *testing.T) {
+	ents := []*Entitlement{
+		{ItemID: "sword", Status: "ACTIVE", Quantity: 1},
+		{ItemID: "potion", Status: "ACTIVE", Quantity: 0},
+		{ItemID: "shield", Status: "INACTIVE", Quantity: 2},
+	}
+
+	if got := (InventoryFilter{}).Entitlements(ents); len(got) != 3 {
+		t.Errorf("Expected all 3 entitlements without HideEmpty, got %d", len(got))
+	}
+
+	// Currency only applies to wallets
+	if got := (InventoryFilter{Currency: "GOLD"}).Entitlements(ents); len(got) != 3 {
+		t.Errorf("Expected currency filter to keep all entitlements, got %d", len(got))
+	}
+
+	got := (InventoryFilter{HideEmpty: true}).Entitlements(ents)
+	if len(got) != 2 || got[0].ItemID != "sword" || got[1].ItemID != "shield" {
+		t.Errorf("Expected sword and shield, got %+v", got)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import "sort"
+
+// Inventory is a snapshot of a user's entitlements and wallets
+type Inventory struct {
+	Entitlements []*Entitlement
+	Wallets      []*Wallet
+}
+
+// ItemChange is an entitlement whose quantity changed between two snapshots
+type ItemChange struct {
+	ItemID string `json:"item_id"`
+	Before int32  `json:"before"` // 0 for a newly added entitlement
+	After  int32  `json:"after"`
+}
+
+// WalletChange is a wallet whose balance changed between two snapshots
+type WalletChange struct {
+	CurrencyCode string `json:"currency_code"`
+	Before       int64  `json:"before"` // 0 for a newly created wallet
+	After        int64  `json:"after"`
+}
+
+// Delta returns the balance change (negative for debits)
+func (c WalletChange) Delta() int64 {
+	return c.After - c.Before
+}
+
+// InventoryDelta describes what changed between two inventory snapshots
+// All slices are sorted by item ID or currency code.
+type InventoryDelta struct {
+	NewItems       []ItemChange   `json:"new_items"`       // Entitlements absent before
+	IncreasedItems []ItemChange   `json:"increased_items"` // Entitlements whose quantity grew
+	WalletChanges  []WalletChange `json:"wallet_changes"`  // Wallets whose balance changed either way
+}
+
+// IsEmpty reports whether nothing was granted or changed
+func (d InventoryDelta) IsEmpty() bool {
+	return len(d.NewItems) == 0 && len(d.IncreasedItems) == 0 && len(d.WalletChanges) == 0
+}
+
+// DiffInventory computes the changes from before to after
+// Entitlement quantities are summed per item ID, counting only ACTIVE (or
+// status-less) entitlements. A nil snapshot is treated as empty.
+func DiffInventory(before, after *Inventory) InventoryDelta {
+	beforeItems := itemQuantities(before)
+	afterItems := itemQuantities(after)
+	beforeWallets := walletBalances(before)
+	afterWallets := walletBalances(after)
+
+	delta := InventoryDelta{
+		NewItems:       []ItemChange{},
+		IncreasedItems: []ItemChange{},
+		WalletChanges:  []WalletChange{},
+	}
+
+	itemIDs := make([]string, 0, len(afterItems))
+	for itemID := range afterItems {
+		itemIDs = append(itemIDs, itemID)
+	}
+	sort.Strings(itemIDs)
+
+	for _, itemID := range itemIDs {
+		qty := afterItems[itemID]
+		prev, existed := beforeItems[itemID]
+		switch {
+		case !existed:
+			delta.NewItems = append(delta.NewItems, ItemChange{ItemID: itemID, After: qty})
+		case qty > prev:
+			delta.IncreasedItems = append(delta.IncreasedItems, ItemChange{ItemID: itemID, Before: prev, After: qty})
+		}
+	}
+
+	codes := make([]string, 0, len(afterWallets))
+	for code := range afterWallets {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		if balance := afterWallets[code]; balance != beforeWallets[code] {
+			delta.WalletChanges = append(delta.WalletChanges, WalletChange{CurrencyCode: code, Before: beforeWallets[code], After: balance})
+		}
+	}
+
+	return delta
+}
+
+// itemQuantities sums active entitlement quantities by item ID
+func itemQuantities(inv *Inventory) map[string]int32 {
+	quantities := map[string]int32{}
+	if inv == nil {
+		return quantities
+	}
+
+	for _, ent := range inv.Entitlements {
+		if ent.Status != "" && ent.Status != "ACTIVE" {
+			continue
+		}
+		quantities[ent.ItemID] += ent.Quantity
+	}
+
+	return quantities
+}
+
+// walletBalances maps currency codes to balances
+func walletBalances(inv *Inventory) map[string]int64 {
+	balances := map[string]int64{}
+	if inv == nil {
+		return balances
+	}
+
+	for _, wallet := range inv.Wallets {
+		balances[wallet.CurrencyCode] += wallet.Balance
+	}
+
+	return balances
+}
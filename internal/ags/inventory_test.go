@@ -0,0 +1,123 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffInventory(t *testing.T) {
+	base := func() *Inventory {
+		return &Inventory{
+			Entitlements: []*Entitlement{
+				{ItemID: "bronze_shield", Status: "ACTIVE", Quantity: 2},
+			},
+			Wallets: []*Wallet{
+				{CurrencyCode: "GOLD", Balance: 150},
+				{CurrencyCode: "GEMS", Balance: 25},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		before *Inventory
+		after  func() *Inventory
+		want   InventoryDelta
+	}{
+		{
+			name:   "no change",
+			before: base(),
+			after:  base,
+			want:   InventoryDelta{NewItems: []ItemChange{}, IncreasedItems: []ItemChange{}, WalletChanges: []WalletChange{}},
+		},
+		{
+			name:   "new item appears",
+			before: base(),
+			after: func() *Inventory {
+				inv := base()
+				inv.Entitlements = append(inv.Entitlements, &Entitlement{ItemID: "winter_sword", Status: "ACTIVE", Quantity: 1})
+				return inv
+			},
+			want: InventoryDelta{
+				NewItems:       []ItemChange{{ItemID: "winter_sword", After: 1}},
+				IncreasedItems: []ItemChange{},
+				WalletChanges:  []WalletChange{},
+			},
+		},
+		{
+			name:   "quantity increases",
+			before: base(),
+			after: func() *Inventory {
+				inv := base()
+				inv.Entitlements[0].Quantity = 5
+				return inv
+			},
+			want: InventoryDelta{
+				NewItems:       []ItemChange{},
+				IncreasedItems: []ItemChange{{ItemID: "bronze_shield", Before: 2, After: 5}},
+				WalletChanges:  []WalletChange{},
+			},
+		},
+		{
+			name:   "balance increases",
+			before: base(),
+			after: func() *Inventory {
+				inv := base()
+				inv.Wallets[0].Balance = 250
+				return inv
+			},
+			want: InventoryDelta{
+				NewItems:       []ItemChange{},
+				IncreasedItems: []ItemChange{},
+				WalletChanges:  []WalletChange{{CurrencyCode: "GOLD", Before: 150, After: 250}},
+			},
+		},
+		{
+			name:   "inactive entitlement is not a grant",
+			before: base(),
+			after: func() *Inventory {
+				inv := base()
+				inv.Entitlements = append(inv.Entitlements, &Entitlement{ItemID: "winter_sword", Status: "INACTIVE", Quantity: 1})
+				return inv
+			},
+			want: InventoryDelta{NewItems: []ItemChange{}, IncreasedItems: []ItemChange{}, WalletChanges: []WalletChange{}},
+		},
+		{
+			name:   "nil before treats everything as new",
+			before: nil,
+			after:  base,
+			want: InventoryDelta{
+				NewItems:       []ItemChange{{ItemID: "bronze_shield", After: 2}},
+				IncreasedItems: []ItemChange{},
+				WalletChanges: []WalletChange{
+					{CurrencyCode: "GEMS", After: 25},
+					{CurrencyCode: "GOLD", After: 150},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffInventory(tt.before, tt.after())
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+			if got.IsEmpty() != (len(tt.want.NewItems)+len(tt.want.IncreasedItems)+len(tt.want.WalletChanges) == 0) {
+				t.Errorf("Expected IsEmpty to reflect the delta, got %v", got.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestWalletChange_Delta(t *testing.T) {
+	change := WalletChange{CurrencyCode: "GOLD", Before: 150, After: 125}
+	if change.Delta() != -25 {
+		t.Errorf("Expected delta -25, got %d", change.Delta())
+	}
+}
@@ -5,6 +5,7 @@
 package ags
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -13,6 +14,7 @@ import (
 type MockRewardVerifier struct {
 	Entitlements []*Entitlement
 	Wallets      []*Wallet
+	Transactions []*WalletTransaction // Newest first; BalanceAfter is computed on query
 	Error        error
 }
 
@@ -53,11 +55,41 @@ func NewMockRewardVerifier() *MockRewardVerifier {
 				Status:       "ACTIVE",
 			},
 		},
+		Transactions: []*WalletTransaction{
+			{
+				CurrencyCode: "GOLD",
+				Action:       "DEBIT",
+				Amount:       -25,
+				Reason:       "Item purchase",
+				CreatedAt:    time.Now().Add(-1 * time.Hour),
+			},
+			{
+				CurrencyCode: "GOLD",
+				Action:       "CREDIT",
+				Amount:       75,
+				Reason:       "Challenge reward",
+				CreatedAt:    time.Now().Add(-12 * time.Hour),
+			},
+			{
+				CurrencyCode: "GEMS",
+				Action:       "CREDIT",
+				Amount:       25,
+				Reason:       "Challenge reward",
+				CreatedAt:    time.Now().Add(-24 * time.Hour),
+			},
+			{
+				CurrencyCode: "GOLD",
+				Action:       "CREDIT",
+				Amount:       100,
+				Reason:       "Initial grant",
+				CreatedAt:    time.Now().Add(-48 * time.Hour),
+			},
+		},
 	}
 }
 
 // GetUserEntitlement retrieves a single entitlement by item ID
-func (m *MockRewardVerifier) GetUserEntitlement(itemID string) (*Entitlement, error) {
+func (m *MockRewardVerifier) GetUserEntitlement(ctx context.Context, itemID string) (*Entitlement, error) {
 	if m.Error != nil {
 		return nil, m.Error
 	}
@@ -72,27 +104,49 @@ func (m *MockRewardVerifier) GetUserEntitlement(itemID string) (*Entitlement, er
 }
 
 // QueryUserEntitlements retrieves all entitlements for the user
-func (m *MockRewardVerifier) QueryUserEntitlements(filters map[string]string) ([]*Entitlement, error) {
+func (m *MockRewardVerifier) QueryUserEntitlements(ctx context.Context, filters map[string]string) ([]*Entitlement, error) {
 	if m.Error != nil {
 		return nil, m.Error
 	}
 
-	// Apply filters if provided
-	if status, ok := filters["status"]; ok {
-		filtered := make([]*Entitlement, 0)
-		for _, ent := range m.Entitlements {
-			if ent.Status == status {
-				filtered = append(filtered, ent)
-			}
+	// Apply filters if provided (mock entitlements have no class, so entitlementClass is ignored)
+	if len(filters) == 0 {
+		return m.Entitlements, nil
+	}
+
+	filtered := make([]*Entitlement, 0)
+	for _, ent := range m.Entitlements {
+		if matchesEntitlementFilters(ent, filters) {
+			filtered = append(filtered, ent)
 		}
-		return filtered, nil
 	}
+	return filtered, nil
+}
 
-	return m.Entitlements, nil
+// QueryUserEntitlementsPage retrieves up to limit entitlements starting at offset
+// The mock pages after filtering, so every page but the last is full and Total is exact.
+func (m *MockRewardVerifier) QueryUserEntitlementsPage(ctx context.Context, filters map[string]string, offset, limit int) (*EntitlementPage, error) {
+	ents, err := m.QueryUserEntitlements(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	start := min(max(offset, 0), len(ents))
+	end := len(ents)
+	if limit > 0 {
+		end = min(start+limit, len(ents))
+	}
+
+	return &EntitlementPage{
+		Entitlements: ents[start:end],
+		NextOffset:   end,
+		HasMore:      end < len(ents),
+		Total:        len(ents),
+	}, nil
 }
 
 // GetUserWallet retrieves a single wallet by currency code
-func (m *MockRewardVerifier) GetUserWallet(currencyCode string) (*Wallet, error) {
+func (m *MockRewardVerifier) GetUserWallet(ctx context.Context, currencyCode string) (*Wallet, error) {
 	if m.Error != nil {
 		return nil, m.Error
 	}
@@ -107,10 +161,39 @@ func (m *MockRewardVerifier) GetUserWallet(currencyCode string) (*Wallet, error)
 }
 
 // QueryUserWallets retrieves all wallets for the user
-func (m *MockRewardVerifier) QueryUserWallets() ([]*Wallet, error) {
+func (m *MockRewardVerifier) QueryUserWallets(ctx context.Context) ([]*Wallet, error) {
 	if m.Error != nil {
 		return nil, m.Error
 	}
 
 	return m.Wallets, nil
 }
+
+// QueryWalletTransactions retrieves the most recent transactions for a currency
+func (m *MockRewardVerifier) QueryWalletTransactions(ctx context.Context, currencyCode string, limit int) ([]*WalletTransaction, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	wallet, err := m.GetUserWallet(ctx, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := []*WalletTransaction{}
+	for _, tx := range m.Transactions {
+		if tx.CurrencyCode != currencyCode {
+			continue
+		}
+		copied := *tx
+		txs = append(txs, &copied)
+	}
+
+	applyBalanceAfter(txs, wallet.Balance)
+
+	if limit > 0 && len(txs) > limit {
+		txs = txs[:limit]
+	}
+
+	return txs, nil
+}
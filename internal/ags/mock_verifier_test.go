@@ -0,0 +1,133 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestMockRewardVerifier_QueryWalletTransactions(t *testing.T) {
+	verifier := NewMockRewardVerifier()
+
+	txs, err := verifier.QueryWalletTransactions(context.Background(), "GOLD", 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(txs) != 3 {
+		t.Fatalf("Expected 3 GOLD transactions, got %d", len(txs))
+	}
+
+	// Newest first: balance after the newest equals the current balance (150)
+	expected := []struct {
+		amount       int64
+		balanceAfter int64
+	}{
+		{amount: -25, balanceAfter: 150},
+		{amount: 75, balanceAfter: 175},
+		{amount: 100, balanceAfter: 100},
+	}
+
+	for i, want := range expected {
+		if txs[i].Amount != want.amount {
+			t.Errorf("Transaction %d: expected amount %d, got %d", i, want.amount, txs[i].Amount)
+		}
+		if txs[i].BalanceAfter != want.balanceAfter {
+			t.Errorf("Transaction %d: expected balance after %d, got %d", i, want.balanceAfter, txs[i].BalanceAfter)
+		}
+	}
+
+	limited, err := verifier.QueryWalletTransactions(context.Background(), "GOLD", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(limited) != 1 || limited[0].BalanceAfter != 150 {
+		t.Errorf("Expected 1 transaction with balance after 150, got %d", len(limited))
+	}
+
+	if _, err := verifier.QueryWalletTransactions(context.Background(), "SILVER", 10); err == nil {
+		t.Error("Expected error for unknown currency")
+	}
+}
+
+func TestMockRewardVerifier_QueryUserEntitlementsFilters(t *testing.T) {
+	verifier := NewMockRewardVerifier()
+	verifier.Entitlements = append(verifier.Entitlements,
+		&Entitlement{ItemID: "winter_dlc", Status: "INACTIVE", AppType: "DLC", Quantity: 1},
+	)
+
+	tests := []struct {
+		name    string
+		filters map[string]string
+		want    []string
+	}{
+		{name: "no filters", filters: nil, want: []string{"winter_sword", "bronze_shield", "winter_dlc"}},
+		{name: "status", filters: map[string]string{FilterStatus: "active"}, want: []string{"winter_sword", "bronze_shield"}},
+		{name: "item ID prefix", filters: map[string]string{FilterItemIDPrefix: "winter"}, want: []string{"winter_sword", "winter_dlc"}},
+		{name: "app type", filters: map[string]string{FilterAppType: "DLC"}, want: []string{"winter_dlc"}},
+		{name: "combined", filters: map[string]string{FilterItemIDPrefix: "winter", FilterStatus: "ACTIVE"}, want: []string{"winter_sword"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ents, err := verifier.QueryUserEntitlements(context.Background(), tt.filters)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			got := make([]string, 0, len(ents))
+			for _, ent := range ents {
+				got = append(got, ent.ItemID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMockRewardVerifier_QueryUserEntitlementsPage(t *testing.T) {
+	verifier := NewMockRewardVerifier()
+	verifier.Entitlements = append(verifier.Entitlements,
+		&Entitlement{ItemID: "winter_dlc", Status: "ACTIVE", Quantity: 1},
+		&Entitlement{ItemID: "summer_dlc", Status: "ACTIVE", Quantity: 1},
+	)
+
+	tests := []struct {
+		name    string
+		offset  int
+		limit   int
+		want    []string
+		next    int
+		hasMore bool
+	}{
+		{name: "first page", offset: 0, limit: 3, want: []string{"winter_sword", "bronze_shield", "winter_dlc"}, next: 3, hasMore: true},
+		{name: "last page", offset: 3, limit: 3, want: []string{"summer_dlc"}, next: 4, hasMore: false},
+		{name: "past the end", offset: 10, limit: 3, want: []string{}, next: 4, hasMore: false},
+		{name: "no limit", offset: 1, limit: 0, want: []string{"bronze_shield", "winter_dlc", "summer_dlc"}, next: 4, hasMore: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := verifier.QueryUserEntitlementsPage(context.Background(), nil, tt.offset, tt.limit)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			got := make([]string, 0, len(page.Entitlements))
+			for _, ent := range page.Entitlements {
+				got = append(got, ent.ItemID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+			if page.NextOffset != tt.next || page.HasMore != tt.hasMore || page.Total != 4 {
+				t.Errorf("Expected next %d, more %v, total 4; got %d, %v, %d", tt.next, tt.hasMore, page.NextOffset, page.HasMore, page.Total)
+			}
+		})
+	}
+}
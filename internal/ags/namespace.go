@@ -0,0 +1,80 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/basic-sdk/pkg/basicclient/namespace"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/basic"
+)
+
+// Namespace represents an AGS namespace the admin client can access
+type Namespace struct {
+	Namespace       string `json:"namespace"`
+	DisplayName     string `json:"display_name"`
+	ParentNamespace string `json:"parent_namespace,omitempty"`
+	Status          string `json:"status"` // ACTIVE, INACTIVE
+}
+
+// NamespaceLister lists the namespaces accessible to the admin credentials
+type NamespaceLister interface {
+	// ListNamespaces returns the accessible namespaces sorted by name
+	ListNamespaces(ctx context.Context) ([]*Namespace, error)
+}
+
+// AGSNamespaceLister implements NamespaceLister using the AccelByte Basic SDK
+type AGSNamespaceLister struct {
+	namespaceSvc *basic.NamespaceService
+}
+
+// NewAGSNamespaceLister creates a new AGS namespace lister
+// Parameters:
+//   - namespaceSvc: Basic SDK namespace service (pre-configured with admin auth)
+func NewAGSNamespaceLister(namespaceSvc *basic.NamespaceService) *AGSNamespaceLister {
+	return &AGSNamespaceLister{namespaceSvc: namespaceSvc}
+}
+
+// ListNamespaces retrieves the namespaces the admin client can access
+func (l *AGSNamespaceLister) ListNamespaces(ctx context.Context) ([]*Namespace, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	params := &namespace.GetNamespacesParams{}
+	params.SetContext(ctx)
+
+	// Call SDK (auth is handled by the service)
+	resp, err := l.namespaceSvc.GetNamespacesShort(params)
+	if err != nil {
+		return nil, fmt.Errorf("get namespaces failed: %w", err)
+	}
+
+	// Convert to our domain model
+	namespaces := make([]*Namespace, 0, len(resp))
+	for _, info := range resp {
+		if info == nil || info.Namespace == nil {
+			continue
+		}
+
+		ns := &Namespace{
+			Namespace:       *info.Namespace,
+			ParentNamespace: info.ParentNamespace,
+			Status:          info.Status,
+		}
+		if info.DisplayName != nil {
+			ns.DisplayName = *info.DisplayName
+		}
+		namespaces = append(namespaces, ns)
+	}
+
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaces[i].Namespace < namespaces[j].Namespace
+	})
+
+	return namespaces, nil
+}
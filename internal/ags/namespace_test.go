@@ -0,0 +1,87 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/factory"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/basic"
+	sdkAuth "github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth"
+)
+
+// newTestNamespaceLister points the SDK namespace service at a stub Basic server
+func newTestNamespaceLister(t *testing.T, handler http.HandlerFunc) *AGSNamespaceLister {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	configRepo := &sdkAuth.ConfigRepositoryImpl{ClientId: "admin", ClientSecret: "secret", BaseUrl: server.URL}
+	tokenRepo := sdkAuth.DefaultTokenRepositoryImpl()
+	accessToken, expiresIn := "admin-token", int32(3600)
+	if err := tokenRepo.Store(iamclientmodels.OauthmodelTokenResponseV3{AccessToken: &accessToken, ExpiresIn: &expiresIn}); err != nil {
+		t.Fatalf("Failed to store token: %v", err)
+	}
+
+	return NewAGSNamespaceLister(&basic.NamespaceService{
+		Client:           factory.NewBasicClient(configRepo),
+		ConfigRepository: configRepo,
+		TokenRepository:  tokenRepo,
+	})
+}
+
+func TestAGSNamespaceLister_ListNamespaces(t *testing.T) {
+	lister := newTestNamespaceLister(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/basic/v1/admin/namespaces" {
+			t.Errorf("Expected namespaces endpoint, got %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer admin-token" {
+			t.Errorf("Expected admin bearer token, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"namespace": "mygame", "displayName": "My Game", "parentNamespace": "studio", "status": "ACTIVE"},
+			{"namespace": "studio", "displayName": "Studio", "status": "ACTIVE"},
+			{"namespace": "archived", "displayName": "Archived", "status": "INACTIVE"}
+		]`))
+	})
+
+	namespaces, err := lister.ListNamespaces(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []Namespace{
+		{Namespace: "archived", DisplayName: "Archived", Status: "INACTIVE"},
+		{Namespace: "mygame", DisplayName: "My Game", ParentNamespace: "studio", Status: "ACTIVE"},
+		{Namespace: "studio", DisplayName: "Studio", Status: "ACTIVE"},
+	}
+	if len(namespaces) != len(want) {
+		t.Fatalf("Expected %d namespaces, got %d", len(want), len(namespaces))
+	}
+	for i := range want {
+		if *namespaces[i] != want[i] {
+			t.Errorf("Namespace %d: expected %+v, got %+v", i, want[i], *namespaces[i])
+		}
+	}
+}
+
+func TestAGSNamespaceLister_Forbidden(t *testing.T) {
+	lister := newTestNamespaceLister(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errorCode": 20013, "errorMessage": "insufficient permissions"}`))
+	})
+
+	if _, err := lister.ListNamespaces(context.Background()); err == nil {
+		t.Error("Expected error for forbidden response, got nil")
+	}
+}
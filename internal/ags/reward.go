@@ -0,0 +1,38 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupportedRewardType is returned by GrantedQuantity for reward types other than ITEM and WALLET
+var ErrUnsupportedRewardType = errors.New("unsupported reward type")
+
+// GrantedQuantity returns the user's entitlement quantity (ITEM) or wallet balance (WALLET)
+// A failed lookup (typically a missing entitlement or wallet) counts as 0, not granted: the
+// quantity is 0 and the lookup error is returned so callers can report it. Unknown reward
+// types return ErrUnsupportedRewardType.
+func GrantedQuantity(ctx context.Context, verifier RewardVerifier, rewardType, rewardID string) (int64, error) {
+	switch rewardType {
+	case "ITEM":
+		ent, err := verifier.GetUserEntitlement(ctx, rewardID)
+		if err != nil {
+			return 0, err
+		}
+		return int64(ent.Quantity), nil
+
+	case "WALLET":
+		wallet, err := verifier.GetUserWallet(ctx, rewardID)
+		if err != nil {
+			return 0, err
+		}
+		return wallet.Balance, nil
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrUnsupportedRewardType, rewardType)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package ags
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestGrantedQuantity(t *testing.T) {
+	verifier := NewMockRewardVerifier()
+
+	tests := []struct {
+		name            string
+		rewardType      string
+		rewardID        string
+		wantQuantity    int64
+		wantErr         bool
+		wantUnsupported bool
+	}{
+		{name: "item entitlement", rewardType: "ITEM", rewardID: "bronze_shield", wantQuantity: 2},
+		{name: "wallet balance", rewardType: "WALLET", rewardID: "GOLD", wantQuantity: 150},
+		{name: "missing item counts as 0", rewardType: "ITEM", rewardID: "missing_item", wantErr: true},
+		{name: "missing wallet counts as 0", rewardType: "WALLET", rewardID: "SILVER", wantErr: true},
+		{name: "unsupported type", rewardType: "BADGE", rewardID: "x", wantErr: true, wantUnsupported: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quantity, err := GrantedQuantity(context.Background(), verifier, tt.rewardType, tt.rewardID)
+
+			if quantity != tt.wantQuantity {
+				t.Errorf("Expected quantity %d, got %d", tt.wantQuantity, quantity)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error=%v, got %v", tt.wantErr, err)
+			}
+			if errors.Is(err, ErrUnsupportedRewardType) != tt.wantUnsupported {
+				t.Errorf("Expected ErrUnsupportedRewardType=%v, got %v", tt.wantUnsupported, err)
+			}
+		})
+	}
+}
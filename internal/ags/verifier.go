@@ -1,6 +1,8 @@
 package ags
 
 import (
+	"context"
+	"strings"
 	"time"
 )
 
@@ -10,10 +12,27 @@ type Entitlement struct {
 	ItemID        string
 	Namespace     string
 	Status        string // ACTIVE, INACTIVE, etc.
+	AppType       string // GAME, SOFTWARE, DLC, DEMO (APP entitlements only)
 	Quantity      int32
 	GrantedAt     time.Time
 }
 
+// QueryUserEntitlements filter keys
+const (
+	FilterStatus           = "status"           // Exact status, e.g. ACTIVE or INACTIVE
+	FilterEntitlementClass = "entitlementClass" // ENTITLEMENT, APP, CODE, ...
+	FilterItemIDPrefix     = "itemIdPrefix"     // Item IDs starting with this
+	FilterAppType          = "appType"          // GAME, SOFTWARE, DLC, DEMO
+)
+
+// EntitlementPage is one page of a user's entitlements
+type EntitlementPage struct {
+	Entitlements []*Entitlement
+	NextOffset   int  // Offset of the next page
+	HasMore      bool // More entitlements follow this page
+	Total        int  // Matching entitlements across all pages, or -1 if unknown
+}
+
 // Wallet represents a user's currency wallet in AGS Platform
 type Wallet struct {
 	WalletID     string
@@ -23,18 +42,61 @@ type Wallet struct {
 	Status       string // ACTIVE, INACTIVE, etc.
 }
 
+// WalletTransaction represents a single credit or debit on a user's wallet
+type WalletTransaction struct {
+	CurrencyCode string
+	Action       string // CREDIT, DEBIT, PAYMENT
+	Amount       int64  // Signed: positive for credits, negative for debits
+	BalanceAfter int64  // Derived from the current balance (AGS does not return it)
+	Reason       string
+	CreatedAt    time.Time
+}
+
 // RewardVerifier queries user entitlements and wallets from AGS Platform
+// ctx cancels the query, including any retries, and its deadline bounds them.
 type RewardVerifier interface {
 	// GetUserEntitlement retrieves a single entitlement by item ID
-	GetUserEntitlement(itemID string) (*Entitlement, error)
+	GetUserEntitlement(ctx context.Context, itemID string) (*Entitlement, error)
 
 	// QueryUserEntitlements retrieves all entitlements for the user
-	// filters can include: status (ACTIVE/INACTIVE), entitlementClass (ENTITLEMENT/APP/CODE)
-	QueryUserEntitlements(filters map[string]string) ([]*Entitlement, error)
+	// filters are keyed by the Filter* constants; unknown keys are ignored
+	QueryUserEntitlements(ctx context.Context, filters map[string]string) ([]*Entitlement, error)
+
+	// QueryUserEntitlementsPage retrieves up to limit entitlements starting at offset
+	// Filters checked client-side may leave a page short; continue from NextOffset, not offset+limit.
+	QueryUserEntitlementsPage(ctx context.Context, filters map[string]string, offset, limit int) (*EntitlementPage, error)
 
 	// GetUserWallet retrieves a single wallet by currency code
-	GetUserWallet(currencyCode string) (*Wallet, error)
+	GetUserWallet(ctx context.Context, currencyCode string) (*Wallet, error)
 
 	// QueryUserWallets retrieves all wallets for the user
-	QueryUserWallets() ([]*Wallet, error)
+	QueryUserWallets(ctx context.Context) ([]*Wallet, error)
+
+	// QueryWalletTransactions retrieves the most recent transactions for a currency, newest first
+	QueryWalletTransactions(ctx context.Context, currencyCode string, limit int) ([]*WalletTransaction, error)
+}
+
+// applyBalanceAfter fills BalanceAfter by walking back from the current balance
+// Transactions must be ordered newest first.
+func applyBalanceAfter(txs []*WalletTransaction, currentBalance int64) {
+	balance := currentBalance
+	for _, tx := range txs {
+		tx.BalanceAfter = balance
+		balance -= tx.Amount
+	}
+}
+
+// matchesEntitlementFilters reports whether ent passes the status, item ID prefix, and app type
+// filters. Platform can't filter by these exactly, so both verifiers check them client-side.
+func matchesEntitlementFilters(ent *Entitlement, filters map[string]string) bool {
+	if status, ok := filters[FilterStatus]; ok && !strings.EqualFold(ent.Status, status) {
+		return false
+	}
+	if prefix, ok := filters[FilterItemIDPrefix]; ok && !strings.HasPrefix(ent.ItemID, prefix) {
+		return false
+	}
+	if appType, ok := filters[FilterAppType]; ok && !strings.EqualFold(ent.AppType, appType) {
+		return false
+	}
+	return true
 }
@@ -0,0 +1,191 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CachingAPIClient wraps an APIClient and serves repeated reads from memory for up to a TTL
+// Any mutating call (claim, initialize, set-active, select) clears the whole cache, so reads
+// after it always see the new state. Failed mutations clear it too, since they may still have
+// changed state (e.g. a claim that timed out after the backend granted it). Errors are never
+// cached. Safe for concurrent use.
+type CachingAPIClient struct {
+	APIClient
+	ttl time.Duration
+	now func() time.Time // Clock; replaced in tests
+
+	mu         sync.Mutex
+	entries    map[string]cacheEntry
+	generation uint64 // Bumped by Invalidate so in-flight reads don't store stale results
+}
+
+// cacheEntry is a cached read result
+type cacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+// NewCachingAPIClient wraps client, caching reads for ttl
+func NewCachingAPIClient(client APIClient, ttl time.Duration) *CachingAPIClient {
+	return &CachingAPIClient{
+		APIClient: client,
+		ttl:       ttl,
+		now:       time.Now,
+		entries:   make(map[string]cacheEntry),
+	}
+}
+
+// Invalidate drops every cached result
+// Call it after changing backend state outside the client, e.g. by triggering events.
+func (c *CachingAPIClient) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]cacheEntry)
+	c.generation++
+}
+
+// InvalidateCache drops the cached results of client if it is a CachingAPIClient
+// Pollers call it before each read, so they see changes made outside the client.
+func InvalidateCache(client APIClient) {
+	if cache, ok := client.(*CachingAPIClient); ok {
+		cache.Invalidate()
+	}
+}
+
+// EventSent drops the cached results of client after a gameplay event was delivered
+// Events change progress without an API call, so cached challenges are stale.
+func EventSent(client APIClient) {
+	InvalidateCache(client)
+}
+
+// ListChallenges lists challenges, from the cache if fresh
+func (c *CachingAPIClient) ListChallenges(ctx context.Context) ([]Challenge, error) {
+	return cached(c, "GET /v1/challenges", copyChallenges, func() ([]Challenge, error) {
+		return c.APIClient.ListChallenges(ctx)
+	})
+}
+
+// ListChallengesWithFilter lists challenges, from the cache if fresh
+func (c *CachingAPIClient) ListChallengesWithFilter(ctx context.Context, activeOnly bool) ([]Challenge, error) {
+	key := fmt.Sprintf("GET /v1/challenges?active_only=%t", activeOnly)
+	return cached(c, key, copyChallenges, func() ([]Challenge, error) {
+		return c.APIClient.ListChallengesWithFilter(ctx, activeOnly)
+	})
+}
+
+// GetChallenge gets a challenge, from the cache if fresh
+func (c *CachingAPIClient) GetChallenge(ctx context.Context, challengeID string) (*Challenge, error) {
+	return cached(c, "GET /v1/challenges/"+challengeID, copyChallenge, func() (*Challenge, error) {
+		return c.APIClient.GetChallenge(ctx, challengeID)
+	})
+}
+
+// GetClaimHistory gets the claim history, from the cache if fresh
+func (c *CachingAPIClient) GetClaimHistory(ctx context.Context, challengeID string) ([]ClaimRecord, error) {
+	copyClaims := func(claims []ClaimRecord) []ClaimRecord { return append([]ClaimRecord(nil), claims...) }
+	return cached(c, "GET /v1/claims?challenge="+challengeID, copyClaims, func() ([]ClaimRecord, error) {
+		return c.APIClient.GetClaimHistory(ctx, challengeID)
+	})
+}
+
+// GetRotationStatus gets the rotation status, from the cache if fresh
+func (c *CachingAPIClient) GetRotationStatus(ctx context.Context, challengeID string) (*RotationStatusResponse, error) {
+	copyStatus := func(status *RotationStatusResponse) *RotationStatusResponse {
+		copied := *status
+		return &copied
+	}
+	return cached(c, "GET /v1/challenges/"+challengeID+"/rotation", copyStatus, func() (*RotationStatusResponse, error) {
+		return c.APIClient.GetRotationStatus(ctx, challengeID)
+	})
+}
+
+// ClaimReward claims a reward and clears the cache
+func (c *CachingAPIClient) ClaimReward(ctx context.Context, challengeID, goalID string) (*ClaimResult, error) {
+	defer c.Invalidate()
+	return c.APIClient.ClaimReward(ctx, challengeID, goalID)
+}
+
+// BatchClaimRewards claims several rewards and clears the cache
+func (c *CachingAPIClient) BatchClaimRewards(ctx context.Context, challengeID string, goalIDs []string) (*BatchClaimResponse, error) {
+	defer c.Invalidate()
+	return c.APIClient.BatchClaimRewards(ctx, challengeID, goalIDs)
+}
+
+// InitializePlayer initializes the player and clears the cache
+func (c *CachingAPIClient) InitializePlayer(ctx context.Context) (*InitializeResponse, error) {
+	defer c.Invalidate()
+	return c.APIClient.InitializePlayer(ctx)
+}
+
+// SetGoalActive sets a goal's active state and clears the cache
+func (c *CachingAPIClient) SetGoalActive(ctx context.Context, challengeID, goalID string, isActive bool) (*SetGoalActiveResponse, error) {
+	defer c.Invalidate()
+	return c.APIClient.SetGoalActive(ctx, challengeID, goalID, isActive)
+}
+
+// BatchSelectGoals selects goals and clears the cache
+func (c *CachingAPIClient) BatchSelectGoals(ctx context.Context, challengeID string, req *BatchSelectRequest) (*BatchSelectResponse, error) {
+	defer c.Invalidate()
+	return c.APIClient.BatchSelectGoals(ctx, challengeID, req)
+}
+
+// RandomSelectGoals selects random goals and clears the cache
+func (c *CachingAPIClient) RandomSelectGoals(ctx context.Context, challengeID string, req *RandomSelectRequest) (*RandomSelectResponse, error) {
+	defer c.Invalidate()
+	return c.APIClient.RandomSelectGoals(ctx, challengeID, req)
+}
+
+// cached returns a copy of the fresh cached result for key, or fetches and caches it
+// A result fetched while the cache was invalidated is returned but not stored.
+func cached[T any](c *CachingAPIClient, key string, clone func(T) T, fetch func() (T, error)) (T, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	generation := c.generation
+	c.mu.Unlock()
+
+	if ok && c.now().Before(entry.expires) {
+		return clone(entry.value.(T)), nil
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		c.entries[key] = cacheEntry{value: value, expires: c.now().Add(c.ttl)}
+	}
+	c.mu.Unlock()
+
+	return clone(value), nil
+}
+
+// copyChallenges copies challenges and their goals, so callers can sort or edit them freely
+func copyChallenges(challenges []Challenge) []Challenge {
+	if challenges == nil {
+		return nil
+	}
+
+	copied := make([]Challenge, len(challenges))
+	for i := range challenges {
+		copied[i] = challenges[i]
+		copied[i].Goals = append([]Goal(nil), challenges[i].Goals...)
+	}
+	return copied
+}
+
+// copyChallenge copies a challenge and its goals
+func copyChallenge(challenge *Challenge) *Challenge {
+	copied := *challenge
+	copied.Goals = append([]Goal(nil), challenge.Goals...)
+	return &copied
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// countingAPIClient counts the reads that reach the mock backend
+type countingAPIClient struct {
+	*MockAPIClient
+	lists int
+	gets  int
+}
+
+func (c *countingAPIClient) ListChallenges(ctx context.Context) ([]Challenge, error) {
+	c.lists++
+	return c.MockAPIClient.ListChallenges(ctx)
+}
+
+func (c *countingAPIClient) GetChallenge(ctx context.Context, challengeID string) (*Challenge, error) {
+	c.gets++
+	return c.MockAPIClient.GetChallenge(ctx, challengeID)
+}
+
+func newCachingTestClient() (*CachingAPIClient, *countingAPIClient, *time.Time) {
+	inner := &countingAPIClient{MockAPIClient: NewMockAPIClient([]Challenge{
+		{ID: "daily", Goals: []Goal{{ID: "login", Status: "completed"}, {ID: "kills", Status: "in_progress"}}},
+	})}
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := NewCachingAPIClient(inner, 5*time.Second)
+	client.now = func() time.Time { return now }
+	return client, inner, &now
+}
+
+func TestCachingAPIClient_CachesReadsForTTL(t *testing.T) {
+	client, inner, now := newCachingTestClient()
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.ListChallenges(ctx); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if inner.lists != 1 {
+		t.Errorf("Expected 1 backend list within the TTL, got %d", inner.lists)
+	}
+
+	// Different endpoints and parameters are cached separately
+	_, _ = client.GetChallenge(ctx, "daily")
+	_, _ = client.GetChallenge(ctx, "daily")
+	if inner.gets != 1 {
+		t.Errorf("Expected 1 backend get within the TTL, got %d", inner.gets)
+	}
+
+	*now = now.Add(5 * time.Second)
+	_, _ = client.ListChallenges(ctx)
+	if inner.lists != 2 {
+		t.Errorf("Expected a refetch once the TTL passed, got %d backend lists", inner.lists)
+	}
+}
+
+func TestCachingAPIClient_InvalidatesAfterClaim(t *testing.T) {
+	client, inner, _ := newCachingTestClient()
+	ctx := context.Background()
+
+	before, _ := client.GetChallenge(ctx, "daily")
+	if before.Goals[0].Status != "completed" {
+		t.Fatalf("Expected login completed before the claim, got %s", before.Goals[0].Status)
+	}
+	_, _ = client.ListChallenges(ctx)
+
+	if _, err := client.ClaimReward(ctx, "daily", "login"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	after, _ := client.GetChallenge(ctx, "daily")
+	if after.Goals[0].Status != "claimed" {
+		t.Errorf("Expected login claimed after the claim, got %s", after.Goals[0].Status)
+	}
+	challenges, _ := client.ListChallenges(ctx)
+	if challenges[0].Goals[0].Status != "claimed" {
+		t.Errorf("Expected the listed login goal claimed after the claim, got %s", challenges[0].Goals[0].Status)
+	}
+	if inner.gets != 2 || inner.lists != 2 {
+		t.Errorf("Expected every read refetched after the claim, got %d gets and %d lists", inner.gets, inner.lists)
+	}
+
+	// A failed claim clears the cache too
+	_, _ = client.ListChallenges(ctx)
+	if _, err := client.ClaimReward(ctx, "daily", "login"); err == nil {
+		t.Fatal("Expected claiming twice to fail")
+	}
+	_, _ = client.ListChallenges(ctx)
+	if inner.lists != 3 {
+		t.Errorf("Expected a refetch after the failed claim, got %d backend lists", inner.lists)
+	}
+}
+
+func TestCachingAPIClient_InvalidatesAfterSelection(t *testing.T) {
+	client, inner, _ := newCachingTestClient()
+	ctx := context.Background()
+
+	_, _ = client.ListChallenges(ctx)
+	if _, err := client.SetGoalActive(ctx, "daily", "kills", true); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	challenges, _ := client.ListChallenges(ctx)
+	if !challenges[0].Goals[1].IsActive {
+		t.Error("Expected kills active after set-active")
+	}
+
+	client.Invalidate()
+	_, _ = client.ListChallenges(ctx)
+	if inner.lists != 3 {
+		t.Errorf("Expected a refetch after each invalidation, got %d backend lists", inner.lists)
+	}
+}
+
+func TestCachingAPIClient_ReturnsCopies(t *testing.T) {
+	client, _, _ := newCachingTestClient()
+	ctx := context.Background()
+
+	first, _ := client.ListChallenges(ctx)
+	first[0].Goals[0].Status = "edited"
+
+	second, _ := client.ListChallenges(ctx)
+	if second[0].Goals[0].Status != "completed" {
+		t.Errorf("Expected the cached goal unchanged by callers, got %s", second[0].Goals[0].Status)
+	}
+}
+
+func TestCachingAPIClient_DoesNotCacheErrors(t *testing.T) {
+	client, inner, _ := newCachingTestClient()
+	ctx := context.Background()
+
+	inner.Error = errors.New("connection refused")
+	if _, err := client.ListChallenges(ctx); err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	inner.Error = nil
+	if _, err := client.ListChallenges(ctx); err != nil {
+		t.Errorf("Expected the failed read to be retried, got %v", err)
+	}
+	if inner.lists != 2 {
+		t.Errorf("Expected 2 backend lists, got %d", inner.lists)
+	}
+}
+
+func TestInvalidateCache(t *testing.T) {
+	client, inner, _ := newCachingTestClient()
+	ctx := context.Background()
+
+	_, _ = client.ListChallenges(ctx)
+	InvalidateCache(client)
+	_, _ = client.ListChallenges(ctx)
+	if inner.lists != 2 {
+		t.Errorf("Expected 2 backend lists after invalidation, got %d", inner.lists)
+	}
+
+	// Uncached clients are left alone
+	InvalidateCache(inner)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+// IsClaimable reports whether the goal is completed and its reward not yet claimed
+func (g *Goal) IsClaimable() bool {
+	return g.Status == "completed"
+}
+
+// ClaimableGoals returns the number of goals whose reward can be claimed
+func (c *Challenge) ClaimableGoals() int {
+	claimable := 0
+	for i := range c.Goals {
+		if c.Goals[i].IsClaimable() {
+			claimable++
+		}
+	}
+	return claimable
+}
+
+// FilterClaimable returns the challenges with at least one claimable goal, in their original order
+// Shared by list-challenges --only-claimable and the TUI quick filter.
+func FilterClaimable(challenges []Challenge) []Challenge {
+	filtered := make([]Challenge, 0, len(challenges))
+	for i := range challenges {
+		if challenges[i].ClaimableGoals() > 0 {
+			filtered = append(filtered, challenges[i])
+		}
+	}
+	return filtered
+}
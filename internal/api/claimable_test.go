@@ -0,0 +1,34 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"testing"
+)
+
+func TestFilterClaimable(t *testing.T) {
+	challenges := []Challenge{
+		{ID: "all-claimed", Goals: []Goal{{ID: "g1", Status: "claimed"}}},
+		{ID: "claimable", Goals: []Goal{{ID: "g2", Status: "claimed"}, {ID: "g3", Status: "completed"}, {ID: "g4", Status: "completed"}}},
+		{ID: "in-progress", Goals: []Goal{{ID: "g5", Status: "in_progress"}, {ID: "g6", Status: "not_started"}}},
+		{ID: "empty"},
+		{ID: "also-claimable", Goals: []Goal{{ID: "g7", Status: "completed"}}},
+	}
+
+	filtered := FilterClaimable(challenges)
+
+	if len(filtered) != 2 || filtered[0].ID != "claimable" || filtered[1].ID != "also-claimable" {
+		t.Fatalf("Expected [claimable also-claimable], got %+v", filtered)
+	}
+	if n := filtered[0].ClaimableGoals(); n != 2 {
+		t.Errorf("Expected 2 claimable goals, got %d", n)
+	}
+	if len(challenges) != 5 {
+		t.Errorf("Expected the input left unchanged, got %d challenges", len(challenges))
+	}
+	if len(FilterClaimable(nil)) != 0 {
+		t.Error("Expected no challenges from nil input")
+	}
+}
@@ -8,12 +8,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
 )
 
 // APIClient defines the interface for interacting with the Challenge Service API
@@ -23,9 +27,12 @@ type APIClient interface {
 	ListChallengesWithFilter(ctx context.Context, activeOnly bool) ([]Challenge, error)
 	GetChallenge(ctx context.Context, challengeID string) (*Challenge, error)
 	ClaimReward(ctx context.Context, challengeID, goalID string) (*ClaimResult, error)
+	GetClaimHistory(ctx context.Context, challengeID string) ([]ClaimRecord, error)
+	BatchClaimRewards(ctx context.Context, challengeID string, goalIDs []string) (*BatchClaimResponse, error) // ErrBatchClaimUnsupported on older backends
 
 	// M3 endpoints
 	InitializePlayer(ctx context.Context) (*InitializeResponse, error)
+	PreviewInitializePlayer(ctx context.Context) (*InitializeResponse, error)
 	SetGoalActive(ctx context.Context, challengeID, goalID string, isActive bool) (*SetGoalActiveResponse, error)
 
 	// M4 endpoints
@@ -40,14 +47,25 @@ type APIClient interface {
 	GetLastResponse() *ResponseDebugInfo
 }
 
+// ErrBatchClaimUnsupported means the backend has no batch-claim endpoint
+var ErrBatchClaimUnsupported = errors.New("batch claim not supported by the backend")
+
+// LevelTrace is the log level below debug at which request and response bodies are logged
+const LevelTrace = slog.LevelDebug - 4
+
 // HTTPAPIClient implements APIClient using net/http
 type HTTPAPIClient struct {
 	baseURL      string
 	httpClient   *http.Client
 	authProvider auth.AuthProvider
-	userID       string // User ID for mock authentication header
-
-	// Debug instrumentation
+	userID       string          // User ID for mock authentication header
+	userMu       sync.RWMutex    // Protects userID; the TUI switches users while requests run
+	logger       *slog.Logger    // Request logging; nil uses slog.Default()
+	observer     RequestObserver // Per-attempt metrics hook; nil records nothing
+	requestID    string          // Pinned X-Request-ID; empty generates one per request
+
+	// Debug instrumentation; calls may run concurrently (e.g. TUI prefetch, claim-batch --parallel)
+	debugMu      sync.Mutex
 	lastRequest  *RequestDebugInfo
 	lastResponse *ResponseDebugInfo
 }
@@ -64,16 +82,45 @@ func NewHTTPAPIClient(baseURL string, authProvider auth.AuthProvider) *HTTPAPICl
 
 // SetUserID sets the user ID for mock authentication (used when backend auth is disabled)
 func (c *HTTPAPIClient) SetUserID(userID string) {
+	c.userMu.Lock()
+	defer c.userMu.Unlock()
 	c.userID = userID
 }
 
+// SetLogger sets the logger for request timing (debug) and bodies (LevelTrace)
+func (c *HTTPAPIClient) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+// SetRequestID pins the X-Request-ID sent with every request (empty generates one per request)
+func (c *HTTPAPIClient) SetRequestID(requestID string) {
+	c.requestID = requestID
+}
+
+// SetObserver sets the hook notified after every HTTP attempt, e.g. to record metrics
+func (c *HTTPAPIClient) SetObserver(observer RequestObserver) {
+	c.observer = observer
+}
+
+// log returns the configured logger, or the default logger at call time (so --verbosity applies)
+func (c *HTTPAPIClient) log() *slog.Logger {
+	if c.logger != nil {
+		return c.logger
+	}
+	return slog.Default()
+}
+
 // GetLastRequest returns the last recorded request for debugging
 func (c *HTTPAPIClient) GetLastRequest() *RequestDebugInfo {
+	c.debugMu.Lock()
+	defer c.debugMu.Unlock()
 	return c.lastRequest
 }
 
 // GetLastResponse returns the last recorded response for debugging
 func (c *HTTPAPIClient) GetLastResponse() *ResponseDebugInfo {
+	c.debugMu.Lock()
+	defer c.debugMu.Unlock()
 	return c.lastResponse
 }
 
@@ -146,11 +193,89 @@ func (c *HTTPAPIClient) ClaimReward(ctx context.Context, challengeID, goalID str
 	return &result, nil
 }
 
+// BatchClaimRewards claims the rewards of several completed goals of a challenge in one request
+// Each goal gets its own result, so some claims can fail while others succeed. Backends
+// without the endpoint return ErrBatchClaimUnsupported; callers should fall back to ClaimReward.
+func (c *HTTPAPIClient) BatchClaimRewards(ctx context.Context, challengeID string, goalIDs []string) (*BatchClaimResponse, error) {
+	path := fmt.Sprintf("/v1/challenges/%s/goals/batch-claim", challengeID)
+	resp, err := c.doRequest(ctx, "POST", path, &BatchClaimRequest{GoalIDs: goalIDs})
+	if err != nil {
+		return nil, batchClaimError(fmt.Errorf("batch claim rewards: %w", err))
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if err := c.checkStatusCode(resp); err != nil {
+		return nil, batchClaimError(err)
+	}
+
+	var result BatchClaimResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// batchClaimError marks err with ErrBatchClaimUnsupported if its status means the route does not exist
+// A 404 can also be a missing challenge, but falling back to ClaimReward reports that per goal.
+// 501 arrives after the retries of a 5xx, so it is checked on the doRequest error too.
+func batchClaimError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	switch apiErr.Code {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return fmt.Errorf("%w: %w", ErrBatchClaimUnsupported, err)
+	}
+	return err
+}
+
+// GetClaimHistory retrieves the user's claimed rewards for a challenge, or across all challenges if challengeID is empty
+func (c *HTTPAPIClient) GetClaimHistory(ctx context.Context, challengeID string) ([]ClaimRecord, error) {
+	path := "/v1/claims"
+	if challengeID != "" {
+		path = fmt.Sprintf("/v1/challenges/%s/claims", challengeID)
+	}
+
+	resp, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get claim history: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if err := c.checkStatusCode(resp); err != nil {
+		return nil, err
+	}
+
+	var response GetClaimHistoryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	return response.Claims, nil
+}
+
 // M3: InitializePlayer initializes player goals with default assignments
 func (c *HTTPAPIClient) InitializePlayer(ctx context.Context) (*InitializeResponse, error) {
+	return c.initializePlayer(ctx, "/v1/challenges/initialize")
+}
+
+// M3: PreviewInitializePlayer reports the goals InitializePlayer would assign without committing them
+func (c *HTTPAPIClient) PreviewInitializePlayer(ctx context.Context) (*InitializeResponse, error) {
+	return c.initializePlayer(ctx, "/v1/challenges/initialize?dry_run=true")
+}
+
+// initializePlayer posts to the initialize endpoint at path
+func (c *HTTPAPIClient) initializePlayer(ctx context.Context, path string) (*InitializeResponse, error) {
 	// Send empty JSON object as body (required by gRPC-Gateway)
 	emptyBody := map[string]interface{}{}
-	resp, err := c.doRequest(ctx, "POST", "/v1/challenges/initialize", emptyBody)
+	resp, err := c.doRequest(ctx, "POST", path, emptyBody)
 	if err != nil {
 		return nil, fmt.Errorf("initialize player: %w", err)
 	}
@@ -318,9 +443,19 @@ func (c *HTTPAPIClient) doRequest(ctx context.Context, method, path string, body
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
+	// Tag the request (and its retries) so it can be found in the backend logs
+	requestID := c.requestID
+	if requestID == "" {
+		requestID = newRequestID()
+	}
+	req.Header.Set(RequestIDHeader, requestID)
+
 	// Set mock user ID header if configured (for testing with auth disabled)
-	if c.userID != "" {
-		req.Header.Set("x-mock-user-id", c.userID)
+	c.userMu.RLock()
+	userID := c.userID
+	c.userMu.RUnlock()
+	if userID != "" {
+		req.Header.Set("x-mock-user-id", userID)
 	}
 
 	// Get auth token
@@ -332,44 +467,93 @@ func (c *HTTPAPIClient) doRequest(ctx context.Context, method, path string, body
 
 	// Record request for debug mode
 	c.recordRequest(req, bodyStr)
+	logger := c.log()
+	logger.Log(ctx, LevelTrace, "HTTP request", "method", method, "url", url, "body", bodyStr)
 
 	// Perform request with retry
 	var resp *http.Response
 	var lastErr error
+	var attemptDurations []time.Duration
 
 	maxRetries := 3
 	for attempt := 0; attempt < maxRetries; attempt++ {
 		if attempt > 0 {
 			// Exponential backoff: 1s, 2s, 4s
 			backoff := time.Duration(1<<uint(attempt-1)) * time.Second
-			time.Sleep(backoff)
+
+			// Don't sleep into the caller's deadline; the retry could never finish in time
+			if !retryFitsDeadline(ctx, backoff) {
+				logger.Debug("HTTP retry skipped, deadline too close", "method", method, "url", url, "attempts", attempt, "backoff_ms", backoff.Milliseconds())
+				return nil, withRequestID(fmt.Errorf("request failed after %d attempts (deadline reached): %w", attempt, lastErr), requestID)
+			}
+			select {
+			case <-ctx.Done():
+				return nil, withRequestID(fmt.Errorf("request failed after %d attempts (%w): %w", attempt, ctx.Err(), lastErr), requestID)
+			case <-time.After(backoff):
+			}
 		}
 
 		startTime := time.Now()
 		resp, lastErr = c.httpClient.Do(req)
 		duration := time.Since(startTime)
+		attemptDurations = append(attemptDurations, duration)
+		c.observe(method, path, resp, duration, lastErr)
 
 		if lastErr != nil {
+			logger.Debug("HTTP request failed", "method", method, "url", url, "attempt", attempt+1, "duration_ms", duration.Milliseconds(), "error", lastErr)
+			if !retry.IsRetryable(lastErr) {
+				return nil, withRequestID(fmt.Errorf("request failed: %w", lastErr), requestID)
+			}
 			continue
 		}
 
 		// Record response for debug mode
-		c.recordResponse(resp, duration)
+		c.recordResponse(resp, attemptDurations)
+		logger.Debug("HTTP response", "method", method, "url", url, "status", resp.StatusCode, "attempt", attempt+1, "duration_ms", duration.Milliseconds())
+		if logger.Enabled(ctx, LevelTrace) {
+			c.logResponseBody(ctx, logger, resp)
+		}
 
 		// Check status code
-		if resp.StatusCode >= 500 {
-			// Server error, retry
+		if retry.IsRetryableStatus(resp.StatusCode) {
+			// Server error or rate limit, retry
+			lastErr = c.checkStatusCode(resp)
 			_ = resp.Body.Close()
-			lastErr = fmt.Errorf("server error: status %d", resp.StatusCode)
 			continue
 		}
 
 		// Success or client error (don't retry)
+		if attempt > 0 {
+			logger.Info("HTTP request retried", "method", method, "url", url, "status", resp.StatusCode, "attempts", attempt+1, "attempt_durations_ms", durationsMs(attemptDurations))
+		}
 		return resp, nil
 	}
 
 	// All retries exhausted
-	return nil, fmt.Errorf("request failed after %d attempts: %w", maxRetries, lastErr)
+	return nil, withRequestID(fmt.Errorf("request failed after %d attempts: %w", maxRetries, lastErr), requestID)
+}
+
+// observe reports one attempt to the observer, if any
+func (c *HTTPAPIClient) observe(method, path string, resp *http.Response, duration time.Duration, err error) {
+	if c.observer == nil {
+		return
+	}
+
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	c.observer.ObserveRequest(method, RouteTemplate(path), statusCode, duration, err)
+}
+
+// retryFitsDeadline reports whether ctx leaves time for a retry after backoff
+// Contexts without a deadline always do; cancelled ones never do.
+func retryFitsDeadline(ctx context.Context, backoff time.Duration) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	deadline, ok := ctx.Deadline()
+	return !ok || time.Until(deadline) > backoff
 }
 
 // checkStatusCode checks if the response status code is OK
@@ -380,7 +564,89 @@ func (c *HTTPAPIClient) checkStatusCode(resp *http.Response) error {
 
 	// Read error response body
 	bodyBytes, _ := io.ReadAll(resp.Body)
-	return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(bodyBytes))
+	apiErr := newAPIError(resp.StatusCode, bodyBytes)
+	if resp.Request != nil {
+		apiErr.RequestID = resp.Request.Header.Get(RequestIDHeader)
+	}
+	return apiErr
+}
+
+// APIError is returned for non-2xx responses from the Challenge API
+// Callers can branch on Code (e.g. 404 vs 409) with errors.As.
+type APIError struct {
+	Code     int               // HTTP status code
+	Body     string            // Raw response body
+	GRPCCode int               // gRPC status code from the gateway error envelope
+	Message  string            // Message from the gateway error envelope; empty if the body isn't one
+	Details  []json.RawMessage // Details from the gateway error envelope
+
+	RequestID string // X-Request-ID of the failed request, for support tickets
+}
+
+// gRPC status codes carried in APIError.GRPCCode
+const (
+	GRPCCodeNotFound           = 5 // HTTP 404
+	GRPCCodeAlreadyExists      = 6 // HTTP 409
+	GRPCCodeFailedPrecondition = 9 // HTTP 400, e.g. claiming a goal that is not completed
+)
+
+// gatewayError is the error envelope written by gRPC-gateway
+type gatewayError struct {
+	Code    int               `json:"code"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details"`
+}
+
+// newAPIError creates an APIError, parsing the gateway error envelope out of body if present
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{Code: statusCode, Body: string(body)}
+
+	var envelope gatewayError
+	if err := json.Unmarshal(body, &envelope); err == nil && envelope.Message != "" {
+		apiErr.GRPCCode = envelope.Code
+		apiErr.Message = envelope.Message
+		apiErr.Details = envelope.Details
+	}
+
+	return apiErr
+}
+
+// Error implements the error interface
+// Gateway errors read "HTTP 404: challenge not found (code 5)"; other bodies are shown raw,
+// and an empty body shows the status text. The request ID, if known, is appended.
+func (e *APIError) Error() string {
+	var msg string
+	if e.Message == "" {
+		body := e.Body
+		if body == "" {
+			body = http.StatusText(e.Code)
+		}
+		msg = fmt.Sprintf("HTTP %d: %s", e.Code, body)
+	} else {
+		msg = fmt.Sprintf("HTTP %d: %s (code %d)", e.Code, e.Message, e.GRPCCode)
+	}
+
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" [request ID %s]", e.RequestID)
+	}
+	return msg
+}
+
+// StatusCode returns the HTTP status code (implements retry.StatusCoder)
+func (e *APIError) StatusCode() int {
+	return e.Code
+}
+
+// logResponseBody logs the response body at LevelTrace, leaving resp.Body readable for the caller
+func (c *HTTPAPIClient) logResponseBody(ctx context.Context, logger *slog.Logger, resp *http.Response) {
+	bodyBytes, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	if err != nil {
+		logger.Log(ctx, LevelTrace, "HTTP response body unreadable", "error", err)
+		return
+	}
+	logger.Log(ctx, LevelTrace, "HTTP response body", "status", resp.StatusCode, "body", string(bodyBytes))
 }
 
 // recordRequest stores request details for debugging
@@ -392,16 +658,22 @@ func (c *HTTPAPIClient) recordRequest(req *http.Request, body string) {
 		}
 	}
 
-	c.lastRequest = &RequestDebugInfo{
-		Method:  req.Method,
-		URL:     req.URL.String(),
-		Headers: headers,
-		Body:    body,
+	info := &RequestDebugInfo{
+		Method:    req.Method,
+		URL:       req.URL.String(),
+		Headers:   headers,
+		Body:      body,
+		RequestID: req.Header.Get(RequestIDHeader),
 	}
+
+	c.debugMu.Lock()
+	c.lastRequest = info
+	c.debugMu.Unlock()
 }
 
 // recordResponse stores response details for debugging
-func (c *HTTPAPIClient) recordResponse(resp *http.Response, duration time.Duration) {
+// attemptDurations holds every attempt so far; the last one produced resp.
+func (c *HTTPAPIClient) recordResponse(resp *http.Response, attemptDurations []time.Duration) {
 	headers := make(map[string]string)
 	for key, values := range resp.Header {
 		if len(values) > 0 {
@@ -413,10 +685,25 @@ func (c *HTTPAPIClient) recordResponse(resp *http.Response, duration time.Durati
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 
-	c.lastResponse = &ResponseDebugInfo{
-		StatusCode: resp.StatusCode,
-		Headers:    headers,
-		Body:       string(bodyBytes),
-		Duration:   duration,
+	info := &ResponseDebugInfo{
+		StatusCode:       resp.StatusCode,
+		Headers:          headers,
+		Body:             string(bodyBytes),
+		Duration:         attemptDurations[len(attemptDurations)-1],
+		Attempts:         len(attemptDurations),
+		AttemptDurations: append([]time.Duration(nil), attemptDurations...),
+	}
+
+	c.debugMu.Lock()
+	c.lastResponse = info
+	c.debugMu.Unlock()
+}
+
+// durationsMs converts attempt durations to milliseconds for logging
+func durationsMs(durations []time.Duration) []int64 {
+	ms := make([]int64, len(durations))
+	for i, d := range durations {
+		ms[i] = d.Milliseconds()
 	}
+	return ms
 }
@@ -7,9 +7,13 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
 )
@@ -180,6 +184,201 @@ func TestHTTPAPIClient_ClaimReward(t *testing.T) {
 	}
 }
 
+func TestHTTPAPIClient_BatchClaimRewards(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/challenges/c1/goals/batch-claim" {
+			t.Errorf("Expected POST /v1/challenges/c1/goals/batch-claim, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req BatchClaimRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || strings.Join(req.GoalIDs, ",") != "g1,g2" {
+			t.Errorf("Expected goal_ids [g1 g2], got %+v (%v)", req, err)
+		}
+
+		_, _ = w.Write([]byte(`{"challengeId":"c1","results":[` +
+			`{"goalId":"g1","status":"claimed","reward":{"type":"ITEM","rewardId":"sword","quantity":1}},` +
+			`{"goalId":"g2","error":"goal g2 is not completed"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPAPIClient(server.URL, mockAuth)
+	result, err := client.BatchClaimRewards(context.Background(), "c1", []string{"g1", "g2"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(result.Results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(result.Results))
+	}
+	if r := result.Results[0]; r.GoalID != "g1" || r.Status != "claimed" || r.Reward.RewardID != "sword" || r.Error != "" {
+		t.Errorf("Expected g1 claimed, got %+v", r)
+	}
+	if r := result.Results[1]; r.GoalID != "g2" || r.Error != "goal g2 is not completed" {
+		t.Errorf("Expected g2 to fail, got %+v", r)
+	}
+}
+
+func TestHTTPAPIClient_BatchClaimRewards_Unsupported(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+
+	tests := []struct {
+		status          int
+		wantUnsupported bool
+	}{
+		{http.StatusNotFound, true},
+		{http.StatusMethodNotAllowed, true},
+		{http.StatusBadRequest, false},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			_, _ = w.Write([]byte(`{"code":5,"message":"Not Found"}`))
+		}))
+
+		client := NewHTTPAPIClient(server.URL, mockAuth)
+		_, err := client.BatchClaimRewards(context.Background(), "c1", []string{"g1"})
+		server.Close()
+
+		if err == nil {
+			t.Fatalf("HTTP %d: expected an error", tt.status)
+		}
+		if got := errors.Is(err, ErrBatchClaimUnsupported); got != tt.wantUnsupported {
+			t.Errorf("HTTP %d: expected unsupported %v, got %v (%v)", tt.status, tt.wantUnsupported, got, err)
+		}
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != tt.status {
+			t.Errorf("HTTP %d: expected the APIError to be kept, got %v", tt.status, err)
+		}
+	}
+}
+
+func TestHTTPAPIClient_RandomSelectGoals_Seed(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+
+	tests := []struct {
+		name     string
+		seed     int64
+		wantSeed bool
+	}{
+		{name: "seed sent when set", seed: 42, wantSeed: true},
+		{name: "seed omitted when zero", seed: 0, wantSeed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("Failed to decode request body: %v", err)
+				}
+
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(RandomSelectResponse{ChallengeID: "c1"})
+			}))
+			defer server.Close()
+
+			client := NewHTTPAPIClient(server.URL, mockAuth)
+			_, err := client.RandomSelectGoals(context.Background(), "c1", &RandomSelectRequest{Count: 2, Seed: tt.seed})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			seed, ok := body["seed"]
+			if ok != tt.wantSeed {
+				t.Fatalf("Expected seed present=%v, got body %v", tt.wantSeed, body)
+			}
+			if ok && seed != float64(tt.seed) {
+				t.Errorf("Expected seed %d, got %v", tt.seed, seed)
+			}
+		})
+	}
+}
+
+func TestHTTPAPIClient_InitializePlayer_DryRun(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+
+	tests := []struct {
+		name      string
+		call      func(c *HTTPAPIClient) (*InitializeResponse, error)
+		wantQuery string
+	}{
+		{name: "initialize commits", call: func(c *HTTPAPIClient) (*InitializeResponse, error) {
+			return c.InitializePlayer(context.Background())
+		}, wantQuery: ""},
+		{name: "preview is a dry run", call: func(c *HTTPAPIClient) (*InitializeResponse, error) {
+			return c.PreviewInitializePlayer(context.Background())
+		}, wantQuery: "dry_run=true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/v1/challenges/initialize" {
+					t.Errorf("Expected POST /v1/challenges/initialize, got %s %s", r.Method, r.URL.Path)
+				}
+				if r.URL.RawQuery != tt.wantQuery {
+					t.Errorf("Expected query %q, got %q", tt.wantQuery, r.URL.RawQuery)
+				}
+
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(InitializeResponse{NewAssignments: 2, TotalActive: 3})
+			}))
+			defer server.Close()
+
+			result, err := tt.call(NewHTTPAPIClient(server.URL, mockAuth))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.NewAssignments != 2 || result.TotalActive != 3 {
+				t.Errorf("Expected 2 new of 3 active, got %+v", result)
+			}
+		})
+	}
+}
+
+func TestHTTPAPIClient_GetClaimHistory(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+
+	tests := []struct {
+		name        string
+		challengeID string
+		wantPath    string
+	}{
+		{name: "all challenges", challengeID: "", wantPath: "/v1/claims"},
+		{name: "single challenge", challengeID: "daily", wantPath: "/v1/challenges/daily/claims"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != tt.wantPath {
+					t.Errorf("Expected GET %s, got %s %s", tt.wantPath, r.Method, r.URL.Path)
+				}
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"claims":[{"challengeId":"daily","goalId":"login","reward":{"type":"WALLET","rewardId":"GOLD","quantity":50},"claimedAt":"2025-03-01T08:00:00Z"}]}`))
+			}))
+			defer server.Close()
+
+			records, err := NewHTTPAPIClient(server.URL, mockAuth).GetClaimHistory(context.Background(), tt.challengeID)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(records) != 1 {
+				t.Fatalf("Expected 1 record, got %d", len(records))
+			}
+			r := records[0]
+			if r.ChallengeID != "daily" || r.GoalID != "login" || r.Reward.RewardID != "GOLD" || r.ClaimedAt != "2025-03-01T08:00:00Z" {
+				t.Errorf("Unexpected record: %+v", r)
+			}
+		})
+	}
+}
+
 func TestHTTPAPIClient_GetLastRequest(t *testing.T) {
 	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -225,3 +424,232 @@ func TestHTTPAPIClient_GetLastResponse(t *testing.T) {
 		t.Errorf("Expected status code 200, got %d", lastResponse.StatusCode)
 	}
 }
+
+func TestHTTPAPIClient_GetLastResponse_Attempts(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"challenges":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPAPIClient(server.URL, mockAuth)
+
+	// The first attempt 503s and is retried after a 1s backoff
+	if _, err := client.ListChallenges(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	lastResponse := client.GetLastResponse()
+	if lastResponse == nil {
+		t.Fatal("Expected non-nil lastResponse")
+	}
+
+	if lastResponse.StatusCode != http.StatusOK {
+		t.Errorf("Expected status code 200, got %d", lastResponse.StatusCode)
+	}
+	if lastResponse.Attempts != 2 || len(lastResponse.AttemptDurations) != 2 {
+		t.Fatalf("Expected 2 attempts, got %d (%v)", lastResponse.Attempts, lastResponse.AttemptDurations)
+	}
+	if lastResponse.Duration != lastResponse.AttemptDurations[1] {
+		t.Errorf("Expected Duration to be the last attempt's, got %v", lastResponse.Duration)
+	}
+	if lastResponse.TotalDuration() < lastResponse.Duration {
+		t.Errorf("Expected total duration to include every attempt, got %v", lastResponse.TotalDuration())
+	}
+}
+
+func TestHTTPAPIClient_RetryStopsAtDeadline(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewHTTPAPIClient(server.URL, mockAuth)
+
+	// The 1s backoff before the first retry doesn't fit in the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.ListChallenges(ctx)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected the last 503 error, got %v", err)
+	}
+	if n := strings.Count(err.Error(), "[request ID "); n != 1 {
+		t.Errorf("Expected the request ID once in the error, got %q", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected 1 attempt, got %d", calls.Load())
+	}
+	if elapsed >= 300*time.Millisecond {
+		t.Errorf("Expected to return before the deadline, took %v", elapsed)
+	}
+}
+
+func TestHTTPAPIClient_RetriesExhausted(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"code":14,"message":"unavailable"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPAPIClient(server.URL, mockAuth)
+	client.SetRequestID("req-1")
+
+	// Every attempt 503s, with 1s and 2s backoffs between them
+	_, err := client.ListChallenges(context.Background())
+
+	want := "list challenges: request failed after 3 attempts: HTTP 503: unavailable (code 14) [request ID req-1]"
+	if err == nil || err.Error() != want {
+		t.Errorf("Expected error %q, got %v", want, err)
+	}
+	if got := RequestID(err); got != "req-1" {
+		t.Errorf("Expected request ID req-1, got %q", got)
+	}
+}
+
+func TestHTTPAPIClient_APIError(t *testing.T) {
+	tests := []struct {
+		name         string
+		statusCode   int
+		body         string
+		wantMessage  string
+		wantGRPCCode int
+		wantError    string
+	}{
+		{
+			name:         "not found",
+			statusCode:   http.StatusNotFound,
+			body:         `{"code":5,"message":"challenge not found","details":[]}`,
+			wantMessage:  "challenge not found",
+			wantGRPCCode: 5,
+			wantError:    "HTTP 404: challenge not found (code 5) [request ID req-1]",
+		},
+		{
+			name:         "conflict with details",
+			statusCode:   http.StatusConflict,
+			body:         `{"code":6,"message":"goal already claimed","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","reason":"ALREADY_CLAIMED"}]}`,
+			wantMessage:  "goal already claimed",
+			wantGRPCCode: 6,
+			wantError:    "HTTP 409: goal already claimed (code 6) [request ID req-1]",
+		},
+		{
+			name:       "plain text body",
+			statusCode: http.StatusUnauthorized,
+			body:       "Jwt is expired",
+			wantError:  "HTTP 401: Jwt is expired [request ID req-1]",
+		},
+		{
+			name:       "JSON without message",
+			statusCode: http.StatusBadRequest,
+			body:       `{"error":"bad request"}`,
+			wantError:  `HTTP 400: {"error":"bad request"} [request ID req-1]`,
+		},
+		{
+			name:       "empty body",
+			statusCode: http.StatusForbidden,
+			wantError:  "HTTP 403: Forbidden [request ID req-1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewHTTPAPIClient(server.URL, auth.NewMockAuthProvider("test-user", "demo"))
+			client.SetRequestID("req-1")
+			_, err := client.GetChallenge(context.Background(), "daily")
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected APIError, got %v", err)
+			}
+			if apiErr.Code != tt.statusCode {
+				t.Errorf("Expected status %d, got %d", tt.statusCode, apiErr.Code)
+			}
+			if apiErr.Message != tt.wantMessage || apiErr.GRPCCode != tt.wantGRPCCode {
+				t.Errorf("Expected message %q (code %d), got %q (code %d)", tt.wantMessage, tt.wantGRPCCode, apiErr.Message, apiErr.GRPCCode)
+			}
+			if apiErr.Body != tt.body {
+				t.Errorf("Expected raw body %q, got %q", tt.body, apiErr.Body)
+			}
+			if apiErr.Error() != tt.wantError {
+				t.Errorf("Expected error %q, got %q", tt.wantError, apiErr.Error())
+			}
+		})
+	}
+}
+
+func TestHTTPAPIClient_RequestID(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get(RequestIDHeader))
+		_, _ = w.Write([]byte(`{"challenges":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPAPIClient(server.URL, auth.NewMockAuthProvider("test-user", "demo"))
+
+	// Each request gets its own ID, recorded for debugging
+	for i := 0; i < 2; i++ {
+		if _, err := client.ListChallenges(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if len(seen) != 2 || len(seen[0]) != 32 || seen[0] == seen[1] {
+		t.Errorf("Expected two distinct generated request IDs, got %q", seen)
+	}
+	if got := client.GetLastRequest().RequestID; got != seen[1] {
+		t.Errorf("Expected the last request ID %q recorded, got %q", seen[1], got)
+	}
+
+	// A pinned ID is sent as is
+	client.SetRequestID("ticket-1234")
+	if _, err := client.ListChallenges(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if seen[2] != "ticket-1234" {
+		t.Errorf("Expected the pinned request ID, got %q", seen[2])
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	// Requests that get no response carry the ID in their error too
+	client := NewHTTPAPIClient("http://127.0.0.1:0", auth.NewMockAuthProvider("test-user", "demo"))
+	client.SetRequestID("req-2")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // Fail the first attempt without retrying
+	_, err := client.ListChallenges(ctx)
+
+	if got := RequestID(err); got != "req-2" {
+		t.Errorf("Expected request ID req-2, got %q from %v", got, err)
+	}
+	if !strings.Contains(err.Error(), "[request ID req-2]") {
+		t.Errorf("Expected the request ID in the error message, got %q", err)
+	}
+	if got := RequestID(errors.New("unrelated")); got != "" {
+		t.Errorf("Expected no request ID for other errors, got %q", got)
+	}
+}
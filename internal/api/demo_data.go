@@ -0,0 +1,92 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import "time"
+
+// DemoChallenges returns sample challenges for the offline mock backend
+// Goals cover every status (not started, in progress, completed, claimed, locked)
+// so the TUI and CLI can be demoed without a Challenge Service. Rewards use the
+// item and currency codes of ags.NewMockRewardVerifier.
+func DemoChallenges() []Challenge {
+	claimedAt := time.Now().Add(-2 * time.Hour).Format(time.RFC3339)
+
+	return []Challenge{
+		{
+			ID:          "daily-quests",
+			Name:        "Daily Quests",
+			Description: "Complete daily tasks to earn rewards",
+			Goals: []Goal{
+				{
+					ID:          "daily-login",
+					Name:        "Daily Login",
+					Description: "Log in today",
+					Requirement: Requirement{StatCode: "login_count", Operator: "gte", TargetValue: 1},
+					Reward:      Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 50},
+					Progress:    1,
+					Status:      "claimed",
+					CompletedAt: claimedAt,
+					ClaimedAt:   claimedAt,
+					IsActive:    true,
+				},
+				{
+					ID:          "kill-10",
+					Name:        "Defeat 10 Enemies",
+					Description: "Defeat 10 enemies in any mode",
+					Requirement: Requirement{StatCode: "enemy_kills", Operator: "gte", TargetValue: 10},
+					Reward:      Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 100},
+					Progress:    10,
+					Status:      "completed",
+					CompletedAt: claimedAt,
+					IsActive:    true,
+				},
+				{
+					ID:          "win-3",
+					Name:        "Win 3 Matches",
+					Description: "Win 3 matches",
+					Requirement: Requirement{StatCode: "match_wins", Operator: "gte", TargetValue: 3},
+					Reward:      Reward{Type: "ITEM", RewardID: "winter_sword", Quantity: 1},
+					Progress:    1,
+					Status:      "in_progress",
+					IsActive:    true,
+				},
+				{
+					ID:            "win-10",
+					Name:          "Win 10 Matches",
+					Description:   "Win 10 matches after winning your first 3",
+					Requirement:   Requirement{StatCode: "match_wins", Operator: "gte", TargetValue: 10},
+					Reward:        Reward{Type: "WALLET", RewardID: "GEMS", Quantity: 20},
+					Prerequisites: []string{"win-3"},
+					Progress:      1,
+					Status:        "in_progress",
+					Locked:        true,
+				},
+			},
+		},
+		{
+			ID:          "winter-event",
+			Name:        "Winter Event",
+			Description: "Limited-time seasonal challenges",
+			Goals: []Goal{
+				{
+					ID:          "collect-snowflakes",
+					Name:        "Collect 50 Snowflakes",
+					Description: "Collect snowflakes during the winter event",
+					Requirement: Requirement{StatCode: "snowflakes", Operator: "gte", TargetValue: 50},
+					Reward:      Reward{Type: "ITEM", RewardID: "bronze_shield", Quantity: 1},
+					Status:      "not_started",
+				},
+				{
+					ID:          "build-snowman",
+					Name:        "Build a Snowman",
+					Description: "Build one snowman",
+					Requirement: Requirement{StatCode: "snowmen_built", Operator: "gte", TargetValue: 1},
+					Reward:      Reward{Type: "WALLET", RewardID: "GEMS", Quantity: 5},
+					Status:      "not_started",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,392 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// MockAPIClient is an in-memory APIClient for testing
+// Challenges are mutated in place by claim and goal-activation calls.
+type MockAPIClient struct {
+	Challenges []Challenge
+	Error      error // Returned by every call when set
+
+	// NoBatchClaim makes BatchClaimRewards return ErrBatchClaimUnsupported, like an older backend
+	NoBatchClaim bool
+
+	// ClaimCalls records "challengeID/goalID" for each goal claimed by ClaimReward or BatchClaimRewards
+	ClaimCalls []string
+
+	// BatchClaimCalls records the challenge ID of each BatchClaimRewards call
+	BatchClaimCalls []string
+
+	mu sync.Mutex // Protects Challenges, ClaimCalls, and BatchClaimCalls
+}
+
+// NewMockAPIClient creates a new mock API client with the given challenges
+func NewMockAPIClient(challenges []Challenge) *MockAPIClient {
+	return &MockAPIClient{
+		Challenges: challenges,
+	}
+}
+
+// ListChallenges returns all challenges
+func (m *MockAPIClient) ListChallenges(ctx context.Context) ([]Challenge, error) {
+	return m.ListChallengesWithFilter(ctx, false)
+}
+
+// ListChallengesWithFilter returns all challenges, keeping only active goals if activeOnly is set
+func (m *MockAPIClient) ListChallengesWithFilter(ctx context.Context, activeOnly bool) ([]Challenge, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	challenges := make([]Challenge, 0, len(m.Challenges))
+	for _, c := range m.Challenges {
+		copied := c
+		copied.Goals = make([]Goal, 0, len(c.Goals))
+		for _, g := range c.Goals {
+			if activeOnly && !g.IsActive {
+				continue
+			}
+			copied.Goals = append(copied.Goals, g)
+		}
+		challenges = append(challenges, copied)
+	}
+
+	return challenges, nil
+}
+
+// GetChallenge returns a single challenge by ID
+func (m *MockAPIClient) GetChallenge(ctx context.Context, challengeID string) (*Challenge, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	challenge := m.findChallenge(challengeID)
+	if challenge == nil {
+		return nil, notFoundError("challenge %s not found", challengeID)
+	}
+
+	copied := *challenge
+	copied.Goals = append([]Goal(nil), challenge.Goals...)
+	return &copied, nil
+}
+
+// ClaimReward claims a completed goal and marks it as claimed
+func (m *MockAPIClient) ClaimReward(ctx context.Context, challengeID, goalID string) (*ClaimResult, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.claimGoal(challengeID, goalID)
+}
+
+// BatchClaimRewards claims each goal like ClaimReward, reporting failures per goal
+func (m *MockAPIClient) BatchClaimRewards(ctx context.Context, challengeID string, goalIDs []string) (*BatchClaimResponse, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+	if m.NoBatchClaim {
+		return nil, fmt.Errorf("%w: %w", ErrBatchClaimUnsupported, &APIError{Code: http.StatusNotFound, GRPCCode: GRPCCodeNotFound, Message: "Not Found"})
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.BatchClaimCalls = append(m.BatchClaimCalls, challengeID)
+	if m.findChallenge(challengeID) == nil {
+		return nil, notFoundError("challenge %s not found", challengeID)
+	}
+
+	response := &BatchClaimResponse{ChallengeID: challengeID, Results: []BatchClaimResult{}}
+	for _, goalID := range goalIDs {
+		result, err := m.claimGoal(challengeID, goalID)
+		if err != nil {
+			response.Results = append(response.Results, BatchClaimResult{ClaimResult: ClaimResult{GoalID: goalID}, Error: err.Error()})
+			continue
+		}
+		response.Results = append(response.Results, BatchClaimResult{ClaimResult: *result})
+	}
+
+	return response, nil
+}
+
+// claimGoal claims a completed goal and records the call. Caller must hold m.mu.
+func (m *MockAPIClient) claimGoal(challengeID, goalID string) (*ClaimResult, error) {
+	m.ClaimCalls = append(m.ClaimCalls, challengeID+"/"+goalID)
+
+	goal, err := m.findGoal(challengeID, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch goal.Status {
+	case "claimed":
+		return nil, &APIError{Code: http.StatusConflict, GRPCCode: GRPCCodeAlreadyExists, Message: fmt.Sprintf("goal %s already claimed", goalID)}
+	case "completed":
+		// Claimable
+	default:
+		return nil, &APIError{Code: http.StatusBadRequest, GRPCCode: GRPCCodeFailedPrecondition, Message: fmt.Sprintf("goal %s is not completed", goalID)}
+	}
+
+	goal.Status = "claimed"
+	goal.ClaimedAt = time.Now().Format(time.RFC3339)
+
+	return &ClaimResult{
+		GoalID:    goalID,
+		Status:    "claimed",
+		Reward:    goal.Reward,
+		ClaimedAt: goal.ClaimedAt,
+	}, nil
+}
+
+// GetClaimHistory returns a record for every claimed goal, of challengeID only if set
+func (m *MockAPIClient) GetClaimHistory(ctx context.Context, challengeID string) ([]ClaimRecord, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if challengeID != "" && m.findChallenge(challengeID) == nil {
+		return nil, notFoundError("challenge %s not found", challengeID)
+	}
+
+	records := []ClaimRecord{}
+	for _, c := range m.Challenges {
+		if challengeID != "" && c.ID != challengeID {
+			continue
+		}
+		for _, g := range c.Goals {
+			if g.Status != "claimed" {
+				continue
+			}
+			records = append(records, ClaimRecord{
+				ChallengeID: c.ID,
+				GoalID:      g.ID,
+				Reward:      g.Reward,
+				ClaimedAt:   g.ClaimedAt,
+			})
+		}
+	}
+
+	return records, nil
+}
+
+// InitializePlayer reports all active goals as assigned
+func (m *MockAPIClient) InitializePlayer(ctx context.Context) (*InitializeResponse, error) {
+	return m.initializeResult()
+}
+
+// PreviewInitializePlayer reports the same assignments as InitializePlayer (which never mutates the mock)
+func (m *MockAPIClient) PreviewInitializePlayer(ctx context.Context) (*InitializeResponse, error) {
+	return m.initializeResult()
+}
+
+// initializeResult lists every active goal as assigned
+func (m *MockAPIClient) initializeResult() (*InitializeResponse, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := &InitializeResponse{AssignedGoals: []AssignedGoal{}}
+	for _, c := range m.Challenges {
+		for _, g := range c.Goals {
+			if !g.IsActive {
+				continue
+			}
+			result.AssignedGoals = append(result.AssignedGoals, AssignedGoal{
+				ChallengeID: c.ID,
+				GoalID:      g.ID,
+				Name:        g.Name,
+				Description: g.Description,
+				IsActive:    g.IsActive,
+				Progress:    g.Progress,
+				Target:      g.Requirement.TargetValue,
+				Status:      g.Status,
+			})
+		}
+	}
+	result.TotalActive = int32(len(result.AssignedGoals))
+
+	return result, nil
+}
+
+// SetGoalActive activates or deactivates a goal
+func (m *MockAPIClient) SetGoalActive(ctx context.Context, challengeID, goalID string, isActive bool) (*SetGoalActiveResponse, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	goal, err := m.findGoal(challengeID, goalID)
+	if err != nil {
+		return nil, err
+	}
+	goal.IsActive = isActive
+
+	return &SetGoalActiveResponse{
+		ChallengeID: challengeID,
+		GoalID:      goalID,
+		IsActive:    isActive,
+		AssignedAt:  time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// BatchSelectGoals activates the requested goals
+func (m *MockAPIClient) BatchSelectGoals(ctx context.Context, challengeID string, req *BatchSelectRequest) (*BatchSelectResponse, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	challenge := m.findChallenge(challengeID)
+	if challenge == nil {
+		return nil, notFoundError("challenge %s not found", challengeID)
+	}
+
+	selected := make(map[string]bool, len(req.GoalIDs))
+	for _, id := range req.GoalIDs {
+		selected[id] = true
+	}
+
+	return m.selectGoals(challenge, selected, req.ReplaceExisting), nil
+}
+
+// RandomSelectGoals activates the first Count eligible goals (deterministic for tests)
+func (m *MockAPIClient) RandomSelectGoals(ctx context.Context, challengeID string, req *RandomSelectRequest) (*RandomSelectResponse, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	challenge := m.findChallenge(challengeID)
+	if challenge == nil {
+		return nil, notFoundError("challenge %s not found", challengeID)
+	}
+
+	selected := make(map[string]bool, req.Count)
+	for _, g := range challenge.Goals {
+		if len(selected) >= req.Count {
+			break
+		}
+		if g.Status == "completed" || g.Status == "claimed" || g.Locked {
+			continue
+		}
+		if req.ExcludeActive && g.IsActive {
+			continue
+		}
+		selected[g.ID] = true
+	}
+
+	result := m.selectGoals(challenge, selected, req.ReplaceExisting)
+	return (*RandomSelectResponse)(result), nil
+}
+
+// GetRotationStatus reports rotation as disabled
+func (m *MockAPIClient) GetRotationStatus(ctx context.Context, challengeID string) (*RotationStatusResponse, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	return &RotationStatusResponse{
+		ChallengeID: challengeID,
+		Rotation:    &RotationInfo{Enabled: false},
+	}, nil
+}
+
+// GetLastRequest returns nil (no HTTP traffic in mock mode)
+func (m *MockAPIClient) GetLastRequest() *RequestDebugInfo {
+	return nil
+}
+
+// GetLastResponse returns nil (no HTTP traffic in mock mode)
+func (m *MockAPIClient) GetLastResponse() *ResponseDebugInfo {
+	return nil
+}
+
+// selectGoals activates the selected goals, optionally deactivating all others
+// Caller must hold m.mu.
+func (m *MockAPIClient) selectGoals(challenge *Challenge, selected map[string]bool, replaceExisting bool) *BatchSelectResponse {
+	result := &BatchSelectResponse{
+		SelectedGoals: []Goal{},
+		ChallengeID:   challenge.ID,
+		ReplacedGoals: []string{},
+	}
+
+	for i := range challenge.Goals {
+		goal := &challenge.Goals[i]
+		switch {
+		case selected[goal.ID]:
+			goal.IsActive = true
+			result.SelectedGoals = append(result.SelectedGoals, *goal)
+		case replaceExisting && goal.IsActive:
+			goal.IsActive = false
+			result.ReplacedGoals = append(result.ReplacedGoals, goal.ID)
+		}
+
+		if goal.IsActive {
+			result.TotalActiveGoals++
+		}
+	}
+
+	return result
+}
+
+// findChallenge returns a pointer to the stored challenge. Caller must hold m.mu.
+func (m *MockAPIClient) findChallenge(challengeID string) *Challenge {
+	for i := range m.Challenges {
+		if m.Challenges[i].ID == challengeID {
+			return &m.Challenges[i]
+		}
+	}
+	return nil
+}
+
+// findGoal returns a pointer to the stored goal. Caller must hold m.mu.
+func (m *MockAPIClient) findGoal(challengeID, goalID string) (*Goal, error) {
+	challenge := m.findChallenge(challengeID)
+	if challenge == nil {
+		return nil, notFoundError("challenge %s not found", challengeID)
+	}
+
+	for i := range challenge.Goals {
+		if challenge.Goals[i].ID == goalID {
+			return &challenge.Goals[i], nil
+		}
+	}
+
+	return nil, notFoundError("goal %s not found in challenge %s", goalID, challengeID)
+}
+
+// notFoundError returns a 404 APIError like the gateway's for a missing challenge or goal
+func notFoundError(format string, args ...interface{}) *APIError {
+	return &APIError{Code: http.StatusNotFound, GRPCCode: GRPCCodeNotFound, Message: fmt.Sprintf(format, args...)}
+}
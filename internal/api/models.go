@@ -66,6 +66,39 @@ type ClaimResult struct {
 	ClaimedAt string `json:"claimedAt"` // Backend uses camelCase via protojson
 }
 
+// BatchClaimRequest represents the request for claiming several goals of a challenge at once
+type BatchClaimRequest struct {
+	GoalIDs []string `json:"goal_ids"`
+}
+
+// BatchClaimResponse holds one result per requested goal; the batch can partially succeed
+type BatchClaimResponse struct {
+	ChallengeID string             `json:"challengeId"`
+	Results     []BatchClaimResult `json:"results"`
+}
+
+// BatchClaimResult is the outcome of claiming one goal in a batch
+// Error is set, and the claim fields are empty, if this goal's claim failed.
+type BatchClaimResult struct {
+	ClaimResult
+	Error string `json:"error,omitempty"`
+}
+
+// ClaimRecord is a single reward claim in the user's claim history
+// Matches the protobuf ClaimRecord message from backend service (uses protojson camelCase)
+type ClaimRecord struct {
+	ChallengeID string `json:"challengeId"` // Backend uses camelCase via protojson
+	GoalID      string `json:"goalId"`
+	Reward      Reward `json:"reward"`
+	ClaimedAt   string `json:"claimedAt"` // RFC3339 timestamp
+}
+
+// GetClaimHistoryResponse wraps the claim records returned by the API
+// Matches the protobuf GetClaimHistoryResponse message from backend service
+type GetClaimHistoryResponse struct {
+	Claims []ClaimRecord `json:"claims"`
+}
+
 // M3: InitializeResponse represents the response from initializing player goals
 // Matches the protobuf InitializePlayerResponse message from backend service
 type InitializeResponse struct {
@@ -122,18 +155,30 @@ type RotationPeriod struct {
 
 // RequestDebugInfo stores debug information about a request
 type RequestDebugInfo struct {
-	Method  string
-	URL     string
-	Headers map[string]string
-	Body    string
+	Method    string
+	URL       string
+	Headers   map[string]string
+	Body      string
+	RequestID string // X-Request-ID sent with the request
 }
 
 // ResponseDebugInfo stores debug information about a response
 type ResponseDebugInfo struct {
-	StatusCode int
-	Headers    map[string]string
-	Body       string
-	Duration   time.Duration
+	StatusCode       int
+	Headers          map[string]string
+	Body             string
+	Duration         time.Duration   // Duration of the attempt that produced this response
+	Attempts         int             // Number of attempts made, including retries
+	AttemptDurations []time.Duration // Duration of each attempt, in order (failed attempts included)
+}
+
+// TotalDuration returns the time spent across all attempts (excluding backoff)
+func (r *ResponseDebugInfo) TotalDuration() time.Duration {
+	var total time.Duration
+	for _, d := range r.AttemptDurations {
+		total += d
+	}
+	return total
 }
 
 // M4: BatchSelectRequest represents the request for batch goal selection
@@ -152,9 +197,10 @@ type BatchSelectResponse struct {
 
 // M4: RandomSelectRequest represents the request for random goal selection
 type RandomSelectRequest struct {
-	Count           int  `json:"count"`
-	ReplaceExisting bool `json:"replace_existing"`
-	ExcludeActive   bool `json:"exclude_active"`
+	Count           int   `json:"count"`
+	ReplaceExisting bool  `json:"replace_existing"`
+	ExcludeActive   bool  `json:"exclude_active"`
+	Seed            int64 `json:"seed,omitempty"` // Optional: 0 lets the backend pick; honored only if the backend supports seeding
 }
 
 // M4: RandomSelectResponse represents the response from random goal selection
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"strings"
+	"time"
+)
+
+// RequestObserver is notified after every HTTP attempt the client makes, retries included
+// statusCode is 0 and err is set when no response was received. Implementations must be
+// safe for concurrent use.
+type RequestObserver interface {
+	ObserveRequest(method, route string, statusCode int, duration time.Duration, err error)
+}
+
+// routeActions are path segments after "goals" that name an action rather than a goal ID
+var routeActions = map[string]bool{
+	"batch-claim":   true,
+	"batch-select":  true,
+	"random-select": true,
+}
+
+// RouteTemplate replaces the IDs in a request path with placeholders and drops the query,
+// e.g. /v1/challenges/daily/goals/login/claim → /v1/challenges/{challenge_id}/goals/{goal_id}/claim
+// Metrics label requests by route so their cardinality stays bounded.
+func RouteTemplate(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	segments := strings.Split(path, "/")
+	for i := 1; i < len(segments); i++ {
+		switch segments[i-1] {
+		case "challenges":
+			segments[i] = "{challenge_id}"
+		case "goals":
+			if !routeActions[segments[i]] {
+				segments[i] = "{goal_id}"
+			}
+		}
+	}
+	return strings.Join(segments, "/")
+}
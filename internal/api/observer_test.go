@@ -0,0 +1,77 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+)
+
+// recordingObserver records each observed attempt as "METHOD route status"
+type recordingObserver struct {
+	mu       sync.Mutex
+	attempts []string
+}
+
+func (o *recordingObserver) ObserveRequest(method, route string, statusCode int, duration time.Duration, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.attempts = append(o.attempts, fmt.Sprintf("%s %s %d", method, route, statusCode))
+}
+
+func TestRouteTemplate(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/v1/challenges", "/v1/challenges"},
+		{"/v1/challenges?active_only=true", "/v1/challenges"},
+		{"/v1/challenges/daily", "/v1/challenges/{challenge_id}"},
+		{"/v1/challenges/daily/goals/login/claim", "/v1/challenges/{challenge_id}/goals/{goal_id}/claim"},
+		{"/v1/challenges/daily/goals/batch-select", "/v1/challenges/{challenge_id}/goals/batch-select"},
+		{"/v1/challenges/daily/goals/batch-claim", "/v1/challenges/{challenge_id}/goals/batch-claim"},
+		{"/v1/claims", "/v1/claims"},
+	}
+
+	for _, tt := range tests {
+		if got := RouteTemplate(tt.path); got != tt.expected {
+			t.Errorf("RouteTemplate(%q): expected %q, got %q", tt.path, tt.expected, got)
+		}
+	}
+}
+
+func TestHTTPAPIClient_SetObserver(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"challengeId":"daily"}`))
+	}))
+	defer server.Close()
+
+	observer := &recordingObserver{}
+	client := NewHTTPAPIClient(server.URL, auth.NewMockAuthProvider("test-user", "demo"))
+	client.SetObserver(observer)
+
+	// The 503 is retried after a 1s backoff; both attempts are observed
+	if _, err := client.GetChallenge(context.Background(), "daily"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{"GET /v1/challenges/{challenge_id} 503", "GET /v1/challenges/{challenge_id} 200"}
+	if fmt.Sprint(observer.attempts) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, got %v", expected, observer.attempts)
+	}
+}
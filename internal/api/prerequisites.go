@@ -0,0 +1,101 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+// UnmetPrerequisites returns the goal's prerequisite IDs that are not yet completed or claimed
+// Prerequisites are resolved within the same challenge; unknown IDs are treated as unmet.
+func (c *Challenge) UnmetPrerequisites(goal *Goal) []string {
+	statuses := make(map[string]string, len(c.Goals))
+	for _, g := range c.Goals {
+		statuses[g.ID] = g.Status
+	}
+
+	unmet := []string{}
+	for _, prereqID := range goal.Prerequisites {
+		status := statuses[prereqID]
+		if status != "completed" && status != "claimed" {
+			unmet = append(unmet, prereqID)
+		}
+	}
+
+	return unmet
+}
+
+// BlockingGoalNames returns the display names of the goal's unmet prerequisites
+// IDs that do not match a goal in the challenge are returned as-is.
+func (c *Challenge) BlockingGoalNames(goal *Goal) []string {
+	names := make(map[string]string, len(c.Goals))
+	for _, g := range c.Goals {
+		if g.Name != "" {
+			names[g.ID] = g.Name
+		}
+	}
+
+	blocking := []string{}
+	for _, id := range c.UnmetPrerequisites(goal) {
+		if name, ok := names[id]; ok {
+			blocking = append(blocking, name)
+		} else {
+			blocking = append(blocking, id)
+		}
+	}
+
+	return blocking
+}
+
+// PrerequisiteCycles returns the cycles in the challenge's goal prerequisite graph
+// Each cycle is the goal IDs along the prerequisite edges, with the first ID repeated at
+// the end (e.g. [a b a] when a requires b and b requires a). Prerequisites that do not
+// match a goal in the challenge cannot form a cycle and are skipped.
+func (c *Challenge) PrerequisiteCycles() [][]string {
+	goals := make(map[string]*Goal, len(c.Goals))
+	for i := range c.Goals {
+		goals[c.Goals[i].ID] = &c.Goals[i]
+	}
+
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	state := make(map[string]int, len(c.Goals))
+	path := []string{}
+	cycles := [][]string{}
+
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = onPath
+		path = append(path, id)
+
+		for _, prereqID := range goals[id].Prerequisites {
+			if _, ok := goals[prereqID]; !ok {
+				continue
+			}
+			switch state[prereqID] {
+			case unvisited:
+				visit(prereqID)
+			case onPath:
+				// Back edge: the cycle runs from prereqID's place on the path to here
+				start := len(path) - 1
+				for path[start] != prereqID {
+					start--
+				}
+				cycle := append(append([]string{}, path[start:]...), prereqID)
+				cycles = append(cycles, cycle)
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[id] = done
+	}
+
+	for _, g := range c.Goals {
+		if state[g.ID] == unvisited {
+			visit(g.ID)
+		}
+	}
+
+	return cycles
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChallenge_BlockingGoalNames(t *testing.T) {
+	challenge := &Challenge{
+		ID: "daily-quests",
+		Goals: []Goal{
+			{ID: "g2", Name: "Login Once", Status: "completed"},
+			{ID: "g5", Name: "Win 3 Matches", Status: "in_progress"},
+			{ID: "g7", Name: "Final Boss", Status: "not_started", Locked: true, Prerequisites: []string{"g2", "g5"}},
+			{ID: "g8", Name: "Secret", Status: "not_started", Locked: true, Prerequisites: []string{"missing"}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		goal     *Goal
+		unmet    []string
+		blocking []string
+	}{
+		{
+			name:     "one of two prerequisites complete",
+			goal:     &challenge.Goals[2],
+			unmet:    []string{"g5"},
+			blocking: []string{"Win 3 Matches"},
+		},
+		{
+			name:     "unknown prerequisite falls back to ID",
+			goal:     &challenge.Goals[3],
+			unmet:    []string{"missing"},
+			blocking: []string{"missing"},
+		},
+		{
+			name:     "no prerequisites",
+			goal:     &challenge.Goals[0],
+			unmet:    []string{},
+			blocking: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := challenge.UnmetPrerequisites(tt.goal); !reflect.DeepEqual(got, tt.unmet) {
+				t.Errorf("Expected unmet %v, got %v", tt.unmet, got)
+			}
+			if got := challenge.BlockingGoalNames(tt.goal); !reflect.DeepEqual(got, tt.blocking) {
+				t.Errorf("Expected blocking %v, got %v", tt.blocking, got)
+			}
+		})
+	}
+}
+
+func TestChallenge_PrerequisiteCycles(t *testing.T) {
+	tests := []struct {
+		name     string
+		goals    []Goal
+		expected [][]string
+	}{
+		{
+			name: "chain without cycles",
+			goals: []Goal{
+				{ID: "a"},
+				{ID: "b", Prerequisites: []string{"a"}},
+				{ID: "c", Prerequisites: []string{"a", "b", "missing"}},
+			},
+			expected: [][]string{},
+		},
+		{
+			name: "two-goal cycle",
+			goals: []Goal{
+				{ID: "a", Prerequisites: []string{"b"}},
+				{ID: "b", Prerequisites: []string{"a"}},
+			},
+			expected: [][]string{{"a", "b", "a"}},
+		},
+		{
+			name: "self prerequisite",
+			goals: []Goal{
+				{ID: "a", Prerequisites: []string{"a"}},
+			},
+			expected: [][]string{{"a", "a"}},
+		},
+		{
+			name: "cycle behind a chain",
+			goals: []Goal{
+				{ID: "start", Prerequisites: []string{"x"}},
+				{ID: "x", Prerequisites: []string{"y"}},
+				{ID: "y", Prerequisites: []string{"z"}},
+				{ID: "z", Prerequisites: []string{"x"}},
+			},
+			expected: [][]string{{"x", "y", "z", "x"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			challenge := &Challenge{ID: "c", Goals: tt.goals}
+			if got := challenge.PrerequisiteCycles(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected cycles %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// RequestIDHeader carries the ID that correlates a request with the backend logs
+const RequestIDHeader = "X-Request-ID"
+
+// newRequestID returns a random 32-character hex request ID
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b) // Never fails (see crypto/rand.Read)
+	return hex.EncodeToString(b)
+}
+
+// requestIDError is a failed request that got no response, tagged with its request ID
+type requestIDError struct {
+	requestID string
+	err       error
+}
+
+// withRequestID tags err with the ID of the request that failed
+// An APIError already prints its ID, so errors wrapping one are returned as is.
+func withRequestID(err error, requestID string) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RequestID != "" {
+		return err
+	}
+	return &requestIDError{requestID: requestID, err: err}
+}
+
+func (e *requestIDError) Error() string {
+	return fmt.Sprintf("%v [request ID %s]", e.err, e.requestID)
+}
+
+func (e *requestIDError) Unwrap() error {
+	return e.err
+}
+
+// RequestID returns the X-Request-ID of the failed request behind err, or "" if err did not
+// come from a Challenge Service request
+func RequestID(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RequestID != "" {
+		return apiErr.RequestID
+	}
+
+	var idErr *requestIDError
+	if errors.As(err, &idErr) {
+		return idErr.requestID
+	}
+	return ""
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import "fmt"
+
+// operatorSymbols maps requirement operators to their display symbols
+var operatorSymbols = map[string]string{
+	"gte": ">=",
+	"lte": "<=",
+	"eq":  "==",
+}
+
+// OperatorSymbol returns the display symbol for the operator (e.g. "gte" -> ">=")
+// Unknown operators are returned as-is.
+func (r Requirement) OperatorSymbol() string {
+	if symbol, ok := operatorSymbols[r.Operator]; ok {
+		return symbol
+	}
+	return r.Operator
+}
+
+// String describes the requirement, e.g. "kills >= 10"
+func (r Requirement) String() string {
+	return fmt.Sprintf("%s %s %d", r.StatCode, r.OperatorSymbol(), r.TargetValue)
+}
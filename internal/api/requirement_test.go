@@ -0,0 +1,27 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import "testing"
+
+func TestRequirement_String(t *testing.T) {
+	tests := []struct {
+		requirement Requirement
+		want        string
+	}{
+		{requirement: Requirement{StatCode: "kills", Operator: "gte", TargetValue: 10}, want: "kills >= 10"},
+		{requirement: Requirement{StatCode: "deaths", Operator: "lte", TargetValue: 3}, want: "deaths <= 3"},
+		{requirement: Requirement{StatCode: "level", Operator: "eq", TargetValue: 5}, want: "level == 5"},
+		{requirement: Requirement{StatCode: "wins", Operator: "gt", TargetValue: 1}, want: "wins gt 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.requirement.String(); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
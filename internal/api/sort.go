@@ -0,0 +1,119 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Challenge sort modes (shared by list-challenges --sort and the TUI)
+const (
+	SortByName     = "name"     // Name, A-Z
+	SortByProgress = "progress" // Completed-goal ratio, least complete first
+	SortByStatus   = "status"   // Derived status: not_started, in_progress, completed
+)
+
+// ChallengeSortModes lists the valid sort modes in TUI cycle order
+var ChallengeSortModes = []string{SortByName, SortByProgress, SortByStatus}
+
+// statusRank orders derived challenge statuses for SortByStatus
+var statusRank = map[string]int{
+	"not_started": 0,
+	"in_progress": 1,
+	"completed":   2,
+}
+
+// CompletedGoals returns the number of completed or claimed goals
+func (c *Challenge) CompletedGoals() int {
+	completed := 0
+	for _, g := range c.Goals {
+		if g.Status == "completed" || g.Status == "claimed" {
+			completed++
+		}
+	}
+	return completed
+}
+
+// Status derives the challenge status from its goals
+// Returns completed when every goal is done, in_progress when some are, else not_started.
+func (c *Challenge) Status() string {
+	completed := c.CompletedGoals()
+	switch {
+	case completed == len(c.Goals):
+		return "completed"
+	case completed > 0:
+		return "in_progress"
+	}
+	return "not_started"
+}
+
+// progressRatio returns the completed-goal ratio (a challenge without goals counts as complete)
+func (c *Challenge) progressRatio() float64 {
+	if len(c.Goals) == 0 {
+		return 1
+	}
+	return float64(c.CompletedGoals()) / float64(len(c.Goals))
+}
+
+// ValidateSortMode reports whether by is empty or one of ChallengeSortModes
+func ValidateSortMode(by string) error {
+	if by == "" {
+		return nil
+	}
+	for _, mode := range ChallengeSortModes {
+		if by == mode {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown sort mode %q (expected %s)", by, strings.Join(ChallengeSortModes, ", "))
+}
+
+// SortChallenges orders challenges in place by the given mode
+// Ties fall back to name (case-insensitive), then ID, so the order is deterministic.
+// An empty mode leaves the backend order unchanged.
+//
+// Returns:
+//   - error: Non-nil if the mode is not one of ChallengeSortModes
+func SortChallenges(challenges []Challenge, by string) error {
+	var primary func(a, b *Challenge) int
+	switch by {
+	case "":
+		return nil
+	case SortByName:
+		primary = func(a, b *Challenge) int { return 0 }
+	case SortByProgress:
+		primary = func(a, b *Challenge) int {
+			ra, rb := a.progressRatio(), b.progressRatio()
+			switch {
+			case ra < rb:
+				return -1
+			case ra > rb:
+				return 1
+			}
+			return 0
+		}
+	case SortByStatus:
+		primary = func(a, b *Challenge) int {
+			return statusRank[a.Status()] - statusRank[b.Status()]
+		}
+	default:
+		return ValidateSortMode(by)
+	}
+
+	sort.SliceStable(challenges, func(i, j int) bool {
+		a, b := &challenges[i], &challenges[j]
+		if cmp := primary(a, b); cmp != 0 {
+			return cmp < 0
+		}
+		if an, bn := strings.ToLower(a.Name), strings.ToLower(b.Name); an != bn {
+			return an < bn
+		}
+		return a.ID < b.ID
+	})
+
+	return nil
+}
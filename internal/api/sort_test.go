@@ -0,0 +1,111 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+// sortFixture builds challenges with the given completed/total goal counts
+func sortFixture() []Challenge {
+	goals := func(completed, total int) []Goal {
+		gs := make([]Goal, total)
+		for i := range gs {
+			gs[i].Status = "in_progress"
+			if i < completed {
+				gs[i].Status = "completed"
+			}
+		}
+		return gs
+	}
+
+	return []Challenge{
+		{ID: "c1", Name: "weekly", Goals: goals(1, 2)}, // 50%, in_progress
+		{ID: "c2", Name: "Daily", Goals: goals(2, 2)},  // 100%, completed
+		{ID: "c3", Name: "event", Goals: goals(0, 3)},  // 0%, not_started
+		{ID: "c4", Name: "Arena", Goals: goals(2, 4)},  // 50%, in_progress
+		{ID: "c5", Name: "arena", Goals: goals(0, 1)},  // 0%, not_started
+	}
+}
+
+func challengeIDs(challenges []Challenge) string {
+	ids := make([]string, len(challenges))
+	for i, c := range challenges {
+		ids[i] = c.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestSortChallenges(t *testing.T) {
+	tests := []struct {
+		by   string
+		want string
+	}{
+		// Backend order is kept without a mode
+		{by: "", want: "c1,c2,c3,c4,c5"},
+		// Case-insensitive name; equal names fall back to ID
+		{by: SortByName, want: "c4,c5,c2,c3,c1"},
+		// Equal progress falls back to name: event/arena at 0%, Arena/weekly at 50%
+		{by: SortByProgress, want: "c5,c3,c4,c1,c2"},
+		// Equal status falls back to name
+		{by: SortByStatus, want: "c5,c3,c4,c1,c2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.by, func(t *testing.T) {
+			challenges := sortFixture()
+			if err := SortChallenges(challenges, tt.by); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got := challengeIDs(challenges); got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSortChallenges_ProgressTieBreaksOnName(t *testing.T) {
+	challenges := []Challenge{
+		{ID: "z", Name: "Zulu", Goals: []Goal{{Status: "completed"}, {Status: "not_started"}}},
+		{ID: "a", Name: "Alpha", Goals: []Goal{{Status: "claimed"}, {Status: "in_progress"}}},
+		{ID: "m", Name: "Mike", Goals: []Goal{{Status: "completed"}, {}, {}, {Status: "completed"}}},
+	}
+
+	if err := SortChallenges(challenges, SortByProgress); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := challengeIDs(challenges); got != "a,m,z" {
+		t.Errorf("Expected equal 50%% progress ordered by name (a,m,z), got %s", got)
+	}
+}
+
+func TestSortChallenges_UnknownMode(t *testing.T) {
+	challenges := sortFixture()
+	if err := SortChallenges(challenges, "size"); err == nil {
+		t.Error("Expected error for unknown sort mode")
+	}
+	if got := challengeIDs(challenges); got != "c1,c2,c3,c4,c5" {
+		t.Errorf("Expected order unchanged on error, got %s", got)
+	}
+}
+
+func TestChallenge_Status(t *testing.T) {
+	tests := []struct {
+		goals []Goal
+		want  string
+	}{
+		{goals: []Goal{{Status: "not_started"}, {Status: "in_progress"}}, want: "not_started"},
+		{goals: []Goal{{Status: "claimed"}, {Status: "in_progress"}}, want: "in_progress"},
+		{goals: []Goal{{Status: "claimed"}, {Status: "completed"}}, want: "completed"},
+	}
+
+	for _, tt := range tests {
+		c := Challenge{Goals: tt.goals}
+		if got := c.Status(); got != tt.want {
+			t.Errorf("Expected %s, got %s", tt.want, got)
+		}
+	}
+}
@@ -6,14 +6,16 @@ package app
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/factory"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/repository"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/basic"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
 	sdkAuth "github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth"
@@ -30,170 +32,184 @@ type Container struct {
 	APIClient         api.APIClient
 	EventTrigger      events.EventTrigger
 	RewardVerifier    ags.RewardVerifier
+	NamespaceLister   ags.NamespaceLister // Optional: nil unless admin credentials are configured
 	UserID            string
 	Namespace         string
+
+	// Set in mock auth mode so SwitchUser can act as another user
+	mockAuth   *auth.MockAuthProvider
+	httpClient *api.HTTPAPIClient
 }
 
-// extractUserIDFromJWT extracts the user ID from a JWT token's "sub" claim
-// Returns empty string if extraction fails
-func extractUserIDFromJWT(token string) string {
-	// JWT format: header.payload.signature
-	parts := strings.Split(token, ".")
-	if len(parts) != 3 {
-		log.Printf("Warning: Invalid JWT format (expected 3 parts, got %d)", len(parts))
-		return ""
-	}
+// ErrUserSwitchUnsupported is returned by SwitchUser when the auth mode takes the user from a real token
+var ErrUserSwitchUnsupported = errors.New("switching users needs --auth-mode mock; log in as the other user instead")
 
-	// Decode the payload (second part)
-	payload := parts[1]
+// MockBackendURL selects the offline in-memory Challenge Service (--backend-url mock or --mock)
+const MockBackendURL = "mock"
 
-	// Add padding if necessary (base64 requires padding)
-	if m := len(payload) % 4; m != 0 {
-		payload += strings.Repeat("=", 4-m)
-	}
+// ContainerConfig holds the connection and auth settings NewContainer builds dependencies from
+type ContainerConfig struct {
+	BackendURL      string // Challenge Service URL, or MockBackendURL for the offline backend
+	AuthMode        string // password, token, client, or mock
+	EventHandlerURL string // Optional: event handler gRPC address (empty disables the simulator)
+	UserID          string // Mock auth user; password and token modes take it from the token
+	Namespace       string
 
-	// Decode base64
-	decoded, err := base64.URLEncoding.DecodeString(payload)
-	if err != nil {
-		log.Printf("Warning: Failed to decode JWT payload: %v", err)
-		return ""
-	}
+	// Password mode
+	Email    string
+	Password string
+	MFAToken string // Optional MFA code for the password grant
 
-	// Parse JSON to extract "sub" claim
-	var claims struct {
-		Sub string `json:"sub"`
-	}
-	if err := json.Unmarshal(decoded, &claims); err != nil {
-		log.Printf("Warning: Failed to parse JWT claims: %v", err)
-		return ""
-	}
+	AccessToken string // User token for token mode
+
+	ClientID     string
+	ClientSecret string
+	IAMURL       string
+	PlatformURL  string
+
+	// Optional: admin client for AGS Platform verification (dual token mode)
+	AdminClientID     string
+	AdminClientSecret string
 
-	return claims.Sub
+	TokenRefreshBuffer  time.Duration          // How long before expiry tokens are refreshed (0 = auth.DefaultRefreshBuffer)
+	EventTriggerOptions []events.TriggerOption // TLS and dial timeout for the event handler connection
 }
 
 // NewContainer creates a new dependency container
-func NewContainer(
-	backendURL string,
-	authMode string,
-	eventHandlerURL string,
-	userID string,
-	namespace string,
-	email string,
-	password string,
-	clientID string,
-	clientSecret string,
-	iamURL string,
-	platformURL string,
-	adminClientID string,
-	adminClientSecret string,
-) *Container {
+func NewContainer(cfg ContainerConfig) *Container {
+	authMode, eventHandlerURL, userID := cfg.AuthMode, cfg.EventHandlerURL, cfg.UserID
+
+	// The offline mock backend needs no credentials or running services
+	offline := cfg.BackendURL == MockBackendURL
+	if offline {
+		if authMode != "mock" {
+			slog.Warn("Mock backend selected, using mock auth", "auth_mode", authMode)
+		}
+		authMode = "mock"
+		eventHandlerURL = ""
+	}
+
 	// Create auth provider based on mode
 	var authProvider auth.AuthProvider
+	var mockAuth *auth.MockAuthProvider
 
 	switch authMode {
 	case "password":
 		// User authentication (email + password → user token)
 		// RECOMMENDED for Challenge Service API testing
+		opts := []auth.ProviderOption{
+			auth.WithRefreshBuffer(cfg.TokenRefreshBuffer),
+			auth.WithMFAToken(cfg.MFAToken),
+		}
+		if cfg.Password == "" {
+			// No password: reuse the token cached by the login command
+			opts = append(opts, auth.WithTokenCache(auth.NewTokenCache(auth.DefaultTokenCachePath())))
+		}
 		authProvider = auth.NewPasswordAuthProvider(
-			iamURL,
-			clientID,
-			clientSecret,
-			namespace,
-			email,
-			password,
+			cfg.IAMURL,
+			cfg.ClientID,
+			cfg.ClientSecret,
+			cfg.Namespace,
+			cfg.Email,
+			cfg.Password,
+			opts...,
 		)
 
 		// Extract user ID from JWT token
 		// This is critical - the --user-id flag should NOT be used in password mode
-		ctx := context.Background()
-		token, err := authProvider.GetToken(ctx)
-		if err != nil {
-			log.Printf("Warning: Failed to authenticate with password: %v", err)
-			log.Printf("Falling back to --user-id flag value: %s", userID)
-		} else {
-			extractedUserID := extractUserIDFromJWT(token.AccessToken)
-			if extractedUserID != "" {
-				log.Printf("Extracted user ID from JWT token: %s", extractedUserID)
-				userID = extractedUserID // Override the flag value with JWT's user ID
-			} else {
-				log.Printf("Warning: Failed to extract user ID from JWT, using --user-id flag: %s", userID)
-			}
-		}
+		userID = tokenUserID(authProvider, authMode, userID, cfg.Namespace)
+
+	case "token":
+		// User token minted elsewhere (e.g. a CI step), so no credentials are needed
+		authProvider = auth.NewStaticTokenAuthProvider(cfg.AccessToken)
+		userID = tokenUserID(authProvider, authMode, userID, cfg.Namespace)
 
 	case "client":
 		// Service authentication (client credentials → service token)
 		// WARNING: Service token does NOT have user_id!
 		// TODO: Implement ClientAuthProvider in future
-		log.Printf("WARNING: client mode not yet implemented, falling back to mock mode")
-		authProvider = auth.NewMockAuthProvider(userID, namespace)
+		slog.Warn("Client auth mode not yet implemented, falling back to mock mode")
+		mockAuth = auth.NewMockAuthProvider(userID, cfg.Namespace)
+		authProvider = mockAuth
 
 	case "mock":
 		// Mock authentication with configurable user_id
-		authProvider = auth.NewMockAuthProvider(userID, namespace)
+		mockAuth = auth.NewMockAuthProvider(userID, cfg.Namespace)
+		authProvider = mockAuth
 
 	default:
 		// Default to mock mode
-		log.Printf("Unknown auth mode '%s', defaulting to mock", authMode)
-		authProvider = auth.NewMockAuthProvider(userID, namespace)
+		slog.Warn("Unknown auth mode, defaulting to mock", "auth_mode", authMode)
+		mockAuth = auth.NewMockAuthProvider(userID, cfg.Namespace)
+		authProvider = mockAuth
 	}
 
 	// Create admin auth provider (optional - for AGS Platform verification)
 	var adminAuthProvider auth.AuthProvider
-	if adminClientID != "" && adminClientSecret != "" {
-		if iamURL == "" {
-			log.Printf("Warning: Admin credentials provided but IAM URL is empty")
+	if cfg.AdminClientID != "" && cfg.AdminClientSecret != "" {
+		if cfg.IAMURL == "" {
+			slog.Warn("Admin credentials provided but IAM URL is empty")
 		} else {
 			adminAuthProvider = auth.NewClientAuthProvider(
-				iamURL,
-				adminClientID,
-				adminClientSecret,
-				namespace,
+				cfg.IAMURL,
+				cfg.AdminClientID,
+				cfg.AdminClientSecret,
+				cfg.Namespace,
+				auth.WithRefreshBuffer(cfg.TokenRefreshBuffer),
 			)
-			log.Printf("Admin auth provider initialized for AGS Platform verification")
+			slog.Info("Admin auth provider initialized for AGS Platform verification")
 		}
 	}
 
 	// Create API client
-	apiClient := api.NewHTTPAPIClient(backendURL, authProvider)
-	// Set user ID for mock authentication header (used when backend auth is disabled)
-	apiClient.SetUserID(userID)
+	var apiClient api.APIClient
+	var httpClient *api.HTTPAPIClient
+	if offline {
+		apiClient = api.NewMockAPIClient(api.DemoChallenges())
+		slog.Info("Using in-memory mock backend with demo challenges")
+	} else {
+		httpClient = api.NewHTTPAPIClient(cfg.BackendURL, authProvider)
+		// Set user ID for mock authentication header (used when backend auth is disabled)
+		httpClient.SetUserID(userID)
+		apiClient = httpClient
+	}
 
 	// Create event trigger (optional - only if event handler URL provided)
 	var eventTrigger events.EventTrigger
 	if eventHandlerURL != "" {
 		var err error
-		eventTrigger, err = events.NewLocalEventTrigger(eventHandlerURL)
+		eventTrigger, err = events.NewLocalEventTrigger(eventHandlerURL, cfg.EventTriggerOptions...)
 		if err != nil {
-			log.Printf("Warning: Failed to connect to event handler at %s: %v", eventHandlerURL, err)
-			log.Printf("Event simulator will be disabled. Start event handler to enable it.")
+			slog.Warn("Failed to connect to event handler, event simulator disabled", "address", eventHandlerURL, "error", err)
 			eventTrigger = nil
 		}
 	}
 
 	// Create reward verifier based on auth mode
 	var rewardVerifier ags.RewardVerifier
+	var namespaceLister ags.NamespaceLister
 	if authMode == "mock" {
 		// Use mock verifier for mock auth mode
 		rewardVerifier = ags.NewMockRewardVerifier()
-	} else if platformURL != "" {
+	} else if cfg.PlatformURL != "" {
 		// Create Platform SDK services with proper OAuth authentication
 		// For dual token mode: use admin credentials (--admin-client-id, --admin-client-secret)
 		// for Platform SDK, while user credentials (--email, --password) are used for Challenge Service
 
 		// Determine which credentials to use for Platform SDK
-		platformClientID := adminClientID
-		platformClientSecret := adminClientSecret
+		platformClientID := cfg.AdminClientID
+		platformClientSecret := cfg.AdminClientSecret
 
 		// Fallback to regular client credentials if admin credentials not provided
 		if platformClientID == "" {
-			platformClientID = clientID
-			platformClientSecret = clientSecret
-			log.Printf("Admin credentials not provided, using regular client credentials for Platform SDK")
+			platformClientID = cfg.ClientID
+			platformClientSecret = cfg.ClientSecret
+			slog.Info("Admin credentials not provided, using regular client credentials for Platform SDK")
 		}
 
 		// Set SDK environment variables (required by DefaultConfigRepositoryImpl)
 		// The SDK reads AB_BASE_URL, AB_CLIENT_ID, AB_CLIENT_SECRET, AB_NAMESPACE from env
-		setSDKEnvironmentVariables(platformURL, iamURL, platformClientID, platformClientSecret, namespace)
+		setSDKEnvironmentVariables(cfg.PlatformURL, cfg.IAMURL, platformClientID, platformClientSecret, cfg.Namespace)
 
 		// Initialize SDK repositories (these read from environment variables for base config)
 		var tokenRepo repository.TokenRepository = sdkAuth.DefaultTokenRepositoryImpl()
@@ -211,13 +227,12 @@ func NewContainer(
 		// Login with client credentials (uses admin credentials for dual token mode)
 		err := oauthService.LoginClient(&platformClientID, &platformClientSecret)
 		if err != nil {
-			log.Printf("Warning: Platform SDK authentication failed: %v", err)
-			log.Printf("Wallet verification will not work. Check client credentials.")
+			slog.Warn("Platform SDK authentication failed, wallet verification will not work", "error", err)
 		} else {
-			if adminClientID != "" {
-				log.Printf("Platform SDK authenticated successfully with admin credentials (dual token mode)")
+			if cfg.AdminClientID != "" {
+				slog.Info("Platform SDK authenticated with admin credentials (dual token mode)")
 			} else {
-				log.Printf("Platform SDK authenticated successfully with regular credentials")
+				slog.Info("Platform SDK authenticated with regular credentials")
 			}
 		}
 
@@ -236,16 +251,24 @@ func NewContainer(
 			ConfigRepository: configRepo,
 		}
 
-		rewardVerifier = ags.NewAGSRewardVerifier(entitlementSvc, walletSvc, userID, namespace)
+		rewardVerifier = ags.NewAGSRewardVerifier(entitlementSvc, walletSvc, userID, cfg.Namespace)
+
+		if cfg.AdminClientID != "" {
+			slog.Info("AGS reward verifier initialized with admin credentials (dual token mode)")
 
-		if adminClientID != "" {
-			log.Printf("AGS reward verifier initialized with admin credentials (dual token mode)")
+			// Namespace listing needs an admin token, so it is only available in dual token mode
+			namespaceSvc := &basic.NamespaceService{
+				Client:           factory.NewBasicClient(configRepo),
+				TokenRepository:  tokenRepo,
+				ConfigRepository: configRepo,
+			}
+			namespaceLister = ags.NewAGSNamespaceLister(namespaceSvc)
 		} else {
-			log.Printf("AGS reward verifier initialized with regular client credentials")
+			slog.Info("AGS reward verifier initialized with regular client credentials")
 		}
 	} else {
 		// No platform URL provided, use mock verifier as fallback
-		log.Printf("Warning: No platform URL provided, using mock reward verifier")
+		slog.Warn("No platform URL provided, using mock reward verifier")
 		rewardVerifier = ags.NewMockRewardVerifier()
 	}
 
@@ -255,9 +278,65 @@ func NewContainer(
 		APIClient:         apiClient,
 		EventTrigger:      eventTrigger,
 		RewardVerifier:    rewardVerifier,
+		NamespaceLister:   namespaceLister,
 		UserID:            userID,
-		Namespace:         namespace,
+		Namespace:         cfg.Namespace,
+		mockAuth:          mockAuth,
+		httpClient:        httpClient,
+	}
+}
+
+// SwitchUser makes later API calls act as another user without rebuilding the container
+// It issues a new mock JWT and mock user header, points the AGS reward verifier at the new
+// user, and drops cached responses of the previous user. Password and token modes return
+// ErrUserSwitchUnsupported, as their user comes from the logged-in token.
+func (c *Container) SwitchUser(userID string) error {
+	if userID == "" {
+		return fmt.Errorf("user ID cannot be empty")
 	}
+	if c.mockAuth == nil {
+		return ErrUserSwitchUnsupported
+	}
+
+	c.mockAuth.SetUserID(userID)
+	if c.httpClient != nil {
+		c.httpClient.SetUserID(userID)
+	}
+	api.InvalidateCache(c.APIClient)
+	if verifier, ok := c.RewardVerifier.(*ags.AGSRewardVerifier); ok {
+		verifier.SetUserID(userID)
+	}
+	c.UserID = userID
+
+	slog.Info("Switched user", "user_id", userID)
+	return nil
+}
+
+// tokenUserID returns the user ID from the sub claim of the provider's token
+// It falls back to the --user-id value if authentication fails or the token has no
+// sub claim, and warns if the token's namespace differs from --namespace.
+func tokenUserID(authProvider auth.AuthProvider, authMode, userID, namespace string) string {
+	token, err := authProvider.GetToken(context.Background())
+	if err != nil {
+		slog.Warn("Failed to authenticate, falling back to --user-id", "auth_mode", authMode, "error", err, "user_id", userID)
+		return userID
+	}
+
+	claims, err := auth.ParseJWTClaims(token.AccessToken)
+	if err != nil {
+		slog.Warn("Failed to read JWT claims, using --user-id", "error", err, "user_id", userID)
+		return userID
+	}
+	if claims.Namespace != "" && claims.Namespace != namespace {
+		slog.Warn("JWT namespace differs from --namespace; results may be empty if --namespace is a typo", "token_namespace", claims.Namespace, "namespace", namespace)
+	}
+	if claims.Subject == "" {
+		slog.Warn("JWT has no sub claim, using --user-id", "user_id", userID)
+		return userID
+	}
+
+	slog.Info("Extracted user ID from JWT token", "user_id", claims.Subject)
+	return claims.Subject
 }
 
 // setSDKEnvironmentVariables sets the environment variables required by AccelByte Go SDK
@@ -285,5 +364,5 @@ func setSDKEnvironmentVariables(platformURL, iamURL, clientID, clientSecret, nam
 	os.Setenv("AB_CLIENT_SECRET", clientSecret)
 	os.Setenv("AB_NAMESPACE", namespace)
 
-	log.Printf("SDK environment configured: AB_BASE_URL=%s, AB_NAMESPACE=%s", baseURL, namespace)
+	slog.Debug("SDK environment configured", "AB_BASE_URL", baseURL, "AB_NAMESPACE", namespace)
 }
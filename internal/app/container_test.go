@@ -4,24 +4,28 @@
 
 package app
 
-import "testing"
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+)
 
 func TestNewContainer(t *testing.T) {
-	container := NewContainer(
-		"http://localhost:8080", // backendURL
-		"mock",                  // authMode
-		"",                      // eventHandlerURL
-		"test-user",             // userID
-		"demo",                  // namespace
-		"",                      // email
-		"",                      // password
-		"",                      // clientID
-		"",                      // clientSecret
-		"",                      // iamURL
-		"",                      // platformURL
-		"",                      // adminClientID
-		"",                      // adminClientSecret
-	)
+	container := NewContainer(ContainerConfig{
+		BackendURL: "http://localhost:8080",
+		AuthMode:   "mock",
+		UserID:     "test-user",
+		Namespace:  "demo",
+	})
 
 	if container == nil {
 		t.Fatal("Expected non-nil container")
@@ -49,21 +53,17 @@ func TestNewContainer_DifferentAuthMode(t *testing.T) {
 	modes := []string{"mock", "password", "client", "invalid"}
 
 	for _, mode := range modes {
-		container := NewContainer(
-			"http://localhost:8080", // backendURL
-			mode,                    // authMode
-			"",                      // eventHandlerURL
-			"test-user",             // userID
-			"demo",                  // namespace
-			"alice@example.com",     // email (for password mode)
-			"password123",           // password (for password mode)
-			"client-id",             // clientID
-			"client-secret",         // clientSecret
-			"https://demo.accelbyte.io/iam", // iamURL
-			"",                      // platformURL
-			"",                      // adminClientID
-			"",                      // adminClientSecret
-		)
+		container := NewContainer(ContainerConfig{
+			BackendURL:   "http://localhost:8080",
+			AuthMode:     mode,
+			UserID:       "test-user",
+			Namespace:    "demo",
+			Email:        "alice@example.com", // for password mode
+			Password:     "password123",       // for password mode
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			IAMURL:       "https://demo.accelbyte.io/iam",
+		})
 
 		if container == nil {
 			t.Fatalf("Expected non-nil container for mode %s", mode)
@@ -78,21 +78,13 @@ func TestNewContainer_DifferentAuthMode(t *testing.T) {
 func TestNewContainer_WithEventHandler(t *testing.T) {
 	// Note: This will fail to connect since there's no event handler running,
 	// but should still create a container with nil EventTrigger
-	container := NewContainer(
-		"http://localhost:8080", // backendURL
-		"mock",                  // authMode
-		"localhost:9999",        // eventHandlerURL
-		"test-user",             // userID
-		"demo",                  // namespace
-		"",                      // email
-		"",                      // password
-		"",                      // clientID
-		"",                      // clientSecret
-		"",                      // iamURL
-		"",                      // platformURL
-		"",                      // adminClientID
-		"",                      // adminClientSecret
-	)
+	container := NewContainer(ContainerConfig{
+		BackendURL:      "http://localhost:8080",
+		AuthMode:        "mock",
+		EventHandlerURL: "localhost:9999",
+		UserID:          "test-user",
+		Namespace:       "demo",
+	})
 
 	if container == nil {
 		t.Fatal("Expected non-nil container")
@@ -103,3 +95,149 @@ func TestNewContainer_WithEventHandler(t *testing.T) {
 		t.Error("Expected nil EventTrigger when event handler is not running")
 	}
 }
+
+func TestNewContainer_MockBackend(t *testing.T) {
+	container := NewContainer(ContainerConfig{
+		BackendURL:      MockBackendURL,
+		AuthMode:        "password",       // ignored offline
+		EventHandlerURL: "localhost:6566", // ignored offline
+		UserID:          "test-user",
+		Namespace:       "demo",
+		Email:           "alice@example.com",
+		Password:        "password123",
+		ClientID:        "client-id",
+		ClientSecret:    "client-secret",
+		IAMURL:          "https://demo.accelbyte.io/iam",
+		PlatformURL:     "https://demo.accelbyte.io/platform",
+	})
+
+	if _, ok := container.APIClient.(*api.MockAPIClient); !ok {
+		t.Fatalf("Expected *api.MockAPIClient, got %T", container.APIClient)
+	}
+	if _, ok := container.AuthProvider.(*auth.MockAuthProvider); !ok {
+		t.Errorf("Expected mock auth provider offline, got %T", container.AuthProvider)
+	}
+	if _, ok := container.RewardVerifier.(*ags.MockRewardVerifier); !ok {
+		t.Errorf("Expected mock reward verifier offline, got %T", container.RewardVerifier)
+	}
+	if container.EventTrigger != nil {
+		t.Error("Expected no event trigger offline")
+	}
+
+	challenges, err := container.APIClient.ListChallenges(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(challenges) == 0 {
+		t.Error("Expected demo challenges")
+	}
+
+	// Claims update the in-memory state
+	if _, err := container.APIClient.ClaimReward(context.Background(), "daily-quests", "kill-10"); err != nil {
+		t.Fatalf("Unexpected claim error: %v", err)
+	}
+	if _, err := container.APIClient.ClaimReward(context.Background(), "daily-quests", "kill-10"); err == nil {
+		t.Error("Expected second claim to fail")
+	}
+}
+
+func TestNewContainer_TokenMode(t *testing.T) {
+	// Mint a JWT for another user, as a CI step would
+	token, err := auth.NewMockAuthProvider("ci-user", "demo").GetToken(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	container := NewContainer(ContainerConfig{
+		BackendURL:  "http://localhost:8080",
+		AuthMode:    "token",
+		UserID:      "test-user",
+		Namespace:   "demo",
+		AccessToken: token.AccessToken,
+	})
+
+	if _, ok := container.AuthProvider.(*auth.StaticTokenAuthProvider); !ok {
+		t.Errorf("Expected StaticTokenAuthProvider, got %T", container.AuthProvider)
+	}
+	if container.UserID != "ci-user" {
+		t.Errorf("Expected UserID 'ci-user' from the token, got '%s'", container.UserID)
+	}
+}
+
+func TestContainer_SwitchUser(t *testing.T) {
+	var mockUser string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mockUser = r.Header.Get("x-mock-user-id")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"challenges":[]}`))
+	}))
+	defer server.Close()
+
+	container := NewContainer(ContainerConfig{BackendURL: server.URL, AuthMode: "mock", UserID: "alice", Namespace: "demo"})
+	if err := container.SwitchUser("bob"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if container.UserID != "bob" {
+		t.Errorf("Expected UserID 'bob', got '%s'", container.UserID)
+	}
+	token, err := container.AuthProvider.GetToken(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if claims, err := auth.ParseJWTClaims(token.AccessToken); err != nil || claims.Subject != "bob" {
+		t.Errorf("Expected a JWT for 'bob', got %+v (%v)", claims, err)
+	}
+
+	_, _ = container.APIClient.ListChallenges(context.Background())
+	if mockUser != "bob" {
+		t.Errorf("Expected x-mock-user-id 'bob', got '%s'", mockUser)
+	}
+
+	if err := container.SwitchUser(""); err == nil {
+		t.Error("Expected an error for an empty user ID")
+	}
+
+	// Token mode acts as the token's user (bob, from the token above), so it can't switch
+	tokenContainer := NewContainer(ContainerConfig{BackendURL: server.URL, AuthMode: "token", UserID: "alice", Namespace: "demo", AccessToken: token.AccessToken})
+	if err := tokenContainer.SwitchUser("carol"); !errors.Is(err, ErrUserSwitchUnsupported) {
+		t.Errorf("Expected ErrUserSwitchUnsupported, got %v", err)
+	}
+	if tokenContainer.UserID != "bob" {
+		t.Errorf("Expected UserID to stay 'bob', got '%s'", tokenContainer.UserID)
+	}
+}
+
+func TestTokenUserID_NamespaceMismatch(t *testing.T) {
+	var logs bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	defer slog.SetDefault(prev)
+
+	// The token was minted for "demo"
+	provider := auth.NewMockAuthProvider("ci-user", "demo")
+
+	tests := []struct {
+		name      string
+		namespace string
+		wantWarn  bool
+	}{
+		{name: "same namespace", namespace: "demo", wantWarn: false},
+		{name: "other namespace", namespace: "dmeo", wantWarn: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs.Reset()
+
+			if userID := tokenUserID(provider, "token", "test-user", tt.namespace); userID != "ci-user" {
+				t.Errorf("Expected user ID 'ci-user', got '%s'", userID)
+			}
+
+			warned := strings.Contains(logs.String(), "JWT namespace differs from --namespace")
+			if warned != tt.wantWarn {
+				t.Errorf("Expected namespace warning %v, got logs:\n%s", tt.wantWarn, logs.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TokenCache persists the user token of a password login (e.g. from the login command),
+// so later password-mode commands can skip the password grant.
+// The file holds a live refresh token, so it is written readable by the owner only.
+type TokenCache struct {
+	path string
+}
+
+// CachedLogin is a cached user token and the IAM client and namespace it was granted for
+type CachedLogin struct {
+	IAMURL       string    `json:"iam_url"`
+	ClientID     string    `json:"client_id"`
+	Namespace    string    `json:"namespace"`
+	Email        string    `json:"email"`
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// NewTokenCache creates a token cache backed by the file at path
+func NewTokenCache(path string) *TokenCache {
+	return &TokenCache{path: path}
+}
+
+// DefaultTokenCachePath returns the default file used to cache the login token.
+//
+// Returns:
+//   - string: Path under the user cache directory, or "" if it cannot be determined
+func DefaultTokenCachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "challenge-demo", "token.json")
+}
+
+// Path returns the file backing the cache
+func (c *TokenCache) Path() string {
+	return c.path
+}
+
+// Load reads the cached login
+//
+// Returns:
+//   - *CachedLogin: The cached login, or nil if nothing is cached
+//   - error: Non-nil if the file exists but could not be read
+func (c *TokenCache) Load() (*CachedLogin, error) {
+	if c.path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token cache %s: %w", c.path, err)
+	}
+
+	var login CachedLogin
+	if err := json.Unmarshal(data, &login); err != nil {
+		return nil, fmt.Errorf("failed to parse token cache %s: %w", c.path, err)
+	}
+	return &login, nil
+}
+
+// Save replaces the cached login
+//
+// Returns:
+//   - error: Non-nil if the file could not be written
+func (c *TokenCache) Save(login *CachedLogin) error {
+	if c.path == "" {
+		return fmt.Errorf("no token cache path (user cache directory unknown)")
+	}
+
+	data, err := json.MarshalIndent(login, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode token cache: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", c.path, err)
+	}
+	if err := os.WriteFile(c.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write token cache %s: %w", c.path, err)
+	}
+
+	return nil
+}
+
+// Matches reports whether the login was granted by the same IAM client for namespace
+// An empty email matches any cached user (commands relying on the login pass none).
+func (l *CachedLogin) Matches(iamURL, clientID, namespace, email string) bool {
+	return l.IAMURL == iamURL && l.ClientID == clientID && l.Namespace == namespace &&
+		(email == "" || l.Email == email)
+}
+
+// Token returns the cached token
+func (l *CachedLogin) Token() *Token {
+	return &Token{
+		AccessToken:  l.AccessToken,
+		TokenType:    l.TokenType,
+		ExpiresAt:    l.ExpiresAt,
+		RefreshToken: l.RefreshToken,
+	}
+}
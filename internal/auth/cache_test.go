@@ -0,0 +1,123 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTokenCache_SaveLoad(t *testing.T) {
+	cache := NewTokenCache(filepath.Join(t.TempDir(), "nested", "token.json"))
+
+	login, err := cache.Load()
+	if err != nil || login != nil {
+		t.Fatalf("Expected no cached login before saving, got %+v, %v", login, err)
+	}
+
+	saved := &CachedLogin{
+		IAMURL:       "https://demo.accelbyte.io/iam",
+		ClientID:     "client-id",
+		Namespace:    "demo",
+		Email:        "alice@example.com",
+		AccessToken:  "access",
+		TokenType:    "Bearer",
+		RefreshToken: "refresh",
+		ExpiresAt:    time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+	if err := cache.Save(saved); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	info, err := os.Stat(cache.Path())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("Expected the cache to be owner-only (0600), got %o", perm)
+	}
+
+	login, err = cache.Load()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if login.Email != saved.Email || login.RefreshToken != saved.RefreshToken || !login.ExpiresAt.Equal(saved.ExpiresAt) {
+		t.Errorf("Expected %+v, got %+v", saved, login)
+	}
+}
+
+func TestTokenCache_LoadCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	if err := os.WriteFile(path, []byte("not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewTokenCache(path).Load(); err == nil {
+		t.Error("Expected an error for a corrupt cache file")
+	}
+}
+
+func TestCachedLogin_Matches(t *testing.T) {
+	login := &CachedLogin{IAMURL: "iam", ClientID: "client", Namespace: "demo", Email: "alice@example.com"}
+
+	tests := []struct {
+		name      string
+		clientID  string
+		namespace string
+		email     string
+		want      bool
+	}{
+		{"same user", "client", "demo", "alice@example.com", true},
+		{"no email given", "client", "demo", "", true},
+		{"other user", "client", "demo", "bob@example.com", false},
+		{"other namespace", "client", "prod", "", false},
+		{"other client", "other", "demo", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := login.Matches("iam", tt.clientID, tt.namespace, tt.email); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPasswordAuthProvider_TokenCache(t *testing.T) {
+	cache := NewTokenCache(filepath.Join(t.TempDir(), "token.json"))
+	err := cache.Save(&CachedLogin{
+		IAMURL:      "https://demo.accelbyte.io/iam",
+		ClientID:    "client-id",
+		Namespace:   "demo",
+		Email:       "alice@example.com",
+		AccessToken: "cached-access",
+		TokenType:   "Bearer",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// No credentials: the cached token is used without contacting IAM
+	provider := NewPasswordAuthProvider("https://demo.accelbyte.io/iam", "client-id", "secret", "demo", "", "",
+		WithTokenCache(cache))
+	token, err := provider.GetToken(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if token.AccessToken != "cached-access" {
+		t.Errorf("Expected the cached token, got %q", token.AccessToken)
+	}
+
+	// A login cached for another namespace is ignored
+	provider = NewPasswordAuthProvider("https://demo.accelbyte.io/iam", "client-id", "secret", "prod", "", "",
+		WithTokenCache(cache))
+	if _, err := provider.GetToken(context.Background()); err == nil {
+		t.Error("Expected an error without credentials or a matching cached login")
+	}
+}
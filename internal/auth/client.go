@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -25,9 +26,12 @@ type ClientAuthProvider struct {
 	clientSecret string
 	namespace    string
 
-	httpClient   *http.Client
+	httpClient    *http.Client
+	refreshBuffer time.Duration // Refresh in the background once the token expires within this
+
 	currentToken *Token
 	mu           sync.RWMutex // Protects currentToken
+	refreshing   atomic.Bool  // Set while a background refresh runs (at most one at a time)
 }
 
 // NewClientAuthProvider creates a new client auth provider
@@ -36,13 +40,16 @@ type ClientAuthProvider struct {
 //   - clientID: OAuth2 client ID for service account
 //   - clientSecret: OAuth2 client secret for service account
 //   - namespace: AGS namespace
-func NewClientAuthProvider(iamURL, clientID, clientSecret, namespace string) *ClientAuthProvider {
+//   - opts: Optional settings (e.g. WithRefreshBuffer)
+func NewClientAuthProvider(iamURL, clientID, clientSecret, namespace string, opts ...ProviderOption) *ClientAuthProvider {
+	cfg := newProviderConfig(opts)
 	return &ClientAuthProvider{
-		iamURL:       iamURL,
-		clientID:     clientID,
-		clientSecret: clientSecret,
-		namespace:    namespace,
-		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		iamURL:        iamURL,
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		namespace:     namespace,
+		refreshBuffer: cfg.refreshBuffer,
+		httpClient:    &http.Client{Timeout: 10 * time.Second},
 	}
 }
 
@@ -71,6 +78,10 @@ func (c *ClientAuthProvider) Authenticate(ctx context.Context) (*Token, error) {
 
 	// Check status
 	if resp.StatusCode != http.StatusOK {
+		// 4xx means IAM rejected the client credentials
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			return nil, fmt.Errorf("%w: IAM returned status %d", ErrAuthFailed, resp.StatusCode)
+		}
 		return nil, fmt.Errorf("authentication failed with status %d", resp.StatusCode)
 	}
 
@@ -181,10 +192,12 @@ func (c *ClientAuthProvider) GetToken(ctx context.Context) (*Token, error) {
 		return c.RefreshToken(ctx, token)
 	}
 
-	// Token expiring soon (within 5 minutes)
-	if token.ExpiresIn() < 5*time.Minute {
-		// Try to refresh in background, but return current token
+	// Token expiring soon (within the refresh buffer, 5 minutes by default)
+	// Try to refresh in background, but return current token. The TUI calls this on every
+	// render, so only start a refresh if none is already running.
+	if token.ExpiresIn() < c.refreshBuffer && c.refreshing.CompareAndSwap(false, true) {
 		go func() {
+			defer c.refreshing.Store(false)
 			refreshCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 			defer cancel()
 			_, _ = c.RefreshToken(refreshCtx, token)
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Claims are the JWT payload claims the demo app reads from an access token
+type Claims struct {
+	Subject   string `json:"sub"`       // User ID; absent for client credentials tokens
+	Namespace string `json:"namespace"` // AccelByte namespace
+	ClientID  string `json:"client_id"`
+	ExpiresAt int64  `json:"exp"` // Unix seconds (0 if absent)
+	IssuedAt  int64  `json:"iat"` // Unix seconds (0 if absent)
+}
+
+// Expiry returns the exp claim as a time (zero if absent)
+func (c *Claims) Expiry() time.Time {
+	if c.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+// ParseJWTClaims decodes the payload of a JWT without verifying its signature
+// It is only meant for displaying token contents; never use it to trust a token.
+//
+// Returns:
+//   - *Claims: The decoded claims
+//   - error: Non-nil if the token is not a JWT or its payload is not valid JSON
+func ParseJWTClaims(token string) (*Claims, error) {
+	// JWT format: header.payload.signature
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid JWT format: expected 3 parts, got %d", len(parts))
+	}
+
+	// Decode the payload (second part); padding is optional in JWTs
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JWT payload: %w", err)
+	}
+
+	var claims Claims
+	if err := json.Unmarshal(decoded, &claims); err != nil {
+		return nil, fmt.Errorf("failed to parse JWT claims: %w", err)
+	}
+
+	return &claims, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package auth
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestParseJWTClaims_UnpaddedPayload(t *testing.T) {
+	// Mock tokens use unpadded base64url, as most issuers do
+	claims, err := ParseJWTClaims(generateMockJWT("user-1", "demo"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if claims.Subject != "user-1" || claims.Namespace != "demo" {
+		t.Errorf("Expected user-1 @ demo, got %s @ %s", claims.Subject, claims.Namespace)
+	}
+	if claims.Expiry().IsZero() {
+		t.Error("Expected exp claim to be decoded")
+	}
+}
+
+func TestParseJWTClaims_PaddedPayload(t *testing.T) {
+	payload := base64.URLEncoding.EncodeToString([]byte(`{"client_id":"svc"}`))
+	if !strings.HasSuffix(payload, "=") {
+		t.Fatalf("Test payload should need padding, got %s", payload)
+	}
+
+	claims, err := ParseJWTClaims("header." + payload + ".sig")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if claims.Subject != "" || claims.ClientID != "svc" || !claims.Expiry().IsZero() {
+		t.Errorf("Unexpected claims: %+v", claims)
+	}
+}
+
+func TestParseJWTClaims_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{"opaque token", "not-a-jwt", "invalid JWT format"},
+		{"bad base64", "header.!!!.sig", "failed to decode JWT payload"},
+		{"payload not JSON", "header." + base64.RawURLEncoding.EncodeToString([]byte("hello")) + ".sig", "failed to parse JWT claims"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseJWTClaims(tt.token)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
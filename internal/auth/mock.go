@@ -9,14 +9,16 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 )
 
 // MockAuthProvider implements AuthProvider with a static token for local development
 type MockAuthProvider struct {
 	token     *Token
-	userID    string // User ID to embed in JWT
-	namespace string // Namespace to embed in JWT
+	userID    string       // User ID to embed in JWT
+	namespace string       // Namespace to embed in JWT
+	mu        sync.RWMutex // Protects token and userID
 }
 
 // NewMockAuthProvider creates a new mock auth provider
@@ -41,30 +43,51 @@ func NewMockAuthProvider(userID, namespace string) *MockAuthProvider {
 
 // Authenticate returns the static token
 func (p *MockAuthProvider) Authenticate(ctx context.Context) (*Token, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	return p.token, nil
 }
 
 // RefreshToken returns a new static token
 func (p *MockAuthProvider) RefreshToken(ctx context.Context, token *Token) (*Token, error) {
 	// Generate new token with 1 hour expiry using stored userID and namespace
-	newToken := &Token{
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.token = &Token{
 		AccessToken:  generateMockJWT(p.userID, p.namespace),
 		TokenType:    "Bearer",
 		ExpiresAt:    time.Now().Add(1 * time.Hour),
 		RefreshToken: "",
 	}
+	return p.token, nil
+}
 
-	p.token = newToken
-	return newToken, nil
+// SetUserID switches the provider to another user, issuing a new token with their "sub" claim
+func (p *MockAuthProvider) SetUserID(userID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.userID = userID
+	p.token = &Token{
+		AccessToken:  generateMockJWT(userID, p.namespace),
+		TokenType:    "Bearer",
+		ExpiresAt:    time.Now().Add(1 * time.Hour),
+		RefreshToken: "",
+	}
 }
 
 // GetToken returns the current static token
 func (p *MockAuthProvider) GetToken(ctx context.Context) (*Token, error) {
+	p.mu.RLock()
+	token := p.token
+	p.mu.RUnlock()
+
 	// If expired, refresh
-	if p.token.IsExpired() {
-		return p.RefreshToken(ctx, p.token)
+	if token.IsExpired() {
+		return p.RefreshToken(ctx, token)
 	}
-	return p.token, nil
+	return token, nil
 }
 
 // IsTokenValid checks if token is valid
@@ -6,6 +6,7 @@ package auth
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 )
@@ -176,3 +177,54 @@ func TestMockAuthProvider_DifferentUsers(t *testing.T) {
 		t.Error("Expected different tokens for different users")
 	}
 }
+
+func TestMockAuthProvider_SetUserID(t *testing.T) {
+	provider := NewMockAuthProvider("alice", "demo")
+	provider.SetUserID("bob")
+
+	// Both the current and refreshed tokens carry the new user
+	ctx := context.Background()
+	token, err := provider.GetToken(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	refreshed, err := provider.RefreshToken(ctx, token)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, tok := range []*Token{token, refreshed} {
+		claims, err := ParseJWTClaims(tok.AccessToken)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if claims.Subject != "bob" || claims.Namespace != "demo" {
+			t.Errorf("Expected sub 'bob' in namespace 'demo', got '%s' in '%s'", claims.Subject, claims.Namespace)
+		}
+	}
+}
+
+func TestMockAuthProvider_ConcurrentRefresh(t *testing.T) {
+	// Run with -race: the TUI refreshes the token while the header reads it
+	provider := NewMockAuthProvider("dave", "demo")
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := provider.RefreshToken(ctx, nil); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			token, err := provider.GetToken(ctx)
+			if err != nil || !provider.IsTokenValid(token) {
+				t.Errorf("Expected a valid token, got %v (%v)", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
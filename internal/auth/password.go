@@ -6,12 +6,17 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclient"
 	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclient/o_auth2_0"
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
 	"github.com/go-openapi/runtime/client"
 )
 
@@ -24,9 +29,16 @@ type PasswordAuthProvider struct {
 	namespace    string
 	email        string // User email
 	password     string // User password
+	mfaToken     string // Optional MFA code sent with the password grant
+
+	tokenCache  *TokenCache // Optional cache the current token is loaded from and saved to
+	cachedEmail string      // Email of the cached login, when email is not given
+
+	refreshBuffer time.Duration // Refresh in the background once the token expires within this
 
 	currentToken *Token
 	mu           sync.RWMutex // Protects currentToken
+	refreshing   atomic.Bool  // Set while a background refresh runs (at most one at a time)
 }
 
 // NewPasswordAuthProvider creates a new password auth provider
@@ -37,19 +49,80 @@ type PasswordAuthProvider struct {
 //   - namespace: AGS namespace
 //   - email: User email for login
 //   - password: User password for login
-func NewPasswordAuthProvider(iamURL, clientID, clientSecret, namespace, email, password string) *PasswordAuthProvider {
-	return &PasswordAuthProvider{
-		iamURL:       iamURL,
-		clientID:     clientID,
-		clientSecret: clientSecret,
-		namespace:    namespace,
-		email:        email,
-		password:     password,
+//   - opts: Optional settings (e.g. WithRefreshBuffer)
+func NewPasswordAuthProvider(iamURL, clientID, clientSecret, namespace, email, password string, opts ...ProviderOption) *PasswordAuthProvider {
+	cfg := newProviderConfig(opts)
+	p := &PasswordAuthProvider{
+		iamURL:        iamURL,
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		namespace:     namespace,
+		email:         email,
+		password:      password,
+		mfaToken:      cfg.mfaToken,
+		refreshBuffer: cfg.refreshBuffer,
+		tokenCache:    cfg.tokenCache,
+	}
+	p.loadCachedToken()
+	return p
+}
+
+// loadCachedToken starts from the cached login if it was granted for the same client,
+// namespace, and user
+func (p *PasswordAuthProvider) loadCachedToken() {
+	if p.tokenCache == nil {
+		return
+	}
+
+	login, err := p.tokenCache.Load()
+	if err != nil {
+		slog.Warn("Ignoring token cache", "error", err)
+		return
+	}
+	if login == nil || !login.Matches(p.iamURL, p.clientID, p.namespace, p.email) {
+		return
+	}
+
+	p.currentToken = login.Token()
+	p.cachedEmail = login.Email
+}
+
+// storeToken makes token the current token and caches it
+func (p *PasswordAuthProvider) storeToken(token *Token) {
+	p.mu.Lock()
+	p.currentToken = token
+	p.mu.Unlock()
+
+	if p.tokenCache == nil {
+		return
+	}
+
+	email := p.email
+	if email == "" {
+		email = p.cachedEmail
+	}
+	login := &CachedLogin{
+		IAMURL:       p.iamURL,
+		ClientID:     p.clientID,
+		Namespace:    p.namespace,
+		Email:        email,
+		AccessToken:  token.AccessToken,
+		TokenType:    token.TokenType,
+		RefreshToken: token.RefreshToken,
+		ExpiresAt:    token.ExpiresAt,
+	}
+	if err := p.tokenCache.Save(login); err != nil {
+		slog.Warn("Failed to cache token", "error", err)
 	}
 }
 
 // Authenticate performs OAuth2 Password Grant flow using AccelByte Go SDK
 func (p *PasswordAuthProvider) Authenticate(ctx context.Context) (*Token, error) {
+	// Without credentials only a cached login can be used, and it could not be refreshed
+	if p.email == "" && p.password == "" {
+		return nil, fmt.Errorf("%w: no --email and --password given and no cached login (run challenge-demo login)", ErrAuthFailed)
+	}
+
 	// Create IAM client from base URL
 	iamClient := createIAMClient(p.iamURL)
 
@@ -60,6 +133,9 @@ func (p *PasswordAuthProvider) Authenticate(ctx context.Context) (*Token, error)
 		Password:  &p.password,
 		Context:   ctx,
 	}
+	if p.mfaToken != "" {
+		params.Code = &p.mfaToken
+	}
 
 	// Create Basic Auth with client credentials
 	basicAuth := client.BasicAuth(p.clientID, p.clientSecret)
@@ -67,6 +143,13 @@ func (p *PasswordAuthProvider) Authenticate(ctx context.Context) (*Token, error)
 	// Call TokenGrantV3Short with Basic Auth
 	ok, err := iamClient.OAuth20.TokenGrantV3Short(params, basicAuth)
 	if err != nil {
+		if isMFARequired(err) {
+			return nil, fmt.Errorf("password grant failed: %w: %w", ErrMFARequired, err)
+		}
+		// A non-transient failure means IAM rejected the credentials
+		if !retry.IsRetryable(err) {
+			return nil, fmt.Errorf("password grant failed: %w: %w", ErrAuthFailed, err)
+		}
 		return nil, fmt.Errorf("password grant failed: %w", err)
 	}
 
@@ -99,9 +182,7 @@ func (p *PasswordAuthProvider) Authenticate(ctx context.Context) (*Token, error)
 	}
 
 	// Store current token
-	p.mu.Lock()
-	p.currentToken = token
-	p.mu.Unlock()
+	p.storeToken(token)
 
 	return token, nil
 }
@@ -163,9 +244,7 @@ func (p *PasswordAuthProvider) RefreshToken(ctx context.Context, token *Token) (
 	}
 
 	// Store current token
-	p.mu.Lock()
-	p.currentToken = newToken
-	p.mu.Unlock()
+	p.storeToken(newToken)
 
 	return newToken, nil
 }
@@ -186,10 +265,12 @@ func (p *PasswordAuthProvider) GetToken(ctx context.Context) (*Token, error) {
 		return p.RefreshToken(ctx, token)
 	}
 
-	// Token expiring soon (within 5 minutes)
-	if token.ExpiresIn() < 5*time.Minute {
-		// Try to refresh in background, but return current token
+	// Token expiring soon (within the refresh buffer, 5 minutes by default)
+	// Try to refresh in background, but return current token. The TUI calls this on every
+	// render, so only start a refresh if none is already running.
+	if token.ExpiresIn() < p.refreshBuffer && p.refreshing.CompareAndSwap(false, true) {
 		go func() {
+			defer p.refreshing.Store(false)
 			refreshCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 			defer cancel()
 			_, _ = p.RefreshToken(refreshCtx, token)
@@ -207,6 +288,21 @@ func (p *PasswordAuthProvider) IsTokenValid(token *Token) bool {
 	return !token.IsExpired()
 }
 
+// mfaRequiredError is the OAuth error IAM returns when a password grant needs an MFA code
+const mfaRequiredError = "mfa_required"
+
+// isMFARequired reports whether a token grant failed because the account needs an MFA code
+func isMFARequired(err error) bool {
+	var resp interface {
+		GetPayload() *iamclientmodels.OauthmodelErrorResponse
+	}
+	if !errors.As(err, &resp) {
+		return false
+	}
+	payload := resp.GetPayload()
+	return payload != nil && payload.Error != nil && *payload.Error == mfaRequiredError
+}
+
 // createIAMClient creates an AccelByte IAM client from the IAM base URL
 func createIAMClient(iamURL string) *iamclient.JusticeIamService {
 	// Parse the IAM URL to extract scheme and host
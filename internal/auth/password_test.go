@@ -7,8 +7,12 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -163,6 +167,76 @@ func TestPasswordAuthProvider_Authenticate_InvalidCredentials(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error for 401 response")
 	}
+
+	if !errors.Is(err, ErrAuthFailed) {
+		t.Errorf("Expected ErrAuthFailed for rejected credentials, got %v", err)
+	}
+}
+
+func TestPasswordAuthProvider_Authenticate_MFA(t *testing.T) {
+	// Mock IAM server that requires an MFA code on the password grant
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse form: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Form.Get("code") != "123456" {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"error":             "mfa_required",
+				"error_description": "mfa required",
+				"mfa_token":         "pending-mfa",
+			})
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "mfa-user-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		opts      []ProviderOption
+		wantToken string
+		wantMFA   bool
+	}{
+		{name: "without code", wantMFA: true},
+		{name: "with code", opts: []ProviderOption{WithMFAToken("123456")}, wantToken: "mfa-user-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewPasswordAuthProvider(server.URL, "test-client", "test-secret", "demo", "alice@example.com", "password123", tt.opts...)
+
+			token, err := provider.Authenticate(context.Background())
+
+			if tt.wantMFA {
+				if !errors.Is(err, ErrMFARequired) {
+					t.Fatalf("Expected ErrMFARequired, got %v", err)
+				}
+				if !errors.Is(err, ErrAuthFailed) {
+					t.Errorf("Expected ErrMFARequired to wrap ErrAuthFailed, got %v", err)
+				}
+				if !strings.Contains(err.Error(), "--mfa-token") {
+					t.Errorf("Expected error to mention --mfa-token, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if token.AccessToken != tt.wantToken {
+				t.Errorf("Expected access token '%s', got '%s'", tt.wantToken, token.AccessToken)
+			}
+		})
+	}
 }
 
 func TestPasswordAuthProvider_RefreshToken(t *testing.T) {
@@ -380,3 +454,123 @@ func TestPasswordAuthProvider_IsTokenValid(t *testing.T) {
 		})
 	}
 }
+
+func TestGetToken_SingleBackgroundRefresh(t *testing.T) {
+	expiringSoon := &Token{AccessToken: "expiring-token", TokenType: "Bearer", ExpiresAt: time.Now().Add(time.Minute), RefreshToken: "old-refresh"}
+
+	tests := []struct {
+		name        string
+		newProvider func(iamURL string) (AuthProvider, *atomic.Bool)
+	}{
+		{"password", func(iamURL string) (AuthProvider, *atomic.Bool) {
+			p := NewPasswordAuthProvider(iamURL, "test-client", "test-secret", "demo", "alice@example.com", "password123")
+			p.currentToken = expiringSoon
+			return p, &p.refreshing
+		}},
+		{"client", func(iamURL string) (AuthProvider, *atomic.Bool) {
+			c := NewClientAuthProvider(iamURL, "test-client", "test-secret", "demo")
+			c.currentToken = expiringSoon
+			return c, &c.refreshing
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			release := make(chan struct{})
+
+			// Slow IAM server, so every GetToken call happens while the first refresh is in flight
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				<-release
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"access_token":  "refreshed-token",
+					"token_type":    "Bearer",
+					"expires_in":    3600,
+					"refresh_token": "new-refresh",
+				})
+			}))
+			defer server.Close()
+
+			provider, refreshing := tt.newProvider(server.URL)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					token, err := provider.GetToken(context.Background())
+					if err != nil || token.AccessToken != "expiring-token" {
+						t.Errorf("Expected the current token while refreshing, got %v (%v)", token, err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			// Let the refresh finish and wait for the guard to clear
+			close(release)
+			deadline := time.Now().Add(5 * time.Second)
+			for refreshing.Load() && time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			if got := calls.Load(); got != 1 {
+				t.Errorf("Expected 1 refresh request, got %d", got)
+			}
+		})
+	}
+}
+
+func TestGetToken_RefreshBuffer(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []ProviderOption
+		expiresIn   time.Duration
+		wantRefresh bool
+	}{
+		{name: "just above custom buffer", opts: []ProviderOption{WithRefreshBuffer(30 * time.Second)}, expiresIn: 40 * time.Second, wantRefresh: false},
+		{name: "just below custom buffer", opts: []ProviderOption{WithRefreshBuffer(30 * time.Second)}, expiresIn: 20 * time.Second, wantRefresh: true},
+		{name: "below default buffer", expiresIn: 4 * time.Minute, wantRefresh: true},
+		{name: "zero keeps default buffer", opts: []ProviderOption{WithRefreshBuffer(0)}, expiresIn: 4 * time.Minute, wantRefresh: true},
+		{name: "above default buffer", expiresIn: 6 * time.Minute, wantRefresh: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"access_token":  "refreshed-token",
+					"token_type":    "Bearer",
+					"expires_in":    3600,
+					"refresh_token": "new-refresh",
+				})
+			}))
+			defer server.Close()
+
+			provider := NewClientAuthProvider(server.URL, "test-client", "test-secret", "demo", tt.opts...)
+			provider.currentToken = &Token{AccessToken: "current-token", TokenType: "Bearer", ExpiresAt: time.Now().Add(tt.expiresIn)}
+
+			token, err := provider.GetToken(context.Background())
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if token.AccessToken != "current-token" {
+				t.Errorf("Expected the current token, got '%s'", token.AccessToken)
+			}
+
+			// Wait for a started background refresh to finish
+			deadline := time.Now().Add(5 * time.Second)
+			for provider.refreshing.Load() && time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			if got := calls.Load() > 0; got != tt.wantRefresh {
+				t.Errorf("Expected refresh %v, got %v", tt.wantRefresh, got)
+			}
+		})
+	}
+}
@@ -4,7 +4,20 @@
 
 package auth
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrAuthFailed marks errors where IAM rejected the credentials,
+// as opposed to IAM being unreachable or failing.
+var ErrAuthFailed = errors.New("authentication failed")
+
+// ErrMFARequired marks password grants IAM rejected because the account needs an MFA code
+// It wraps ErrAuthFailed, so callers checking for rejected credentials still match.
+var ErrMFARequired = fmt.Errorf("%w: MFA code required (re-run with --mfa-token <code>)", ErrAuthFailed)
 
 // AuthProvider handles authentication and token management
 type AuthProvider interface {
@@ -20,3 +33,53 @@ type AuthProvider interface {
 	// IsTokenValid checks if the token is still valid
 	IsTokenValid(token *Token) bool
 }
+
+// DefaultRefreshBuffer is how long before expiry GetToken starts a background refresh
+const DefaultRefreshBuffer = 5 * time.Minute
+
+// providerConfig holds the settings set by ProviderOptions
+type providerConfig struct {
+	refreshBuffer time.Duration
+	mfaToken      string      // Password grant only
+	tokenCache    *TokenCache // Password grant only
+}
+
+// ProviderOption configures a PasswordAuthProvider or ClientAuthProvider
+type ProviderOption func(*providerConfig)
+
+// WithRefreshBuffer sets how long before expiry a token is refreshed in the background
+// Short-lived tokens need a buffer below their lifetime, or every call refreshes.
+// Non-positive values keep DefaultRefreshBuffer.
+func WithRefreshBuffer(buffer time.Duration) ProviderOption {
+	return func(c *providerConfig) {
+		if buffer > 0 {
+			c.refreshBuffer = buffer
+		}
+	}
+}
+
+// WithMFAToken sends an MFA code with the password grant, for accounts with two-factor login
+// Client credentials grants ignore it.
+func WithMFAToken(code string) ProviderOption {
+	return func(c *providerConfig) {
+		c.mfaToken = code
+	}
+}
+
+// WithTokenCache starts the password grant provider from the login cached in cache, and
+// caches every token it is granted afterwards
+// Client credentials grants ignore it.
+func WithTokenCache(cache *TokenCache) ProviderOption {
+	return func(c *providerConfig) {
+		c.tokenCache = cache
+	}
+}
+
+// newProviderConfig applies opts over the defaults
+func newProviderConfig(opts []ProviderOption) providerConfig {
+	cfg := providerConfig{refreshBuffer: DefaultRefreshBuffer}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// StaticTokenAuthProvider implements AuthProvider with a user token minted elsewhere
+// (e.g. by an earlier CI step), so pipelines don't need to embed passwords.
+// The token cannot be refreshed; once its exp claim passes, GetToken fails.
+type StaticTokenAuthProvider struct {
+	token *Token
+	err   error // Set if the token is not a JWT with an exp claim
+}
+
+// NewStaticTokenAuthProvider creates a provider for a raw bearer token
+// An invalid token is reported by Authenticate and GetToken rather than here, like a
+// rejected password.
+//
+// Parameters:
+//   - accessToken: JWT access token (from --access-token or $CHALLENGE_ACCESS_TOKEN)
+func NewStaticTokenAuthProvider(accessToken string) *StaticTokenAuthProvider {
+	p := &StaticTokenAuthProvider{}
+
+	if accessToken == "" {
+		p.err = fmt.Errorf("%w: no access token given", ErrAuthFailed)
+		return p
+	}
+
+	claims, err := ParseJWTClaims(accessToken)
+	if err != nil {
+		p.err = fmt.Errorf("%w: invalid access token: %w", ErrAuthFailed, err)
+		return p
+	}
+	if claims.ExpiresAt == 0 {
+		p.err = fmt.Errorf("%w: access token has no exp claim", ErrAuthFailed)
+		return p
+	}
+
+	p.token = &Token{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresAt:   claims.Expiry(),
+	}
+	return p
+}
+
+// Authenticate returns the static token, or an error if it is invalid or expired
+func (p *StaticTokenAuthProvider) Authenticate(ctx context.Context) (*Token, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.token.IsExpired() {
+		return nil, fmt.Errorf("%w: access token expired at %s", ErrAuthFailed, p.token.ExpiresAt.Format(time.RFC3339))
+	}
+	return p.token, nil
+}
+
+// RefreshToken cannot mint a new token; it returns the static token while it is valid
+func (p *StaticTokenAuthProvider) RefreshToken(ctx context.Context, token *Token) (*Token, error) {
+	return p.Authenticate(ctx)
+}
+
+// GetToken returns the static token, or an error once it has expired
+func (p *StaticTokenAuthProvider) GetToken(ctx context.Context) (*Token, error) {
+	return p.Authenticate(ctx)
+}
+
+// IsTokenValid checks if a token is still valid
+func (p *StaticTokenAuthProvider) IsTokenValid(token *Token) bool {
+	if token == nil {
+		return false
+	}
+	return !token.IsExpired()
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// jwtWithClaims builds an unsigned JWT with the given JSON payload
+func jwtWithClaims(payload string) string {
+	return "header." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".sig"
+}
+
+func TestStaticTokenAuthProvider_GetToken(t *testing.T) {
+	exp := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	accessToken := jwtWithClaims(fmt.Sprintf(`{"sub":"ci-user","namespace":"demo","exp":%d}`, exp.Unix()))
+
+	provider := NewStaticTokenAuthProvider(accessToken)
+	token, err := provider.GetToken(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if token.AccessToken != accessToken {
+		t.Errorf("Expected the given access token, got '%s'", token.AccessToken)
+	}
+	if token.TokenType != "Bearer" {
+		t.Errorf("Expected token type 'Bearer', got '%s'", token.TokenType)
+	}
+	if !token.ExpiresAt.Equal(exp) {
+		t.Errorf("Expected expiry %v from the exp claim, got %v", exp, token.ExpiresAt)
+	}
+	if !provider.IsTokenValid(token) {
+		t.Error("Expected token to be valid")
+	}
+
+	refreshed, err := provider.RefreshToken(context.Background(), token)
+	if err != nil || refreshed != token {
+		t.Errorf("Expected refresh to return the same token, got %v (%v)", refreshed, err)
+	}
+}
+
+func TestStaticTokenAuthProvider_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		accessToken string
+		wantErr     string
+	}{
+		{name: "empty", accessToken: "", wantErr: "no access token given"},
+		{name: "not a JWT", accessToken: "opaque-token", wantErr: "invalid access token"},
+		{name: "no exp claim", accessToken: jwtWithClaims(`{"sub":"ci-user"}`), wantErr: "no exp claim"},
+		{
+			name:        "expired",
+			accessToken: jwtWithClaims(fmt.Sprintf(`{"sub":"ci-user","exp":%d}`, time.Now().Add(-time.Minute).Unix())),
+			wantErr:     "access token expired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewStaticTokenAuthProvider(tt.accessToken)
+
+			token, err := provider.GetToken(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if !errors.Is(err, ErrAuthFailed) {
+				t.Errorf("Expected ErrAuthFailed, got %v", err)
+			}
+			if token != nil {
+				t.Errorf("Expected nil token, got %v", token)
+			}
+		})
+	}
+}
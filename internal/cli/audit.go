@@ -0,0 +1,92 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// AuditEntry is a single command invocation in the audit log
+type AuditEntry struct {
+	Timestamp  time.Time         `json:"timestamp"`
+	Command    string            `json:"command"`
+	Args       []string          `json:"args"`
+	Flags      map[string]string `json:"flags"` // Explicitly set flags, secrets redacted
+	ExitCode   int               `json:"exit_code"`
+	DurationMs int64             `json:"duration_ms"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// AuditLogger appends one JSON line per command invocation to an audit file
+// Begin is called from the root PersistentPreRunE and End from PersistentPostRunE
+// (or after Execute returns, since Cobra skips post-run hooks when a command fails).
+type AuditLogger struct {
+	path  string
+	entry *AuditEntry
+	start time.Time
+}
+
+// NewAuditLogger creates an audit logger writing to path
+func NewAuditLogger(path string) *AuditLogger {
+	return &AuditLogger{path: path}
+}
+
+// Begin records the command, arguments, and explicitly set flags
+func (a *AuditLogger) Begin(cmd *cobra.Command, args []string) {
+	flags := make(map[string]string)
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		flags[f.Name] = RedactFlag(f.Name, f.Value.String())
+	})
+
+	a.start = time.Now()
+	a.entry = &AuditEntry{
+		Timestamp: a.start.UTC(),
+		Command:   cmd.CommandPath(),
+		Args:      append([]string{}, args...),
+		Flags:     flags,
+	}
+}
+
+// End records the outcome and appends the entry to the audit file
+// Calling End more than once (or without Begin) is a no-op.
+func (a *AuditLogger) End(cmdErr error) error {
+	if a.entry == nil {
+		return nil
+	}
+
+	entry := a.entry
+	a.entry = nil
+
+	entry.DurationMs = time.Since(a.start).Milliseconds()
+	entry.ExitCode = ExitCode(cmdErr)
+	if cmdErr != nil {
+		entry.Error = cmdErr.Error()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+
+	file, err := os.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log %s: %w", a.path, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log %s: %w", a.path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// newAuditedRoot builds a root command wired to the audit logger like main.go
+func newAuditedRoot(path string, runErr error) *cobra.Command {
+	var audit *AuditLogger
+
+	root := &cobra.Command{
+		Use: "challenge-demo",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			audit = NewAuditLogger(path)
+			audit.Begin(cmd, args)
+			return nil
+		},
+		PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+			return audit.End(nil)
+		},
+	}
+	root.PersistentFlags().String("password", "", "")
+	root.PersistentFlags().String("client-secret", "", "")
+	root.PersistentFlags().String("format", "json", "")
+	root.SilenceErrors = true
+	root.SilenceUsage = true
+
+	claim := &cobra.Command{
+		Use: "claim-reward",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runErr
+		},
+	}
+	root.AddCommand(claim)
+
+	return root
+}
+
+func readAuditEntries(t *testing.T, path string) []AuditEntry {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %v", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	entries := []AuditEntry{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("Failed to parse audit entry: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestAuditLogger_RecordsInvocation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	root := newAuditedRoot(path, nil)
+	root.SetArgs([]string{"claim-reward", "daily", "login", "--password", "hunter2", "--client-secret", "s3cr3t", "--format", "table"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	entries := readAuditEntries(t, path)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 audit entry, got %d", len(entries))
+	}
+
+	entry := entries[0]
+	if entry.Command != "challenge-demo claim-reward" {
+		t.Errorf("Expected command 'challenge-demo claim-reward', got '%s'", entry.Command)
+	}
+	if len(entry.Args) != 2 || entry.Args[0] != "daily" || entry.Args[1] != "login" {
+		t.Errorf("Expected args [daily login], got %v", entry.Args)
+	}
+	if entry.ExitCode != ExitSuccess {
+		t.Errorf("Expected exit code %d, got %d", ExitSuccess, entry.ExitCode)
+	}
+	if entry.Timestamp.IsZero() {
+		t.Error("Expected timestamp to be set")
+	}
+	if entry.DurationMs < 0 {
+		t.Errorf("Expected non-negative duration, got %d", entry.DurationMs)
+	}
+
+	// Secrets are redacted, other flags are kept
+	if entry.Flags["password"] != RedactedValue {
+		t.Errorf("Expected password to be redacted, got '%s'", entry.Flags["password"])
+	}
+	if entry.Flags["client-secret"] != RedactedValue {
+		t.Errorf("Expected client-secret to be redacted, got '%s'", entry.Flags["client-secret"])
+	}
+	if entry.Flags["format"] != "table" {
+		t.Errorf("Expected format 'table', got '%s'", entry.Flags["format"])
+	}
+
+	data, _ := os.ReadFile(path)
+	for _, secret := range []string{"hunter2", "s3cr3t"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Expected audit log not to contain secret '%s'", secret)
+		}
+	}
+}
+
+func TestAuditLogger_RecordsFailureAndAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	// First invocation succeeds
+	root := newAuditedRoot(path, nil)
+	root.SetArgs([]string{"claim-reward"})
+	_ = root.Execute()
+
+	// Second invocation fails; PostRunE is skipped so End is called with the error
+	var audit *AuditLogger
+	root = newAuditedRoot(path, errors.New("HTTP 400: not completed"))
+	root.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		audit = NewAuditLogger(path)
+		audit.Begin(cmd, args)
+		return nil
+	}
+	root.SetArgs([]string{"claim-reward"})
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("Expected command error")
+	}
+	if endErr := audit.End(err); endErr != nil {
+		t.Fatalf("Unexpected audit error: %v", endErr)
+	}
+
+	// A second End is a no-op
+	if endErr := audit.End(err); endErr != nil {
+		t.Fatalf("Unexpected audit error: %v", endErr)
+	}
+
+	entries := readAuditEntries(t, path)
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 audit entries, got %d", len(entries))
+	}
+
+	failed := entries[1]
+	if failed.ExitCode != ExitError {
+		t.Errorf("Expected exit code %d, got %d", ExitError, failed.ExitCode)
+	}
+	if failed.Error != "HTTP 400: not completed" {
+		t.Errorf("Expected error to be recorded, got '%s'", failed.Error)
+	}
+}
+
+func TestRedactFlag(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "password", value: "hunter2", expected: RedactedValue},
+		{name: "admin-client-secret", value: "abc", expected: RedactedValue},
+		{name: "client-secret", value: "", expected: ""},
+		{name: "client-id", value: "my-client", expected: "my-client"},
+		{name: "namespace", value: "demo", expected: "demo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactFlag(tt.name, tt.value); got != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/spf13/pflag"
+)
+
+// CacheTTLFlag is the global flag caching Challenge Service reads for a duration (0 = off)
+const CacheTTLFlag = "cache-ttl"
+
+// ValidateCacheTTLFlag checks --cache-ttl is not negative
+func ValidateCacheTTLFlag(flags *pflag.FlagSet) error {
+	ttl, err := flags.GetDuration(CacheTTLFlag)
+	if err != nil {
+		return &UsageError{Err: err}
+	}
+	if ttl < 0 {
+		return UsageErrorf("--%s must not be negative (0 = off), got %s", CacheTTLFlag, ttl)
+	}
+	return nil
+}
+
+// CacheAPIClient wraps the container's API client in a read cache if --cache-ttl is set
+// It must wrap last, so --verbose and --dump-curl only show requests that were actually sent.
+func CacheAPIClient(flags *pflag.FlagSet, container *app.Container) {
+	if flags.Lookup(CacheTTLFlag) == nil {
+		return
+	}
+
+	if ttl, _ := flags.GetDuration(CacheTTLFlag); ttl > 0 {
+		container.APIClient = api.NewCachingAPIClient(container.APIClient, ttl)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/spf13/pflag"
+)
+
+func TestCacheAPIClient(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantErr    bool
+		wantCached bool
+	}{
+		{name: "off by default", args: nil},
+		{name: "enabled", args: []string{"--cache-ttl=5s"}, wantCached: true},
+		{name: "negative", args: []string{"--cache-ttl=-1s"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("challenge-demo", pflag.ContinueOnError)
+			flags.Duration(CacheTTLFlag, 0, "")
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			err := ValidateCacheTTLFlag(flags)
+			if tt.wantErr {
+				var usageErr *UsageError
+				if !errors.As(err, &usageErr) {
+					t.Errorf("Expected usage error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			container := &app.Container{APIClient: api.NewMockAPIClient(nil)}
+			CacheAPIClient(flags, container)
+			if _, cached := container.APIClient.(*api.CachingAPIClient); cached != tt.wantCached {
+				t.Errorf("Expected cached %v, got %T", tt.wantCached, container.APIClient)
+			}
+		})
+	}
+}
+
+func TestCacheAPIClient_WrapsLast(t *testing.T) {
+	flags := pflag.NewFlagSet("challenge-demo", pflag.ContinueOnError)
+	flags.Duration(CacheTTLFlag, time.Second, "")
+
+	verbose := NewVerboseAPIClient(api.NewMockAPIClient(nil), nil)
+	container := &app.Container{APIClient: verbose}
+	CacheAPIClient(flags, container)
+
+	cache, ok := container.APIClient.(*api.CachingAPIClient)
+	if !ok || cache.APIClient != verbose {
+		t.Errorf("Expected the cache around the verbose client, got %T", container.APIClient)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/pflag"
+)
+
+// NoColorFlag is the global flag turning off colored table output
+const NoColorFlag = "no-color"
+
+// EnvNoColor turns off colored output when set to any value (https://no-color.org)
+const EnvNoColor = "NO_COLOR"
+
+// ApplyColorFlags turns off colored table output for --no-color, $NO_COLOR, or -o
+// Output written to a file never gets color codes; otherwise the table formatter
+// colors statuses only when stdout is a terminal.
+func ApplyColorFlags(flags *pflag.FlagSet, getenv func(string) string) {
+	noColor, _ := flags.GetBool(NoColorFlag)
+	outputPath, _ := flags.GetString(OutputFlag)
+
+	output.SetColorDisabled(noColor || getenv(EnvNoColor) != "" || outputPath != "")
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/pflag"
+)
+
+func TestApplyColorFlags(t *testing.T) {
+	t.Cleanup(func() { output.SetColorDisabled(false) })
+
+	tests := []struct {
+		name         string
+		args         []string
+		env          map[string]string
+		wantDisabled bool
+	}{
+		{name: "default", wantDisabled: false},
+		{name: "no-color flag", args: []string{"--no-color"}, wantDisabled: true},
+		{name: "NO_COLOR env", env: map[string]string{EnvNoColor: "1"}, wantDisabled: true},
+		{name: "output file", args: []string{"-o", "report.txt"}, wantDisabled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("challenge-demo", pflag.ContinueOnError)
+			flags.Bool(NoColorFlag, false, "")
+			flags.StringP(OutputFlag, "o", "", "")
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			// Start from the opposite state so the call is observable
+			output.SetColorDisabled(!tt.wantDisabled)
+			ApplyColorFlags(flags, func(key string) string { return tt.env[key] })
+
+			if got := output.ColorDisabled(); got != tt.wantDisabled {
+				t.Errorf("Expected color disabled %v, got %v", tt.wantDisabled, got)
+			}
+		})
+	}
+}
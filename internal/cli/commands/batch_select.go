@@ -5,13 +5,13 @@
 package commands
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 	"github.com/spf13/cobra"
 )
 
@@ -27,14 +27,15 @@ func NewBatchSelectCommand() *cobra.Command {
 		Short: "Batch select multiple goals",
 		Long: `Activate multiple goals at once (M4 feature).
 Provide a comma-separated list of goal IDs to activate.`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeChallengeID,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			challengeID := args[0]
 
 			// Parse goal IDs
 			goalIDList := strings.Split(goalIDs, ",")
 			if len(goalIDList) == 0 {
-				return fmt.Errorf("goal-ids cannot be empty")
+				return cli.UsageErrorf("goal-ids cannot be empty")
 			}
 
 			// Trim whitespace from each goal ID
@@ -55,44 +56,46 @@ Provide a comma-separated list of goal IDs to activate.`,
 			}
 
 			// Call API
-			ctx := context.Background()
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 			result, err := container.APIClient.BatchSelectGoals(ctx, challengeID, req)
 			if err != nil {
 				return fmt.Errorf("failed to batch select goals: %w", err)
 			}
 
 			// Format output
+			var b strings.Builder
 			switch format {
-			case "json":
-				output, err := json.MarshalIndent(result, "", "  ")
+			case "json", "jsonl":
+				data, err := output.MarshalJSON(format, result)
 				if err != nil {
 					return fmt.Errorf("failed to format JSON: %w", err)
 				}
-				fmt.Println(string(output))
+				b.Write(data)
 
 			case "table":
-				fmt.Printf("Batch Goal Selection Completed\n")
-				fmt.Println("─────────────────────────────────────────")
-				fmt.Printf("Challenge ID:      %s\n", result.ChallengeID)
-				fmt.Printf("Selected Goals:    %d\n", len(result.SelectedGoals))
-				fmt.Printf("Total Active:      %d\n", result.TotalActiveGoals)
-				fmt.Printf("Replaced Goals:    %d\n", len(result.ReplacedGoals))
-				fmt.Println("─────────────────────────────────────────")
-				fmt.Println("Selected Goals:")
+				fmt.Fprintf(&b, "Batch Goal Selection Completed\n")
+				fmt.Fprintln(&b, glyph.Rule(41))
+				fmt.Fprintf(&b, "Challenge ID:      %s\n", result.ChallengeID)
+				fmt.Fprintf(&b, "Selected Goals:    %d\n", len(result.SelectedGoals))
+				fmt.Fprintf(&b, "Total Active:      %d\n", result.TotalActiveGoals)
+				fmt.Fprintf(&b, "Replaced Goals:    %d\n", len(result.ReplacedGoals))
+				fmt.Fprintln(&b, glyph.Rule(41))
+				fmt.Fprintln(&b, "Selected Goals:")
 				for _, goal := range result.SelectedGoals {
-					fmt.Printf("  - %s (%s)\n", goal.Name, goal.ID)
+					fmt.Fprintf(&b, "  - %s (%s)\n", goal.Name, goal.ID)
 				}
 
 			default: // text
-				fmt.Printf("✅ Successfully selected %d goals\n", len(result.SelectedGoals))
-				fmt.Printf("   Challenge: %s\n", result.ChallengeID)
-				fmt.Printf("   Total Active: %d\n", result.TotalActiveGoals)
+				fmt.Fprintf(&b, "%s Successfully selected %d goals\n", glyph.Current().Done, len(result.SelectedGoals))
+				fmt.Fprintf(&b, "   Challenge: %s\n", result.ChallengeID)
+				fmt.Fprintf(&b, "   Total Active: %d\n", result.TotalActiveGoals)
 				if len(result.ReplacedGoals) > 0 {
-					fmt.Printf("   Replaced: %d goals\n", len(result.ReplacedGoals))
+					fmt.Fprintf(&b, "   Replaced: %d goals\n", len(result.ReplacedGoals))
 				}
 			}
 
-			return nil
+			return cli.PrintResult(cmd, b.String())
 		},
 	}
 
@@ -6,33 +6,73 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
 	"github.com/spf13/cobra"
 )
 
+// grantPollInterval is how often --verify re-checks AGS Platform after claiming
+const grantPollInterval = 1 * time.Second
+
 // NewClaimCommand creates the claim-reward command
 func NewClaimCommand() *cobra.Command {
+	var (
+		explain         bool
+		showRequirement bool
+		verify          bool
+		verifyTimeout   time.Duration
+	)
+
 	cmd := &cobra.Command{
 		Use:   "claim-reward <challenge-id> <goal-id>",
 		Short: "Claim reward for completed goal",
-		Long:  "Claim the reward for a completed goal within a challenge.",
-		Args:  cobra.ExactArgs(2),
+		Long: `Claim the reward for a completed goal within a challenge.
+
+With --verify, the reward's entitlement quantity or wallet balance is recorded
+before claiming, and AGS Platform is polled afterwards until it has grown by the
+reward quantity. The command fails if the grant is not observed in time.
+
+With --show-requirement, the goal's requirement (stat code, operator, and target) is
+shown alongside the claimed reward.`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeChallengeAndGoalID,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			challengeID := args[0]
 			goalID := args[1]
 
 			// Get format flag
 			format, _ := cmd.Flags().GetString("format")
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
 
 			// Create container
 			container := cli.GetContainerFromFlags(cmd)
 
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+
+			// Snapshot inventory before claiming so the grant can be measured
+			var grant *output.GrantDelta
+			if verify {
+				snapshot, err := snapshotGrant(ctx, container.APIClient, container.RewardVerifier, challengeID, goalID)
+				if err != nil {
+					return fmt.Errorf("failed to snapshot inventory before claim: %w", err)
+				}
+				grant = snapshot
+			}
+
 			// Call API
-			ctx := context.Background()
 			claimResult, err := container.APIClient.ClaimReward(ctx, challengeID, goalID)
 
 			// Prepare output
@@ -46,27 +86,190 @@ func NewClaimCommand() *cobra.Command {
 
 			if err != nil {
 				reward.Status = "error"
+				if explain {
+					reward.Explanation = explainClaimFailure(ctx, container.APIClient, challengeID, goalID)
+				}
 			} else if claimResult != nil {
 				// Use reward from claim result
 				reward.Reward = &claimResult.Reward
 			}
 
+			if err == nil && showRequirement {
+				// The claim already went through, so a failed lookup only drops the requirement
+				requirement, reqErr := goalRequirement(ctx, container.APIClient, challengeID, goalID)
+				if reqErr != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Warning: reward claimed but failed to fetch goal requirement: %v\n", reqErr)
+				}
+				reward.Requirement = requirement
+			}
+
+			var grantErr error
+			if err == nil && grant != nil {
+				grantErr = awaitGrant(ctx, container.RewardVerifier, grant, grantPollInterval, verifyTimeout)
+				reward.Grant = grant
+			}
+
 			// Format output
-			formatter := output.NewFormatter(format)
 			result, formatErr := formatter.FormatClaimResult(reward)
 			if formatErr != nil {
 				return fmt.Errorf("failed to format output: %w", formatErr)
 			}
 
-			fmt.Print(result)
+			if printErr := cli.PrintResult(cmd, result); printErr != nil {
+				return printErr
+			}
 
 			if err != nil {
-				return fmt.Errorf("claim failed: %w", err)
+				return claimError(challengeID, goalID, err)
+			}
+			if grantErr != nil {
+				return fmt.Errorf("reward grant not observed: %w", grantErr)
 			}
 
 			return nil
 		},
 	}
 
+	cmd.Flags().BoolVar(&explain, "explain", false, "On failure, fetch the goal and explain the likely reason")
+	cmd.Flags().BoolVar(&showRequirement, "show-requirement", false, "Show the goal's requirement alongside the claimed reward")
+	cmd.Flags().BoolVar(&verify, "verify", false, "Check AGS Platform before and after claiming and report the granted delta")
+	cmd.Flags().DurationVar(&verifyTimeout, "verify-timeout", 30*time.Second, "How long --verify waits for the grant to appear")
+
 	return cmd
 }
+
+// claimError explains a claim the API rejected because of the goal's state
+// Other errors are returned as a plain claim failure.
+func claimError(challengeID, goalID string, err error) error {
+	var msg string
+	var apiErr *api.APIError
+	code, _ := retry.StatusCode(err)
+	switch {
+	case code == http.StatusNotFound:
+		msg = fmt.Sprintf("goal '%s' not found in challenge '%s'", goalID, challengeID)
+	case code == http.StatusConflict:
+		msg = fmt.Sprintf("goal '%s' already claimed", goalID)
+	case code == http.StatusPreconditionFailed,
+		errors.As(err, &apiErr) && apiErr.GRPCCode == api.GRPCCodeFailedPrecondition:
+		msg = fmt.Sprintf("goal '%s' not completed", goalID)
+	default:
+		return fmt.Errorf("claim failed: %w", err)
+	}
+
+	return &cli.ExplainedError{Msg: "claim failed: " + msg, Err: err}
+}
+
+// explainClaimFailure fetches the challenge and explains why the goal could not be claimed
+func explainClaimFailure(ctx context.Context, apiClient api.APIClient, challengeID, goalID string) string {
+	challenge, err := apiClient.GetChallenge(ctx, challengeID)
+	if err != nil {
+		return fmt.Sprintf("unable to fetch challenge %s to explain failure: %v", challengeID, err)
+	}
+
+	return explainGoalState(challenge, goalID)
+}
+
+// explainGoalState describes the goal state that most likely caused a claim to fail
+func explainGoalState(challenge *api.Challenge, goalID string) string {
+	goal := findGoal(challenge, goalID)
+	if goal == nil {
+		return fmt.Sprintf("goal %s does not exist in challenge %s", goalID, challenge.ID)
+	}
+
+	switch {
+	case goal.Status == "claimed":
+		if goal.ClaimedAt != "" {
+			return fmt.Sprintf("goal already claimed at %s", goal.ClaimedAt)
+		}
+		return "goal already claimed"
+
+	case goal.Locked:
+		unmet := challenge.UnmetPrerequisites(goal)
+		if len(unmet) == 0 {
+			return "goal is locked by prerequisites"
+		}
+		return fmt.Sprintf("goal is locked by unmet prerequisites: %s", strings.Join(unmet, ", "))
+
+	case goal.Status != "completed":
+		return fmt.Sprintf("goal not completed yet (status: %s, progress: %d/%d)",
+			goal.Status, goal.Progress, goal.Requirement.TargetValue)
+	}
+
+	return "goal is completed and claimable; the failure is likely transient or server-side"
+}
+
+// goalRequirement fetches the challenge and returns the goal's requirement
+func goalRequirement(ctx context.Context, apiClient api.APIClient, challengeID, goalID string) (*api.Requirement, error) {
+	challenge, err := apiClient.GetChallenge(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	goal := findGoal(challenge, goalID)
+	if goal == nil {
+		return nil, fmt.Errorf("goal %s not found in challenge %s", goalID, challengeID)
+	}
+
+	return &goal.Requirement, nil
+}
+
+// snapshotGrant looks up the goal's reward and records its current quantity
+// A missing entitlement or wallet counts as 0 (the reward has not been granted yet).
+func snapshotGrant(ctx context.Context, apiClient api.APIClient, verifier ags.RewardVerifier, challengeID, goalID string) (*output.GrantDelta, error) {
+	challenge, err := apiClient.GetChallenge(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	goal := findGoal(challenge, goalID)
+	if goal == nil {
+		return nil, fmt.Errorf("goal %s not found in challenge %s", goalID, challengeID)
+	}
+
+	// verifyReward validates the reward type and reports missing inventory as 0
+	before, err := verifyReward(ctx, verifier, goal.Reward)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output.GrantDelta{
+		RewardType: goal.Reward.Type,
+		RewardID:   goal.Reward.RewardID,
+		Before:     before.ActualQuantity,
+		After:      before.ActualQuantity,
+		Expected:   int64(goal.Reward.Quantity),
+	}, nil
+}
+
+// awaitGrant polls the verifier until the quantity has grown by the expected amount
+// The grant's After, Delta, and Verified fields are updated with the last observation.
+func awaitGrant(ctx context.Context, verifier ags.RewardVerifier, grant *output.GrantDelta, interval, timeout time.Duration) error {
+	reward := api.Reward{Type: grant.RewardType, RewardID: grant.RewardID}
+
+	err := retry.Poll(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		current, err := verifyReward(ctx, verifier, reward)
+		if err != nil {
+			return false, err
+		}
+
+		grant.After = current.ActualQuantity
+		grant.Delta = grant.After - grant.Before
+		grant.Verified = grant.Delta >= grant.Expected
+		return grant.Verified, nil
+	})
+	if err != nil {
+		return fmt.Errorf("expected %+d %s, observed %+d: %w", grant.Expected, grant.RewardID, grant.Delta, err)
+	}
+
+	return nil
+}
+
+// findGoal returns the goal with the given ID, or nil if the challenge has no such goal
+func findGoal(challenge *api.Challenge, goalID string) *api.Goal {
+	for i := range challenge.Goals {
+		if challenge.Goals[i].ID == goalID {
+			return &challenge.Goals[i]
+		}
+	}
+	return nil
+}
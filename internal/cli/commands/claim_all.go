@@ -0,0 +1,211 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// NewClaimAllCommand creates the claim-all command
+func NewClaimAllCommand() *cobra.Command {
+	var (
+		challengeID string
+		dryRun      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "claim-all",
+		Short: "Claim rewards for every completed goal",
+		Long: `Find every goal in "completed" status and claim its reward, printing a
+per-goal summary. Every goal is attempted; the command exits non-zero if any
+claim failed.
+
+The goals of each challenge are claimed in a single batch request. Backends
+without the batch-claim endpoint are detected automatically, and the goals are
+then claimed one request at a time.
+
+Use --challenge to only claim goals of one challenge, and --dry-run to list
+the goals that would be claimed without claiming them.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// Claim completed goals
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			result, err := claimAll(ctx, container.APIClient, challengeID, dryRun)
+			if err != nil {
+				return err
+			}
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			formatted, err := formatter.FormatBulkResult(result)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			if err := cli.PrintResult(cmd, formatted); err != nil {
+				return err
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("%d of %d claims failed", result.Failed, result.Total)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&challengeID, "challenge", "", "Only claim completed goals of this challenge")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the goals that would be claimed without claiming them")
+	_ = cmd.RegisterFlagCompletionFunc("challenge", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeChallengeID(cmd, nil, toComplete)
+	})
+
+	return cmd
+}
+
+// claimAll claims every completed goal (of challengeID only, if set), continuing past failures
+// With dryRun, the goals are listed as skipped without being claimed.
+func claimAll(ctx context.Context, apiClient api.APIClient, challengeID string, dryRun bool) (*output.BulkResult, error) {
+	challenges, err := claimAllChallenges(ctx, apiClient, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	pairs := completedClaimPairs(challenges)
+
+	if dryRun {
+		result := &output.BulkResult{Operation: "claim-all (dry run)", Items: []output.BulkItemResult{}}
+		for _, pair := range pairs {
+			result.Add(output.BulkItemResult{ID: pair.id(), Status: "skipped"})
+		}
+		return result, nil
+	}
+
+	return claimPairsBatched(ctx, apiClient, pairs), nil
+}
+
+// claimPairsBatched claims pairs with one BatchClaimRewards call per challenge
+// If the backend has no batch-claim endpoint, the remaining pairs are claimed one at a time.
+func claimPairsBatched(ctx context.Context, apiClient api.APIClient, pairs []claimPair) *output.BulkResult {
+	result := &output.BulkResult{Operation: "claim-all", Items: []output.BulkItemResult{}}
+
+	// Pairs are in challenge order, so each challenge's goals are contiguous
+	for start := 0; start < len(pairs); {
+		end := start + 1
+		for end < len(pairs) && pairs[end].challengeID == pairs[start].challengeID {
+			end++
+		}
+
+		items, err := batchClaim(ctx, apiClient, pairs[start:end])
+		if errors.Is(err, api.ErrBatchClaimUnsupported) {
+			for _, item := range runClaimBatch(ctx, apiClient, pairs[start:], 1, true).Items {
+				result.Add(item)
+			}
+			return result
+		}
+
+		for _, item := range items {
+			result.Add(item)
+		}
+		start = end
+	}
+
+	return result
+}
+
+// batchClaim claims the goals of one challenge in a single request, returning an item per pair
+// Goals fail individually on a partial success. If the whole request fails, so does every goal,
+// and goals missing from the response are reported as failed. Each item's duration is the
+// duration of the whole request.
+//
+// Returns:
+//   - error: ErrBatchClaimUnsupported if the backend has no batch-claim endpoint
+func batchClaim(ctx context.Context, apiClient api.APIClient, pairs []claimPair) ([]output.BulkItemResult, error) {
+	goalIDs := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		goalIDs = append(goalIDs, pair.goalID)
+	}
+
+	start := time.Now()
+	response, err := apiClient.BatchClaimRewards(ctx, pairs[0].challengeID, goalIDs)
+	durationMs := time.Since(start).Milliseconds()
+	if errors.Is(err, api.ErrBatchClaimUnsupported) {
+		return nil, err
+	}
+
+	results := make(map[string]api.BatchClaimResult)
+	if err == nil {
+		for _, r := range response.Results {
+			results[r.GoalID] = r
+		}
+	}
+
+	items := make([]output.BulkItemResult, 0, len(pairs))
+	for _, pair := range pairs {
+		item := output.BulkItemResult{ID: pair.id(), Status: "success", DurationMs: durationMs}
+		r, ok := results[pair.goalID]
+		switch {
+		case err != nil:
+			item.Error = err
+		case !ok:
+			item.Error = fmt.Errorf("no result for goal %s in the batch claim response", pair.goalID)
+		case r.Error != "":
+			item.Error = errors.New(r.Error)
+		}
+		if item.Error != nil {
+			item.Status = "error"
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// claimAllChallenges returns every challenge, or only challengeID if set
+func claimAllChallenges(ctx context.Context, apiClient api.APIClient, challengeID string) ([]api.Challenge, error) {
+	if challengeID != "" {
+		challenge, err := apiClient.GetChallenge(ctx, challengeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get challenge: %w", err)
+		}
+		return []api.Challenge{*challenge}, nil
+	}
+
+	challenges, err := apiClient.ListChallenges(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list challenges: %w", err)
+	}
+	return challenges, nil
+}
+
+// completedClaimPairs returns a claim pair for every completed (not yet claimed) goal, in challenge order
+func completedClaimPairs(challenges []api.Challenge) []claimPair {
+	pairs := []claimPair{}
+	for _, c := range challenges {
+		for _, g := range c.Goals {
+			if g.Status == "completed" {
+				pairs = append(pairs, claimPair{challengeID: c.ID, goalID: g.ID})
+			}
+		}
+	}
+	return pairs
+}
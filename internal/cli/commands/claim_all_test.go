@@ -0,0 +1,192 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// failingClaimAPI fails claims for the listed challenge/goal IDs
+type failingClaimAPI struct {
+	*api.MockAPIClient
+	fail map[string]bool
+}
+
+func (f *failingClaimAPI) ClaimReward(ctx context.Context, challengeID, goalID string) (*api.ClaimResult, error) {
+	if f.fail[challengeID+"/"+goalID] {
+		return nil, fmt.Errorf("HTTP 500: reward grant failed")
+	}
+	return f.MockAPIClient.ClaimReward(ctx, challengeID, goalID)
+}
+
+func (f *failingClaimAPI) BatchClaimRewards(ctx context.Context, challengeID string, goalIDs []string) (*api.BatchClaimResponse, error) {
+	claimable := []string{}
+	failed := []api.BatchClaimResult{}
+	for _, goalID := range goalIDs {
+		if f.fail[challengeID+"/"+goalID] {
+			failed = append(failed, api.BatchClaimResult{ClaimResult: api.ClaimResult{GoalID: goalID}, Error: "reward grant failed"})
+		} else {
+			claimable = append(claimable, goalID)
+		}
+	}
+
+	response, err := f.MockAPIClient.BatchClaimRewards(ctx, challengeID, claimable)
+	if err != nil {
+		return nil, err
+	}
+	response.Results = append(response.Results, failed...)
+	return response, nil
+}
+
+// truncatedBatchAPI answers batch claims with the first result only
+type truncatedBatchAPI struct {
+	*api.MockAPIClient
+}
+
+func (t *truncatedBatchAPI) BatchClaimRewards(ctx context.Context, challengeID string, goalIDs []string) (*api.BatchClaimResponse, error) {
+	return t.MockAPIClient.BatchClaimRewards(ctx, challengeID, goalIDs[:1])
+}
+
+func newClaimAllMockAPI() *api.MockAPIClient {
+	return api.NewMockAPIClient([]api.Challenge{
+		{
+			ID: "daily",
+			Goals: []api.Goal{
+				{ID: "login", Status: "completed"},
+				{ID: "kills", Status: "in_progress"},
+				{ID: "wins", Status: "completed"},
+				{ID: "old", Status: "claimed"},
+			},
+		},
+		{
+			ID: "weekly",
+			Goals: []api.Goal{
+				{ID: "raid", Status: "completed"},
+			},
+		},
+	})
+}
+
+func TestClaimAll(t *testing.T) {
+	tests := []struct {
+		name        string
+		challengeID string
+		dryRun      bool
+		noBatch     bool
+		wantIDs     string
+		wantClaims  string
+		wantBatches string
+	}{
+		{name: "all challenges", wantIDs: "daily/login,daily/wins,weekly/raid", wantClaims: "daily/login,daily/wins,weekly/raid", wantBatches: "daily,weekly"},
+		{name: "scoped to challenge", challengeID: "weekly", wantIDs: "weekly/raid", wantClaims: "weekly/raid", wantBatches: "weekly"},
+		{name: "dry run", dryRun: true, wantIDs: "daily/login,daily/wins,weekly/raid", wantClaims: "", wantBatches: ""},
+		{name: "no batch endpoint", noBatch: true, wantIDs: "daily/login,daily/wins,weekly/raid", wantClaims: "daily/login,daily/wins,weekly/raid", wantBatches: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiClient := newClaimAllMockAPI()
+			apiClient.NoBatchClaim = tt.noBatch
+			result, err := claimAll(context.Background(), apiClient, tt.challengeID, tt.dryRun)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			ids := []string{}
+			for _, item := range result.Items {
+				ids = append(ids, item.ID)
+			}
+			if got := strings.Join(ids, ","); got != tt.wantIDs {
+				t.Errorf("Expected items %s, got %s", tt.wantIDs, got)
+			}
+			if got := strings.Join(apiClient.ClaimCalls, ","); got != tt.wantClaims {
+				t.Errorf("Expected claims %q, got %q", tt.wantClaims, got)
+			}
+			if got := strings.Join(apiClient.BatchClaimCalls, ","); got != tt.wantBatches {
+				t.Errorf("Expected batch claims %q, got %q", tt.wantBatches, got)
+			}
+
+			if tt.dryRun {
+				if result.Skipped != result.Total || result.Succeeded != 0 {
+					t.Errorf("Expected every dry-run item skipped, got %+v", result)
+				}
+			} else if result.Succeeded != result.Total {
+				t.Errorf("Expected every claim to succeed, got %+v", result)
+			}
+		})
+	}
+}
+
+func TestClaimAll_ContinuesPastFailures(t *testing.T) {
+	for _, noBatch := range []bool{false, true} {
+		t.Run(fmt.Sprintf("noBatch=%v", noBatch), func(t *testing.T) {
+			mock := newClaimAllMockAPI()
+			mock.NoBatchClaim = noBatch
+			apiClient := &failingClaimAPI{MockAPIClient: mock, fail: map[string]bool{"daily/login": true}}
+
+			result, err := claimAll(context.Background(), apiClient, "", false)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.Operation != "claim-all" {
+				t.Errorf("Expected operation claim-all, got %s", result.Operation)
+			}
+			if result.Failed != 1 || result.Succeeded != 2 || result.Skipped != 0 {
+				t.Errorf("Expected 1 failed and 2 succeeded, got %+v", result)
+			}
+			if result.Items[0].Status != "error" || !strings.Contains(result.Items[0].Error.Error(), "reward grant failed") {
+				t.Errorf("Expected daily/login to fail, got %+v", result.Items[0])
+			}
+		})
+	}
+}
+
+func TestClaimAll_BatchRequestFailed(t *testing.T) {
+	apiClient := newClaimAllMockAPI()
+	apiClient.Challenges = apiClient.Challenges[:1]
+
+	// Make the batch request fail after the challenges are listed
+	pairs := completedClaimPairs(apiClient.Challenges)
+	apiClient.Error = fmt.Errorf("HTTP 503: service unavailable")
+	result := claimPairsBatched(context.Background(), apiClient, pairs)
+
+	if result.Failed != 2 || result.Succeeded != 0 {
+		t.Errorf("Expected every goal of the failed batch to fail, got %+v", result)
+	}
+	for _, item := range result.Items {
+		if item.Error == nil || !strings.Contains(item.Error.Error(), "service unavailable") {
+			t.Errorf("Expected the request error for %s, got %v", item.ID, item.Error)
+		}
+	}
+}
+
+func TestClaimAll_BatchMissingResult(t *testing.T) {
+	apiClient := &truncatedBatchAPI{MockAPIClient: newClaimAllMockAPI()}
+
+	result, err := claimAll(context.Background(), apiClient, "daily", false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.Succeeded != 1 || result.Failed != 1 {
+		t.Errorf("Expected 1 succeeded and 1 failed, got %+v", result)
+	}
+	if result.Items[1].ID != "daily/wins" || result.Items[1].Error == nil || !strings.Contains(result.Items[1].Error.Error(), "no result for goal wins") {
+		t.Errorf("Expected daily/wins to fail without a result, got %+v", result.Items[1])
+	}
+}
+
+func TestClaimAll_UnknownChallenge(t *testing.T) {
+	_, err := claimAll(context.Background(), newClaimAllMockAPI(), "missing", false)
+	if err == nil || !strings.Contains(err.Error(), "failed to get challenge") {
+		t.Errorf("Expected get challenge error, got %v", err)
+	}
+}
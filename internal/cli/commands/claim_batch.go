@@ -0,0 +1,249 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// claimPair is a single (challenge, goal) row from a claim batch file
+type claimPair struct {
+	line        int
+	challengeID string
+	goalID      string
+	err         error // Non-nil if the row is malformed
+}
+
+// id returns the identifier used in bulk results
+func (p claimPair) id() string {
+	if p.err != nil {
+		return fmt.Sprintf("line %d", p.line)
+	}
+	return p.challengeID + "/" + p.goalID
+}
+
+// NewClaimBatchCommand creates the claim-batch command
+func NewClaimBatchCommand() *cobra.Command {
+	var (
+		fromFile        string
+		continueOnError bool
+		parallel        int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "claim-batch",
+		Short: "Claim rewards for goals listed in a CSV file",
+		Long: `Claim rewards for (challenge-id, goal-id) pairs listed in a CSV file.
+Each row must contain exactly two columns: challenge-id,goal-id.
+An optional header row and lines starting with '#' are ignored.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fromFile == "" {
+				return cli.UsageErrorf("--from-file is required")
+			}
+
+			if parallel < 1 {
+				return cli.UsageErrorf("--parallel must be at least 1")
+			}
+
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Read and validate pairs before claiming anything
+			file, err := os.Open(fromFile)
+			if err != nil {
+				return fmt.Errorf("failed to open %s: %w", fromFile, err)
+			}
+			defer func() {
+				_ = file.Close()
+			}()
+
+			pairs, err := parseClaimPairs(file)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", fromFile, err)
+			}
+
+			if !continueOnError {
+				if err := firstMalformedPair(pairs); err != nil {
+					return fmt.Errorf("invalid claim file (use --continue-on-error to skip bad rows): %w", err)
+				}
+			}
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// Claim all pairs
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			result := runClaimBatch(ctx, container.APIClient, pairs, parallel, continueOnError)
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			formatted, err := formatter.FormatBulkResult(result)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			if err := cli.PrintResult(cmd, formatted); err != nil {
+				return err
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("%d of %d claims failed", result.Failed, result.Total)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "CSV file with challenge-id,goal-id rows (required)")
+	cmd.Flags().BoolVar(&continueOnError, "continue-on-error", false, "Keep claiming after a failure or malformed row")
+	cmd.Flags().IntVar(&parallel, "parallel", 1, "Number of claims to run concurrently")
+	_ = cmd.MarkFlagRequired("from-file")
+
+	return cmd
+}
+
+// parseClaimPairs reads challenge-id,goal-id rows from CSV
+// Malformed rows are returned with err set so callers can decide whether to skip them.
+func parseClaimPairs(r io.Reader) ([]claimPair, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // Validate column count ourselves
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	pairs := []claimPair{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			pairs = append(pairs, claimPair{line: parseErr.Line, err: err})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		// Skip optional header row
+		if len(pairs) == 0 && len(record) > 0 && isClaimHeader(record[0]) {
+			continue
+		}
+
+		pair := claimPair{line: line}
+		if len(record) != 2 {
+			pair.err = fmt.Errorf("line %d: expected 2 columns (challenge-id,goal-id), got %d", line, len(record))
+		} else {
+			pair.challengeID = strings.TrimSpace(record[0])
+			pair.goalID = strings.TrimSpace(record[1])
+			if pair.challengeID == "" || pair.goalID == "" {
+				pair.err = fmt.Errorf("line %d: challenge-id and goal-id cannot be empty", line)
+			}
+		}
+
+		pairs = append(pairs, pair)
+	}
+
+	return pairs, nil
+}
+
+// isClaimHeader checks if a first column looks like a header label
+func isClaimHeader(column string) bool {
+	switch strings.ToLower(strings.TrimSpace(column)) {
+	case "challenge-id", "challenge_id", "challengeid", "challenge":
+		return true
+	}
+	return false
+}
+
+// firstMalformedPair returns the error of the first malformed row, if any
+func firstMalformedPair(pairs []claimPair) error {
+	for _, p := range pairs {
+		if p.err != nil {
+			return p.err
+		}
+	}
+	return nil
+}
+
+// runClaimBatch claims each pair using up to parallel workers
+// Without continueOnError, pairs not yet started after the first failure are skipped.
+func runClaimBatch(ctx context.Context, apiClient api.APIClient, pairs []claimPair, parallel int, continueOnError bool) *output.BulkResult {
+	items := make([]output.BulkItemResult, len(pairs))
+	var stopped atomic.Bool
+
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+
+	for i, pair := range pairs {
+		if pair.err != nil {
+			items[i] = output.BulkItemResult{ID: pair.id(), Status: "error", Error: pair.err}
+			if !continueOnError {
+				stopped.Store(true)
+			}
+			continue
+		}
+
+		sem <- struct{}{}
+		if stopped.Load() {
+			<-sem
+			items[i] = output.BulkItemResult{ID: pair.id(), Status: "skipped"}
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, pair claimPair) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			start := time.Now()
+			_, err := apiClient.ClaimReward(ctx, pair.challengeID, pair.goalID)
+
+			item := output.BulkItemResult{
+				ID:         pair.id(),
+				Status:     "success",
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				item.Status = "error"
+				item.Error = err
+				if !continueOnError {
+					stopped.Store(true)
+				}
+			}
+			items[i] = item
+		}(i, pair)
+	}
+
+	wg.Wait()
+
+	result := &output.BulkResult{Operation: "claim-batch", Items: []output.BulkItemResult{}}
+	for _, item := range items {
+		result.Add(item)
+	}
+
+	return result
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+func newClaimBatchMockAPI() *api.MockAPIClient {
+	return api.NewMockAPIClient([]api.Challenge{
+		{
+			ID: "daily",
+			Goals: []api.Goal{
+				{ID: "login", Status: "completed"},
+				{ID: "kills", Status: "in_progress"},
+				{ID: "wins", Status: "completed"},
+			},
+		},
+	})
+}
+
+func TestParseClaimPairs(t *testing.T) {
+	csvData := "challenge_id,goal_id\n" +
+		"daily,login\n" +
+		"# comment line\n" +
+		"daily\n" +
+		"daily, wins\n" +
+		",kills\n"
+
+	pairs, err := parseClaimPairs(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(pairs) != 4 {
+		t.Fatalf("Expected 4 pairs, got %d", len(pairs))
+	}
+
+	if pairs[0].err != nil || pairs[0].challengeID != "daily" || pairs[0].goalID != "login" {
+		t.Errorf("Expected valid pair daily/login, got %+v", pairs[0])
+	}
+
+	if pairs[1].err == nil {
+		t.Error("Expected single-column row to be malformed")
+	}
+
+	if pairs[1].line != 4 {
+		t.Errorf("Expected malformed row on line 4, got %d", pairs[1].line)
+	}
+
+	if pairs[2].err != nil || pairs[2].goalID != "wins" {
+		t.Errorf("Expected trimmed pair daily/wins, got %+v", pairs[2])
+	}
+
+	if pairs[3].err == nil {
+		t.Error("Expected empty challenge-id to be malformed")
+	}
+}
+
+func TestRunClaimBatch_ContinueOnError(t *testing.T) {
+	mockAPI := newClaimBatchMockAPI()
+
+	pairs, err := parseClaimPairs(strings.NewReader("daily,login\nmalformed\ndaily,kills\ndaily,wins\n"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result := runClaimBatch(context.Background(), mockAPI, pairs, 2, true)
+
+	expected := []struct {
+		id     string
+		status string
+	}{
+		{"daily/login", "success"},
+		{"line 2", "error"},
+		{"daily/kills", "error"},
+		{"daily/wins", "success"},
+	}
+
+	if len(result.Items) != len(expected) {
+		t.Fatalf("Expected %d items, got %d", len(expected), len(result.Items))
+	}
+
+	for i, want := range expected {
+		item := result.Items[i]
+		if item.ID != want.id || item.Status != want.status {
+			t.Errorf("Item %d: expected %s=%s, got %s=%s", i, want.id, want.status, item.ID, item.Status)
+		}
+	}
+
+	if result.Succeeded != 2 || result.Failed != 2 || result.Total != 4 {
+		t.Errorf("Expected 2 succeeded / 2 failed / 4 total, got %d/%d/%d", result.Succeeded, result.Failed, result.Total)
+	}
+
+	if len(mockAPI.ClaimCalls) != 3 {
+		t.Errorf("Expected 3 claim calls (malformed row not sent), got %d", len(mockAPI.ClaimCalls))
+	}
+}
+
+func TestRunClaimBatch_StopOnError(t *testing.T) {
+	mockAPI := newClaimBatchMockAPI()
+
+	pairs, err := parseClaimPairs(strings.NewReader("daily,kills\ndaily,login\ndaily,wins\n"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result := runClaimBatch(context.Background(), mockAPI, pairs, 1, false)
+
+	if result.Items[0].Status != "error" {
+		t.Errorf("Expected first claim to fail, got %s", result.Items[0].Status)
+	}
+
+	for _, item := range result.Items[1:] {
+		if item.Status != "skipped" {
+			t.Errorf("Expected %s to be skipped after failure, got %s", item.ID, item.Status)
+		}
+	}
+
+	if len(mockAPI.ClaimCalls) != 1 {
+		t.Errorf("Expected 1 claim call, got %d", len(mockAPI.ClaimCalls))
+	}
+}
+
+func TestFirstMalformedPair(t *testing.T) {
+	pairs, _ := parseClaimPairs(strings.NewReader("daily,login\ndaily,login,extra\n"))
+
+	if err := firstMalformedPair(pairs); err == nil {
+		t.Error("Expected error for row with extra column")
+	}
+}
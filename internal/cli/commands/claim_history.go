@@ -0,0 +1,108 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// NewClaimHistoryCommand creates the claim-history command
+func NewClaimHistoryCommand() *cobra.Command {
+	var challengeID string
+
+	cmd := &cobra.Command{
+		Use:   "claim-history",
+		Short: "List claimed goals, most recent first",
+		Long: `List every claimed goal with its reward and claim time, most recent claim
+first. Goals without a claim timestamp are listed last.
+
+Use --challenge to only list the claims of one challenge.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// Call API
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			records, err := container.APIClient.GetClaimHistory(ctx, challengeID)
+			if err != nil {
+				return fmt.Errorf("failed to get claim history: %w", err)
+			}
+
+			// Challenge and goal names are best effort; entries fall back to IDs
+			challenges, _ := container.APIClient.ListChallenges(ctx)
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			result, err := formatter.FormatClaimHistory(claimHistory(records, challenges))
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			return cli.PrintResult(cmd, result)
+		},
+	}
+
+	cmd.Flags().StringVar(&challengeID, "challenge", "", "Only list claims of this challenge")
+	_ = cmd.RegisterFlagCompletionFunc("challenge", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeChallengeID(cmd, nil, toComplete)
+	})
+
+	return cmd
+}
+
+// claimHistory converts claim records to history entries sorted by claim time, most recent first
+// Names are looked up in challenges (IDs are used when missing). Records with a missing or
+// unparseable ClaimedAt sort last; ties keep the backend order.
+func claimHistory(records []api.ClaimRecord, challenges []api.Challenge) []output.ClaimHistoryEntry {
+	challengeNames := map[string]string{}
+	goalNames := map[string]string{}
+	for _, c := range challenges {
+		challengeNames[c.ID] = c.Name
+		for _, g := range c.Goals {
+			goalNames[c.ID+"/"+g.ID] = g.Name
+		}
+	}
+
+	entries := []output.ClaimHistoryEntry{}
+	for _, r := range records {
+		claimedAt, _ := output.ParseTimestamp(r.ClaimedAt)
+		entries = append(entries, output.ClaimHistoryEntry{
+			ChallengeID:   r.ChallengeID,
+			ChallengeName: nameOrID(challengeNames[r.ChallengeID], r.ChallengeID),
+			GoalID:        r.GoalID,
+			GoalName:      nameOrID(goalNames[r.ChallengeID+"/"+r.GoalID], r.GoalID),
+			Reward:        r.Reward,
+			ClaimedAt:     claimedAt,
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].ClaimedAt.After(entries[j].ClaimedAt)
+	})
+
+	return entries
+}
+
+// nameOrID returns name, or id if name is empty
+func nameOrID(name, id string) string {
+	if name == "" {
+		return id
+	}
+	return name
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+)
+
+func claimHistoryFixture() []api.Challenge {
+	return []api.Challenge{
+		{
+			ID:   "daily",
+			Name: "Daily Quests",
+			Goals: []api.Goal{
+				{ID: "login", Name: "Log In", Status: "claimed", ClaimedAt: "2025-03-01T08:00:00Z",
+					Reward: api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 50}},
+				{ID: "kill-10", Name: "Kill 10", Status: "completed",
+					Reward: api.Reward{Type: "ITEM", RewardID: "sword", Quantity: 1}},
+				{ID: "legacy", Name: "Legacy Goal", Status: "claimed", ClaimedAt: "",
+					Reward: api.Reward{Type: "ITEM", RewardID: "badge", Quantity: 1}},
+			},
+		},
+		{
+			ID:   "winter",
+			Name: "Winter Event",
+			Goals: []api.Goal{
+				{ID: "snow", Name: "Build Snowman", Status: "claimed", ClaimedAt: "2025-03-02T09:30:00Z",
+					Reward: api.Reward{Type: "ITEM", RewardID: "winter_hat", Quantity: 1}},
+				{ID: "ice", Name: "Skate", Status: "claimed", ClaimedAt: "2025-02-28T23:59:00Z",
+					Reward: api.Reward{Type: "WALLET", RewardID: "GEMS", Quantity: 5}},
+				{ID: "sled", Name: "Sled", Status: "in_progress"},
+			},
+		},
+	}
+}
+
+// fixtureClaimHistory builds the claim history of claimHistoryFixture through the mock API
+func fixtureClaimHistory(t *testing.T, challengeID string) []output.ClaimHistoryEntry {
+	t.Helper()
+	challenges := claimHistoryFixture()
+	records, err := api.NewMockAPIClient(challenges).GetClaimHistory(context.Background(), challengeID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return claimHistory(records, challenges)
+}
+
+func TestClaimHistory_SortedMostRecentFirst(t *testing.T) {
+	entries := fixtureClaimHistory(t, "")
+
+	// Only claimed goals; missing timestamps last
+	want := []string{"winter/snow", "daily/login", "winter/ice", "daily/legacy"}
+	if len(entries) != len(want) {
+		t.Fatalf("Expected %d entries, got %d: %+v", len(want), len(entries), entries)
+	}
+	for i, e := range entries {
+		if got := e.ChallengeID + "/" + e.GoalID; got != want[i] {
+			t.Errorf("Entry %d: expected %s, got %s", i, want[i], got)
+		}
+	}
+
+	first := entries[0]
+	if first.ChallengeName != "Winter Event" || first.GoalName != "Build Snowman" {
+		t.Errorf("Expected names to be included, got %+v", first)
+	}
+	if first.Reward.RewardID != "winter_hat" || first.Reward.Type != "ITEM" {
+		t.Errorf("Expected reward winter_hat, got %+v", first.Reward)
+	}
+	if got := first.ClaimedAt.UTC().Format("2006-01-02T15:04:05Z"); got != "2025-03-02T09:30:00Z" {
+		t.Errorf("Expected parsed claim time, got %s", got)
+	}
+	if !entries[3].ClaimedAt.IsZero() {
+		t.Errorf("Expected zero time for missing ClaimedAt, got %v", entries[3].ClaimedAt)
+	}
+}
+
+func TestClaimHistory_NoClaims(t *testing.T) {
+	entries := claimHistory([]api.ClaimRecord{}, []api.Challenge{{ID: "c1", Goals: []api.Goal{{ID: "g1", Status: "completed"}}}})
+	if entries == nil || len(entries) != 0 {
+		t.Errorf("Expected empty (non-nil) history, got %+v", entries)
+	}
+}
+
+func TestClaimHistory_ScopedToChallenge(t *testing.T) {
+	entries := fixtureClaimHistory(t, "daily")
+
+	want := []string{"daily/login", "daily/legacy"}
+	if len(entries) != len(want) {
+		t.Fatalf("Expected %d entries, got %d: %+v", len(want), len(entries), entries)
+	}
+	for i, e := range entries {
+		if got := e.ChallengeID + "/" + e.GoalID; got != want[i] {
+			t.Errorf("Entry %d: expected %s, got %s", i, want[i], got)
+		}
+	}
+}
+
+func TestClaimHistory_NamesFallBackToIDs(t *testing.T) {
+	records := []api.ClaimRecord{{ChallengeID: "gone", GoalID: "old-goal", ClaimedAt: "2025-03-01T08:00:00Z"}}
+
+	entries := claimHistory(records, nil)
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+	if entries[0].ChallengeName != "gone" || entries[0].GoalName != "old-goal" {
+		t.Errorf("Expected IDs as names, got %+v", entries[0])
+	}
+}
+
+func TestClaimHistory_TextIncludesReward(t *testing.T) {
+	formatter := &output.TextFormatter{}
+	result, err := formatter.FormatClaimHistory(fixtureClaimHistory(t, ""))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"1. 2025-03-02 09:30 Build Snowman - Winter Event (winter)",
+		"Reward: WALLET GOLD x50",
+		"4. unknown time Legacy Goal",
+	} {
+		if !strings.Contains(result, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, result)
+		}
+	}
+}
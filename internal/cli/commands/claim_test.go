@@ -0,0 +1,351 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
+	"github.com/spf13/cobra"
+)
+
+// grantingAPIClient reflects each successful claim in the verifier, like AGS Platform granting the reward
+type grantingAPIClient struct {
+	*api.MockAPIClient
+	verifier *ags.MockRewardVerifier
+	grant    func(v *ags.MockRewardVerifier, reward api.Reward)
+}
+
+func (c *grantingAPIClient) ClaimReward(ctx context.Context, challengeID, goalID string) (*api.ClaimResult, error) {
+	result, err := c.MockAPIClient.ClaimReward(ctx, challengeID, goalID)
+	if err == nil && c.grant != nil {
+		c.grant(c.verifier, result.Reward)
+	}
+	return result, err
+}
+
+func TestExplainGoalState(t *testing.T) {
+	challenge := &api.Challenge{
+		ID: "daily-quests",
+		Goals: []api.Goal{
+			{
+				ID:          "kill-10",
+				Status:      "in_progress",
+				Progress:    4,
+				Requirement: api.Requirement{StatCode: "kills", Operator: "gte", TargetValue: 10},
+			},
+			{
+				ID:        "login-once",
+				Status:    "claimed",
+				ClaimedAt: "2025-01-01T00:00:00Z",
+			},
+			{
+				ID:            "win-3",
+				Status:        "not_started",
+				Locked:        true,
+				Prerequisites: []string{"login-once", "kill-10"},
+			},
+			{
+				ID:     "play-1",
+				Status: "completed",
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		goalID   string
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "not completed shows progress and target",
+			goalID:   "kill-10",
+			contains: []string{"not completed", "in_progress", "4/10"},
+		},
+		{
+			name:     "already claimed shows claimed-at",
+			goalID:   "login-once",
+			contains: []string{"already claimed", "2025-01-01T00:00:00Z"},
+		},
+		{
+			name:     "locked shows only unmet prerequisites",
+			goalID:   "win-3",
+			contains: []string{"locked", "kill-10"},
+			excludes: []string{"login-once"},
+		},
+		{
+			name:     "completed goal points to server-side failure",
+			goalID:   "play-1",
+			contains: []string{"claimable"},
+		},
+		{
+			name:     "unknown goal",
+			goalID:   "missing",
+			contains: []string{"does not exist", "daily-quests"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			explanation := explainGoalState(challenge, tt.goalID)
+
+			for _, want := range tt.contains {
+				if !strings.Contains(explanation, want) {
+					t.Errorf("Expected explanation to contain '%s', got '%s'", want, explanation)
+				}
+			}
+
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(explanation, unwanted) {
+					t.Errorf("Expected explanation not to contain '%s', got '%s'", unwanted, explanation)
+				}
+			}
+		})
+	}
+}
+
+func newGrantTestAPI() *api.MockAPIClient {
+	return api.NewMockAPIClient([]api.Challenge{
+		{
+			ID: "daily-quests",
+			Goals: []api.Goal{
+				{
+					ID:          "kill-10",
+					Status:      "completed",
+					Requirement: api.Requirement{StatCode: "kills", Operator: "gte", TargetValue: 10},
+					Reward:      api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 100},
+				},
+				{ID: "win-3", Status: "completed", Reward: api.Reward{Type: "ITEM", RewardID: "winter_sword", Quantity: 1}},
+			},
+		},
+	})
+}
+
+func TestClaimVerify_ReportsGrantDelta(t *testing.T) {
+	tests := []struct {
+		name      string
+		goalID    string
+		wantDelta int64
+	}{
+		{name: "wallet balance increases", goalID: "kill-10", wantDelta: 100},
+		{name: "new entitlement appears", goalID: "win-3", wantDelta: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := &ags.MockRewardVerifier{
+				Wallets: []*ags.Wallet{{CurrencyCode: "GOLD", Balance: 250, Status: "ACTIVE"}},
+			}
+			client := &grantingAPIClient{
+				MockAPIClient: newGrantTestAPI(),
+				verifier:      verifier,
+				grant: func(v *ags.MockRewardVerifier, reward api.Reward) {
+					if reward.Type == "WALLET" {
+						v.Wallets[0].Balance += int64(reward.Quantity)
+						return
+					}
+					v.Entitlements = append(v.Entitlements, &ags.Entitlement{ItemID: reward.RewardID, Quantity: reward.Quantity})
+				},
+			}
+
+			ctx := context.Background()
+			grant, err := snapshotGrant(ctx, client, verifier, "daily-quests", tt.goalID)
+			if err != nil {
+				t.Fatalf("Unexpected snapshot error: %v", err)
+			}
+			if _, err := client.ClaimReward(ctx, "daily-quests", tt.goalID); err != nil {
+				t.Fatalf("Unexpected claim error: %v", err)
+			}
+
+			if err := awaitGrant(ctx, verifier, grant, time.Millisecond, time.Second); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if grant.Delta != tt.wantDelta || grant.Delta != grant.Expected {
+				t.Errorf("Expected delta %d matching reward quantity %d, got %d", tt.wantDelta, grant.Expected, grant.Delta)
+			}
+			if !grant.Verified {
+				t.Error("Expected grant to be verified")
+			}
+		})
+	}
+}
+
+func TestClaimVerify_GrantNotObserved(t *testing.T) {
+	verifier := &ags.MockRewardVerifier{
+		Wallets: []*ags.Wallet{{CurrencyCode: "GOLD", Balance: 250, Status: "ACTIVE"}},
+	}
+	client := newGrantTestAPI()
+
+	ctx := context.Background()
+	grant, err := snapshotGrant(ctx, client, verifier, "daily-quests", "kill-10")
+	if err != nil {
+		t.Fatalf("Unexpected snapshot error: %v", err)
+	}
+	if _, err := client.ClaimReward(ctx, "daily-quests", "kill-10"); err != nil {
+		t.Fatalf("Unexpected claim error: %v", err)
+	}
+
+	err = awaitGrant(ctx, verifier, grant, time.Millisecond, 20*time.Millisecond)
+	if !errors.Is(err, retry.ErrPollTimeout) {
+		t.Fatalf("Expected ErrPollTimeout, got %v", err)
+	}
+	if grant.Verified || grant.Delta != 0 || grant.Before != 250 {
+		t.Errorf("Expected unverified zero delta from 250, got %+v", grant)
+	}
+}
+
+func TestSnapshotGrant_UnknownGoal(t *testing.T) {
+	_, err := snapshotGrant(context.Background(), newGrantTestAPI(), &ags.MockRewardVerifier{}, "daily-quests", "missing")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected goal not found error, got %v", err)
+	}
+}
+
+func TestClaimCommand_NoLocalVerboseFlag(t *testing.T) {
+	// A local --verbose would shadow the global API dump flag
+	if NewClaimCommand().Flags().Lookup("verbose") != nil {
+		t.Error("Expected claim-reward not to define its own --verbose")
+	}
+}
+
+func TestClaimShowRequirement_IncludesRequirement(t *testing.T) {
+	requirement, err := goalRequirement(context.Background(), newGrantTestAPI(), "daily-quests", "kill-10")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	result := &output.ClaimResult{
+		ChallengeID: "daily-quests",
+		GoalID:      "kill-10",
+		Status:      "success",
+		Reward:      &api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 100},
+		Requirement: requirement,
+	}
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{format: "text", want: []string{"Requirement: kills >= 10", "GOLD"}},
+		{format: "table", want: []string{"Requires:  kills >= 10", "GOLD"}},
+		{format: "json", want: []string{`"stat_code": "kills"`, `"operator": "gte"`, `"target_value": 10`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formatter, err := output.NewFormatter(tt.format)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			formatted, err := formatter.FormatClaimResult(result)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(formatted, want) {
+					t.Errorf("Expected output to contain %q, got:\n%s", want, formatted)
+				}
+			}
+		})
+	}
+}
+
+func TestClaimShowRequirement_LookupFails(t *testing.T) {
+	// The claim succeeds but the challenge can't be fetched afterwards
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(`{"challengeId":"daily-quests","goalId":"kill-10","status":"CLAIMED"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	root := &cobra.Command{Use: "challenge-demo", SilenceUsage: true, SilenceErrors: true}
+	root.PersistentFlags().String("auth-mode", "mock", "")
+	root.PersistentFlags().String("backend-url", server.URL, "")
+	root.PersistentFlags().String("format", "json", "")
+	root.AddCommand(NewClaimCommand())
+	root.SetArgs([]string{"claim-reward", "daily-quests", "kill-10", "--show-requirement"})
+	var stdout, stderr bytes.Buffer
+	root.SetOut(&stdout)
+	root.SetErr(&stderr)
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("Expected the claim to succeed, got %v", err)
+	}
+	if !strings.Contains(stdout.String(), `"success"`) {
+		t.Errorf("Expected the claim result printed, got %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "Warning: reward claimed but failed to fetch goal requirement") {
+		t.Errorf("Expected a requirement warning, got %q", stderr.String())
+	}
+}
+
+func TestGoalRequirement_UnknownGoal(t *testing.T) {
+	_, err := goalRequirement(context.Background(), newGrantTestAPI(), "daily-quests", "missing")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected goal not found error, got %v", err)
+	}
+}
+
+func TestClaimError(t *testing.T) {
+	mock := api.NewMockAPIClient([]api.Challenge{
+		{
+			ID: "daily-quests",
+			Goals: []api.Goal{
+				{ID: "kill-10", Status: "claimed"},
+				{ID: "win-3", Status: "in_progress"},
+			},
+		},
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		goalID   string
+		wantMsg  string
+		wantExit int
+	}{
+		{name: "already claimed", goalID: "kill-10", wantMsg: "claim failed: goal 'kill-10' already claimed", wantExit: cli.ExitConflict},
+		{name: "not completed", goalID: "win-3", wantMsg: "claim failed: goal 'win-3' not completed", wantExit: cli.ExitConflict},
+		{name: "not found", goalID: "missing", wantMsg: "claim failed: goal 'missing' not found in challenge 'daily-quests'", wantExit: cli.ExitNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, claimErr := mock.ClaimReward(ctx, "daily-quests", tt.goalID)
+			if claimErr == nil {
+				t.Fatal("Expected claim error, got nil")
+			}
+
+			err := claimError("daily-quests", tt.goalID, claimErr)
+			if err.Error() != tt.wantMsg {
+				t.Errorf("Expected %q, got %q", tt.wantMsg, err.Error())
+			}
+			if code := cli.ExitCode(err); code != tt.wantExit {
+				t.Errorf("Expected exit code %d, got %d", tt.wantExit, code)
+			}
+		})
+	}
+
+	err := claimError("daily-quests", "kill-10", errors.New("connection refused"))
+	if err.Error() != "claim failed: connection refused" || cli.ExitCode(err) != cli.ExitError {
+		t.Errorf("Expected plain claim failure, got %q", err)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// completionTimeout bounds the backend lookup so a slow or unreachable backend never hangs the shell
+const completionTimeout = 2 * time.Second
+
+// NewCompletionCommand creates the completion command
+func NewCompletionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completion <bash|zsh|fish|powershell>",
+		Short: "Generate shell completion scripts",
+		Long: `Generate a completion script for the given shell.
+
+Challenge and goal IDs are completed from the backend (using the same
+connection flags as other commands) and silently omitted if it is unreachable.
+
+  bash:        source <(challenge-demo completion bash)
+  zsh:         challenge-demo completion zsh > "${fpath[1]}/_challenge-demo"
+  fish:        challenge-demo completion fish | source
+  powershell:  challenge-demo completion powershell | Out-String | Invoke-Expression`,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root := cmd.Root()
+			out := os.Stdout
+
+			switch args[0] {
+			case "bash":
+				return root.GenBashCompletionV2(out, true)
+			case "zsh":
+				return root.GenZshCompletion(out)
+			case "fish":
+				return root.GenFishCompletion(out, true)
+			case "powershell":
+				return root.GenPowerShellCompletionWithDesc(out)
+			}
+
+			return cli.UsageErrorf("unsupported shell %q (expected bash, zsh, fish, or powershell)", args[0])
+		},
+	}
+
+	return cmd
+}
+
+// completeChallengeID completes a single <challenge-id> argument
+func completeChallengeID(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return withCompletionClient(cmd, func(ctx context.Context, apiClient api.APIClient) []string {
+		return challengeIDCompletions(ctx, apiClient, toComplete)
+	})
+}
+
+// completeChallengeAndGoalID completes <challenge-id> <goal-id> arguments
+func completeChallengeAndGoalID(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return completeChallengeID(cmd, args, toComplete)
+	case 1:
+		return withCompletionClient(cmd, func(ctx context.Context, apiClient api.APIClient) []string {
+			return goalIDCompletions(ctx, apiClient, args[0], toComplete)
+		})
+	}
+
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
+// withCompletionClient runs a lookup against the backend with logging silenced and a short timeout
+func withCompletionClient(cmd *cobra.Command, lookup func(ctx context.Context, apiClient api.APIClient) []string) ([]string, cobra.ShellCompDirective) {
+	// Container setup logs warnings that would corrupt the shell's completion output
+	log.SetOutput(io.Discard)
+
+	// Completion never triggers events, so skip the blocking event handler dial
+	_ = cmd.Flags().Set("event-handler-url", "")
+
+	container := cli.GetContainerFromFlags(cmd)
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	return lookup(ctx, container.APIClient), cobra.ShellCompDirectiveNoFileComp
+}
+
+// challengeIDCompletions returns "id\tname" candidates matching the prefix
+// Errors are swallowed so completion degrades to no suggestions.
+func challengeIDCompletions(ctx context.Context, apiClient api.APIClient, prefix string) []string {
+	challenges, err := apiClient.ListChallenges(ctx)
+	if err != nil {
+		return nil
+	}
+
+	completions := []string{}
+	for _, c := range challenges {
+		if strings.HasPrefix(c.ID, prefix) {
+			completions = append(completions, c.ID+"\t"+c.Name)
+		}
+	}
+
+	return completions
+}
+
+// goalIDCompletions returns "id\tname" candidates for goals in the challenge matching the prefix
+// Errors are swallowed so completion degrades to no suggestions.
+func goalIDCompletions(ctx context.Context, apiClient api.APIClient, challengeID, prefix string) []string {
+	challenge, err := apiClient.GetChallenge(ctx, challengeID)
+	if err != nil {
+		return nil
+	}
+
+	completions := []string{}
+	for _, g := range challenge.Goals {
+		if strings.HasPrefix(g.ID, prefix) {
+			completions = append(completions, g.ID+"\t"+g.Name)
+		}
+	}
+
+	return completions
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+func newCompletionMockAPI() *api.MockAPIClient {
+	return api.NewMockAPIClient([]api.Challenge{
+		{ID: "daily-quests", Name: "Daily Quests", Goals: []api.Goal{
+			{ID: "kill-10", Name: "Kill 10"},
+			{ID: "login-once", Name: "Login Once"},
+		}},
+		{ID: "weekly-quests", Name: "Weekly Quests"},
+	})
+}
+
+func TestChallengeIDCompletions(t *testing.T) {
+	apiClient := newCompletionMockAPI()
+
+	tests := []struct {
+		name     string
+		prefix   string
+		expected []string
+	}{
+		{name: "all", prefix: "", expected: []string{"daily-quests\tDaily Quests", "weekly-quests\tWeekly Quests"}},
+		{name: "prefix", prefix: "we", expected: []string{"weekly-quests\tWeekly Quests"}},
+		{name: "no match", prefix: "monthly", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := challengeIDCompletions(context.Background(), apiClient, tt.prefix)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGoalIDCompletions(t *testing.T) {
+	apiClient := newCompletionMockAPI()
+
+	got := goalIDCompletions(context.Background(), apiClient, "daily-quests", "lo")
+	expected := []string{"login-once\tLogin Once"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	if got := goalIDCompletions(context.Background(), apiClient, "missing", ""); got != nil {
+		t.Errorf("Expected no completions for unknown challenge, got %v", got)
+	}
+}
+
+func TestCompletions_BackendUnreachable(t *testing.T) {
+	apiClient := newCompletionMockAPI()
+	apiClient.Error = errors.New("connection refused")
+
+	if got := challengeIDCompletions(context.Background(), apiClient, ""); got != nil {
+		t.Errorf("Expected no challenge completions, got %v", got)
+	}
+	if got := goalIDCompletions(context.Background(), apiClient, "daily-quests", ""); got != nil {
+		t.Errorf("Expected no goal completions, got %v", got)
+	}
+}
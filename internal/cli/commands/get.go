@@ -5,21 +5,23 @@
 package commands
 
 import (
-	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
 	"github.com/spf13/cobra"
 )
 
 // NewGetCommand creates the get-challenge command
 func NewGetCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get-challenge <challenge-id>",
-		Short: "Get specific challenge details",
-		Long:  "Get details for a specific challenge including all goals.",
-		Args:  cobra.ExactArgs(1),
+		Use:               "get-challenge <challenge-id>",
+		Short:             "Get specific challenge details",
+		Long:              "Get details for a specific challenge including all goals.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeChallengeID,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			challengeID := args[0]
 
@@ -30,21 +32,27 @@ func NewGetCommand() *cobra.Command {
 			container := cli.GetContainerFromFlags(cmd)
 
 			// Call API
-			ctx := context.Background()
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 			challenge, err := container.APIClient.GetChallenge(ctx, challengeID)
 			if err != nil {
+				if code, ok := retry.StatusCode(err); ok && code == http.StatusNotFound {
+					return &cli.ExplainedError{Msg: fmt.Sprintf("challenge '%s' not found", challengeID), Err: err}
+				}
 				return fmt.Errorf("failed to get challenge: %w", err)
 			}
 
 			// Format output
-			formatter := output.NewFormatter(format)
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
 			result, err := formatter.FormatChallenge(challenge)
 			if err != nil {
 				return fmt.Errorf("failed to format output: %w", err)
 			}
 
-			fmt.Println(result)
-			return nil
+			return cli.PrintResult(cmd, result)
 		},
 	}
 
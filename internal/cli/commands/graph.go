@@ -0,0 +1,117 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
+	"github.com/spf13/cobra"
+)
+
+// NewGraphCommand creates the graph command
+func NewGraphCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph <challenge-id>",
+		Short: "Show a challenge's goal prerequisite graph",
+		Long: `Render the goal dependency graph of a challenge from each goal's prerequisites,
+to check that prerequisite chains are sane before testing.
+
+The text format draws an indented tree with each goal under the goals it
+requires; --format dot prints a Graphviz digraph (edges run from prerequisite
+to dependent). Prerequisite cycles are reported on stderr and marked in the
+output, and prerequisite IDs with no goal in the challenge are flagged as
+missing.`,
+		Example: `  challenge-demo graph daily-quests --format text
+  challenge-demo graph daily-quests --format dot | dot -Tpng -o daily-quests.png`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeChallengeID,
+		Annotations:       map[string]string{cli.ExtraFormatsAnnotation: output.DotFormat},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			challengeID := args[0]
+
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// Call API
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			challenge, err := container.APIClient.GetChallenge(ctx, challengeID)
+			if err != nil {
+				if code, ok := retry.StatusCode(err); ok && code == http.StatusNotFound {
+					return &cli.ExplainedError{Msg: fmt.Sprintf("challenge '%s' not found", challengeID), Err: err}
+				}
+				return fmt.Errorf("failed to get challenge: %w", err)
+			}
+
+			graph := goalGraph(challenge)
+			for _, cycle := range graph.Cycles {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: prerequisite cycle: %s\n", strings.Join(cycle, " -> "))
+			}
+
+			// DOT is rendered here; it has no meaning for other commands' results
+			if format == output.DotFormat {
+				return cli.PrintResult(cmd, output.GoalGraphDOT(graph))
+			}
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			result, err := formatter.FormatGoalGraph(graph)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			return cli.PrintResult(cmd, result)
+		},
+	}
+
+	return cmd
+}
+
+// goalGraph builds the prerequisite graph of the challenge's goals, in goal order
+func goalGraph(challenge *api.Challenge) *output.GoalGraph {
+	graph := &output.GoalGraph{
+		ChallengeID: challenge.ID,
+		Name:        challenge.Name,
+		Goals:       make([]output.GoalGraphNode, 0, len(challenge.Goals)),
+		Cycles:      challenge.PrerequisiteCycles(),
+	}
+
+	index := make(map[string]int, len(challenge.Goals))
+	for _, g := range challenge.Goals {
+		index[g.ID] = len(graph.Goals)
+		graph.Goals = append(graph.Goals, output.GoalGraphNode{
+			ID:            g.ID,
+			Name:          g.Name,
+			Status:        g.Status,
+			Prerequisites: append([]string{}, g.Prerequisites...),
+			Unlocks:       []string{},
+		})
+	}
+
+	for i := range graph.Goals {
+		node := &graph.Goals[i]
+		for _, prereqID := range node.Prerequisites {
+			if j, ok := index[prereqID]; ok {
+				graph.Goals[j].Unlocks = append(graph.Goals[j].Unlocks, node.ID)
+			} else {
+				node.Missing = append(node.Missing, prereqID)
+			}
+		}
+	}
+
+	return graph
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+func TestGoalGraph(t *testing.T) {
+	challenge := &api.Challenge{
+		ID:   "season",
+		Name: "Season",
+		Goals: []api.Goal{
+			{ID: "login", Name: "Login", Status: "claimed"},
+			{ID: "win-3", Name: "Win 3", Status: "completed", Prerequisites: []string{"login"}},
+			{ID: "boss", Name: "Boss", Status: "not_started", Prerequisites: []string{"login", "win-3", "gone"}},
+			{ID: "x", Prerequisites: []string{"y"}},
+			{ID: "y", Prerequisites: []string{"x"}},
+		},
+	}
+
+	graph := goalGraph(challenge)
+
+	if graph.ChallengeID != "season" || len(graph.Goals) != 5 {
+		t.Fatalf("Expected 5 goals of season, got %+v", graph)
+	}
+	unlocks := map[string][]string{
+		"login": {"win-3", "boss"},
+		"win-3": {"boss"},
+		"boss":  {},
+		"x":     {"y"},
+		"y":     {"x"},
+	}
+	for _, node := range graph.Goals {
+		if !reflect.DeepEqual(node.Unlocks, unlocks[node.ID]) {
+			t.Errorf("Expected %s to unlock %v, got %v", node.ID, unlocks[node.ID], node.Unlocks)
+		}
+	}
+	if boss := graph.Goals[2]; !reflect.DeepEqual(boss.Missing, []string{"gone"}) {
+		t.Errorf("Expected boss missing [gone], got %v", boss.Missing)
+	}
+	if !reflect.DeepEqual(graph.Cycles, [][]string{{"x", "y", "x"}}) {
+		t.Errorf("Expected cycle [x y x], got %v", graph.Cycles)
+	}
+
+	// The graph must not share the challenge's prerequisite slices
+	graph.Goals[1].Prerequisites[0] = "edited"
+	if challenge.Goals[1].Prerequisites[0] != "login" {
+		t.Error("Expected the challenge's prerequisites unchanged")
+	}
+}
@@ -5,22 +5,28 @@
 package commands
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 	"github.com/spf13/cobra"
 )
 
 // NewInitializeCommand creates the initialize-player command
 func NewInitializeCommand() *cobra.Command {
+	var dryRun bool
+
 	cmd := &cobra.Command{
 		Use:   "initialize-player",
 		Short: "Initialize player goals with default assignments",
 		Long: `Initialize player goals by assigning default goals based on challenge configuration.
 This should be called on first login or when config is updated.
-Safe to call multiple times (idempotent).`,
+Safe to call multiple times (idempotent).
+
+Use --dry-run to preview the goals that would be assigned without committing them.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get format flag
 			format, _ := cmd.Flags().GetString("format")
@@ -29,77 +35,129 @@ Safe to call multiple times (idempotent).`,
 			container := cli.GetContainerFromFlags(cmd)
 
 			// Call API
-			ctx := context.Background()
-			result, err := container.APIClient.InitializePlayer(ctx)
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			var (
+				result *api.InitializeResponse
+				err    error
+			)
+			if dryRun {
+				result, err = container.APIClient.PreviewInitializePlayer(ctx)
+			} else {
+				result, err = container.APIClient.InitializePlayer(ctx)
+			}
 			if err != nil {
 				return fmt.Errorf("failed to initialize player: %w", err)
 			}
 
 			// Format output
-			switch format {
-			case "json":
-				output, err := json.MarshalIndent(result, "", "  ")
-				if err != nil {
-					return fmt.Errorf("failed to format JSON: %w", err)
-				}
-				fmt.Println(string(output))
-
-			case "table":
-				// Table output for assigned goals
-				fmt.Printf("Player Initialized Successfully\n")
-				fmt.Printf("New Assignments: %d\n", result.NewAssignments)
-				fmt.Printf("Total Active: %d\n\n", result.TotalActive)
-
-				if len(result.AssignedGoals) > 0 {
-					fmt.Println("Assigned Goals:")
-					fmt.Println("─────────────────────────────────────────────────────────────────")
-					fmt.Printf("%-20s %-20s %-12s %-10s\n", "Challenge ID", "Goal ID", "Status", "Progress")
-					fmt.Println("─────────────────────────────────────────────────────────────────")
-
-					for _, goal := range result.AssignedGoals {
-						active := "inactive"
-						if goal.IsActive {
-							active = "active"
-						}
-						fmt.Printf("%-20s %-20s %-12s %d/%d\n",
-							truncate(goal.ChallengeID, 20),
-							truncate(goal.GoalID, 20),
-							active,
-							goal.Progress,
-							goal.Target)
-					}
-					fmt.Println("─────────────────────────────────────────────────────────────────")
-				}
-
-			default: // text
-				fmt.Printf("✅ Player initialized successfully\n")
-				fmt.Printf("   New assignments: %d\n", result.NewAssignments)
-				fmt.Printf("   Total active goals: %d\n", result.TotalActive)
-
-				if len(result.AssignedGoals) > 0 {
-					fmt.Printf("\nAssigned goals:\n")
-					for _, goal := range result.AssignedGoals {
-						status := "inactive"
-						if goal.IsActive {
-							status = "active"
-						}
-						fmt.Printf("  - %s / %s (%s) - %d/%d\n",
-							goal.ChallengeID,
-							goal.GoalID,
-							status,
-							goal.Progress,
-							goal.Target)
-					}
-				}
+			formatted, err := formatInitializeResult(result, format, dryRun)
+			if err != nil {
+				return err
 			}
 
-			return nil
+			return cli.PrintResult(cmd, formatted)
 		},
 	}
 
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the goals that would be assigned without committing them")
+
 	return cmd
 }
 
+// formatInitializeResult renders an initialize (or dry-run preview) result
+// Text and table output say when nothing new was assigned, i.e. the player was already initialized.
+func formatInitializeResult(result *api.InitializeResponse, format string, dryRun bool) (string, error) {
+	var b strings.Builder
+	switch format {
+	case "json", "jsonl":
+		data, err := output.MarshalJSON(format, struct {
+			*api.InitializeResponse
+			DryRun bool `json:"dryRun,omitempty"`
+		}{result, dryRun})
+		if err != nil {
+			return "", fmt.Errorf("failed to format JSON: %w", err)
+		}
+		b.Write(data)
+
+	case "table":
+		// Table output for assigned goals
+		switch {
+		case dryRun:
+			fmt.Fprintf(&b, "Dry Run (nothing committed)\n")
+			fmt.Fprintf(&b, "Would Assign: %d\n", result.NewAssignments)
+		case result.NewAssignments == 0:
+			fmt.Fprintf(&b, "Player Already Initialized\n")
+			fmt.Fprintf(&b, "New Assignments: 0\n")
+		default:
+			fmt.Fprintf(&b, "Player Initialized Successfully\n")
+			fmt.Fprintf(&b, "New Assignments: %d\n", result.NewAssignments)
+		}
+		fmt.Fprintf(&b, "Total Active: %d\n\n", result.TotalActive)
+
+		if len(result.AssignedGoals) > 0 {
+			if dryRun {
+				fmt.Fprintln(&b, "Goals That Would Be Assigned:")
+			} else {
+				fmt.Fprintln(&b, "Assigned Goals:")
+			}
+			fmt.Fprintln(&b, glyph.Rule(65))
+			fmt.Fprintf(&b, "%-20s %-20s %-12s %-10s\n", "Challenge ID", "Goal ID", "Status", "Progress")
+			fmt.Fprintln(&b, glyph.Rule(65))
+
+			for _, goal := range result.AssignedGoals {
+				active := "inactive"
+				if goal.IsActive {
+					active = "active"
+				}
+				fmt.Fprintf(&b, "%-20s %-20s %-12s %d/%d\n",
+					truncate(goal.ChallengeID, 20),
+					truncate(goal.GoalID, 20),
+					active,
+					goal.Progress,
+					goal.Target)
+			}
+			fmt.Fprintln(&b, glyph.Rule(65))
+		}
+
+	default: // text
+		switch {
+		case dryRun:
+			fmt.Fprintf(&b, "Dry run: nothing committed\n")
+			fmt.Fprintf(&b, "   Would assign: %d\n", result.NewAssignments)
+		case result.NewAssignments == 0:
+			fmt.Fprintf(&b, "%s Player already initialized (no new assignments)\n", glyph.Current().Done)
+			fmt.Fprintf(&b, "   New assignments: 0\n")
+		default:
+			fmt.Fprintf(&b, "%s Player initialized successfully\n", glyph.Current().Done)
+			fmt.Fprintf(&b, "   New assignments: %d\n", result.NewAssignments)
+		}
+		fmt.Fprintf(&b, "   Total active goals: %d\n", result.TotalActive)
+
+		if len(result.AssignedGoals) > 0 {
+			if dryRun {
+				fmt.Fprintf(&b, "\nGoals that would be assigned:\n")
+			} else {
+				fmt.Fprintf(&b, "\nAssigned goals:\n")
+			}
+			for _, goal := range result.AssignedGoals {
+				status := "inactive"
+				if goal.IsActive {
+					status = "active"
+				}
+				fmt.Fprintf(&b, "  - %s / %s (%s) - %d/%d\n",
+					goal.ChallengeID,
+					goal.GoalID,
+					status,
+					goal.Progress,
+					goal.Target)
+			}
+		}
+	}
+
+	return b.String(), nil
+}
+
 // truncate truncates a string to maxLen with ellipsis
 func truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+func TestFormatInitializeResult(t *testing.T) {
+	assigned := &api.InitializeResponse{
+		NewAssignments: 1,
+		TotalActive:    1,
+		AssignedGoals:  []api.AssignedGoal{{ChallengeID: "daily", GoalID: "login", IsActive: true, Progress: 0, Target: 1}},
+	}
+	noop := &api.InitializeResponse{NewAssignments: 0, TotalActive: 1, AssignedGoals: assigned.AssignedGoals}
+
+	tests := []struct {
+		name    string
+		result  *api.InitializeResponse
+		format  string
+		dryRun  bool
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "text committed", result: assigned, format: "text",
+			want:    []string{"Player initialized successfully", "New assignments: 1", "Assigned goals:", "daily / login"},
+			notWant: []string{"Dry run"},
+		},
+		{
+			name: "text already initialized", result: noop, format: "text",
+			want: []string{"Player already initialized (no new assignments)"},
+		},
+		{
+			name: "text dry run", result: assigned, format: "text", dryRun: true,
+			want:    []string{"Dry run: nothing committed", "Would assign: 1", "Goals that would be assigned:", "daily / login"},
+			notWant: []string{"initialized successfully"},
+		},
+		{
+			name: "table dry run", result: assigned, format: "table", dryRun: true,
+			want: []string{"Dry Run (nothing committed)", "Would Assign: 1", "Goals That Would Be Assigned:"},
+		},
+		{
+			name: "table already initialized", result: noop, format: "table",
+			want: []string{"Player Already Initialized"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := formatInitializeResult(tt.result, tt.format, tt.dryRun)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("Expected output to contain %q, got:\n%s", want, got)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("Expected output not to contain %q, got:\n%s", notWant, got)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatInitializeResult_JSONDryRun(t *testing.T) {
+	result := &api.InitializeResponse{NewAssignments: 2, TotalActive: 2}
+
+	for _, dryRun := range []bool{false, true} {
+		got, err := formatInitializeResult(result, "json", dryRun)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var decoded map[string]interface{}
+		if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+			t.Fatalf("Failed to decode output: %v\n%s", err, got)
+		}
+		if decoded["newAssignments"] != float64(2) {
+			t.Errorf("Expected newAssignments 2, got %v", decoded["newAssignments"])
+		}
+		if _, ok := decoded["dryRun"]; ok != dryRun {
+			t.Errorf("Expected dryRun present=%v, got %v", dryRun, decoded)
+		}
+	}
+}
@@ -5,7 +5,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
@@ -16,13 +15,22 @@ import (
 
 // NewListCommand creates the list-challenges command
 func NewListCommand() *cobra.Command {
-	var activeOnly bool
+	var (
+		activeOnly    bool
+		onlyClaimable bool
+		sortBy        string
+		failOnEmpty   bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "list-challenges",
 		Short: "List all challenges with progress",
 		Long:  "List all challenges available to the user with their current progress.",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := api.ValidateSortMode(sortBy); err != nil {
+				return &cli.UsageError{Err: fmt.Errorf("invalid --sort: %w", err)}
+			}
+
 			// Get format flag
 			format, _ := cmd.Flags().GetString("format")
 
@@ -30,7 +38,8 @@ func NewListCommand() *cobra.Command {
 			container := cli.GetContainerFromFlags(cmd)
 
 			// Call API (M3: use filtered version if active_only is set)
-			ctx := context.Background()
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 			var challenges []api.Challenge
 			var err error
 
@@ -44,20 +53,39 @@ func NewListCommand() *cobra.Command {
 				return fmt.Errorf("failed to list challenges: %w", err)
 			}
 
+			// Keep challenges with a completed goal whose reward is still unclaimed
+			if onlyClaimable {
+				challenges = api.FilterClaimable(challenges)
+			}
+
+			// Same ordering as the TUI dashboard
+			if err := api.SortChallenges(challenges, sortBy); err != nil {
+				return err
+			}
+
 			// Format output
-			formatter := output.NewFormatter(format)
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
 			result, err := formatter.FormatChallenges(challenges)
 			if err != nil {
 				return fmt.Errorf("failed to format output: %w", err)
 			}
 
-			fmt.Println(result)
-			return nil
+			if err := cli.PrintResult(cmd, result); err != nil {
+				return err
+			}
+
+			return emptyListError(failOnEmpty, len(challenges), "challenges")
 		},
 	}
 
 	// M3: Add --active-only flag
 	cmd.Flags().BoolVar(&activeOnly, "active-only", false, "Show only active goals (M3 feature)")
+	cmd.Flags().BoolVar(&onlyClaimable, "only-claimable", false, "Show only challenges with a completed goal whose reward is not yet claimed")
+	cmd.Flags().StringVar(&sortBy, "sort", "", "Sort challenges by name, progress, or status (ties fall back to name)")
+	cmd.Flags().BoolVar(&failOnEmpty, "fail-on-empty", false, failOnEmptyUsage)
 
 	return cmd
 }
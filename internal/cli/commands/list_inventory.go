@@ -6,7 +6,9 @@ package commands
 
 import (
 	"fmt"
+	"os"
 
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
 	"github.com/spf13/cobra"
@@ -14,44 +16,171 @@ import (
 
 // NewListInventoryCommand creates the list-inventory command
 func NewListInventoryCommand() *cobra.Command {
-	var status string
+	var (
+		status      string
+		itemPrefix  string
+		appType     string
+		maxItems    int
+		limit       int
+		offset      int
+		hideEmpty   bool
+		failOnEmpty bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "list-inventory",
 		Short: "List all user entitlements",
 		Long:  "List all item entitlements owned by the user from AGS Platform.",
+		Example: `  challenge-demo list-inventory --limit 50
+  challenge-demo list-inventory --limit 50 --offset 50`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get format flag
 			format, _ := cmd.Flags().GetString("format")
 
+			if limit < 0 || offset < 0 {
+				return cli.UsageErrorf("--limit and --offset must not be negative")
+			}
+			if offset > 0 && limit == 0 {
+				return cli.UsageErrorf("--offset requires --limit")
+			}
+
 			// Create container
 			container := cli.GetContainerFromFlags(cmd)
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 
 			// Build filters
 			filters := make(map[string]string)
 			if status != "" {
-				filters["status"] = status
+				filters[ags.FilterStatus] = status
+			}
+			if itemPrefix != "" {
+				filters[ags.FilterItemIDPrefix] = itemPrefix
+			}
+			if appType != "" {
+				filters[ags.FilterAppType] = appType
 			}
 
-			// Query entitlements
-			ents, err := container.RewardVerifier.QueryUserEntitlements(filters)
+			// Query entitlements, one page when --limit is set
+			var ents []*ags.Entitlement
+			var page *ags.EntitlementPage
+			var err error
+			if limit > 0 {
+				page, err = container.RewardVerifier.QueryUserEntitlementsPage(ctx, filters, offset, limit)
+				if page != nil {
+					ents = page.Entitlements
+				}
+			} else {
+				ents, err = container.RewardVerifier.QueryUserEntitlements(ctx, filters)
+			}
 			if err != nil {
 				return fmt.Errorf("failed to query entitlements: %w", err)
 			}
+			ents = ags.InventoryFilter{HideEmpty: hideEmpty}.Entitlements(ents)
+
+			// Apply display limit after filtering
+			total := len(ents)
+			ents = limitItems(ents, maxItems)
 
 			// Format output
-			formatter := output.NewFormatter(format)
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
 			result, err := formatter.FormatEntitlements(ents)
 			if err != nil {
 				return fmt.Errorf("failed to format output: %w", err)
 			}
 
-			fmt.Println(result)
-			return nil
+			result = withTruncationNote(result, format, len(ents), total)
+			if page != nil {
+				result = withNote(result, format, pageNote(page, offset))
+			}
+			if err := cli.PrintResult(cmd, result); err != nil {
+				return err
+			}
+
+			return emptyListError(failOnEmpty, total, "entitlements")
 		},
 	}
 
 	cmd.Flags().StringVar(&status, "status", "", "Filter by status (ACTIVE, INACTIVE)")
+	cmd.Flags().StringVar(&itemPrefix, "item-prefix", "", "Only show entitlements whose item ID starts with this")
+	cmd.Flags().StringVar(&appType, "app-type", "", "Only show app entitlements of this type (GAME, SOFTWARE, DLC, DEMO)")
+	cmd.Flags().BoolVar(&hideEmpty, "hide-empty", false, "Hide entitlements with zero quantity")
+	cmd.Flags().IntVar(&maxItems, "max-items", 0, "Maximum number of entitlements to display (0 = unlimited)")
+	cmd.Flags().IntVar(&limit, "limit", 0, "Query one page of at most this many entitlements (0 = no paging)")
+	cmd.Flags().IntVar(&offset, "offset", 0, "Skip this many entitlements before the page (requires --limit)")
+	cmd.Flags().BoolVar(&failOnEmpty, "fail-on-empty", false, failOnEmptyUsage)
 
 	return cmd
 }
+
+// failOnEmptyUsage is the --fail-on-empty help shared by the list commands
+const failOnEmptyUsage = "Exit non-zero if nothing is listed (after filters), so CI fails on a misconfigured environment"
+
+// emptyListError returns an error if failOnEmpty is set and count is 0, naming what was listed
+func emptyListError(failOnEmpty bool, count int, what string) error {
+	if !failOnEmpty || count > 0 {
+		return nil
+	}
+	return fmt.Errorf("no %s found (--fail-on-empty)", what)
+}
+
+// limitItems returns at most max items (max <= 0 means unlimited)
+func limitItems[T any](items []T, max int) []T {
+	if max <= 0 || len(items) <= max {
+		return items
+	}
+	return items[:max]
+}
+
+// truncationNote returns the "(showing N of M)" note, or "" if nothing was truncated
+func truncationNote(shown, total int) string {
+	if shown >= total {
+		return ""
+	}
+	return fmt.Sprintf("(showing %d of %d)", shown, total)
+}
+
+// pageNote returns the "(entitlements X-Y of N)" note for a page queried at offset
+// When the total is unknown, it names the --offset of the next page instead.
+func pageNote(page *ags.EntitlementPage, offset int) string {
+	var more string
+	if page.HasMore {
+		more = fmt.Sprintf("; next page: --offset %d", page.NextOffset)
+	}
+
+	if len(page.Entitlements) == 0 {
+		if page.Total >= 0 {
+			return fmt.Sprintf("(no entitlements at offset %d of %d%s)", offset, page.Total, more)
+		}
+		return fmt.Sprintf("(no entitlements at offset %d%s)", offset, more)
+	}
+
+	// Positions are counted in Platform's unfiltered order, so the range can exceed the page size
+	span := fmt.Sprintf("%d-%d", offset+1, page.NextOffset)
+	if page.Total >= 0 {
+		return fmt.Sprintf("(entitlements %s of %d%s)", span, page.Total, more)
+	}
+	return fmt.Sprintf("(entitlements %s%s)", span, more)
+}
+
+// withTruncationNote appends the truncation note to the result
+func withTruncationNote(result, format string, shown, total int) string {
+	return withNote(result, format, truncationNote(shown, total))
+}
+
+// withNote appends note to the result, unless it is empty
+// For JSON the note goes to stderr instead, so the output stays parseable.
+func withNote(result, format, note string) string {
+	if note == "" {
+		return result
+	}
+
+	if output.IsJSON(format) {
+		fmt.Fprintln(os.Stderr, note)
+		return result
+	}
+	return result + "\n" + note
+}
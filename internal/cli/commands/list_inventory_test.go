@@ -0,0 +1,114 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+)
+
+func TestLimitItems(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	tests := []struct {
+		name     string
+		max      int
+		expected int
+	}{
+		{name: "unlimited", max: 0, expected: 5},
+		{name: "negative is unlimited", max: -1, expected: 5},
+		{name: "truncated at limit", max: 3, expected: 3},
+		{name: "limit equals length", max: 5, expected: 5},
+		{name: "limit above length", max: 10, expected: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limited := limitItems(items, tt.max)
+			if len(limited) != tt.expected {
+				t.Errorf("Expected %d items, got %d", tt.expected, len(limited))
+			}
+			for i := range limited {
+				if limited[i] != items[i] {
+					t.Errorf("Expected item %d to be '%s', got '%s'", i, items[i], limited[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTruncationNote(t *testing.T) {
+	if note := truncationNote(3, 10); note != "(showing 3 of 10)" {
+		t.Errorf("Expected '(showing 3 of 10)', got '%s'", note)
+	}
+
+	if note := truncationNote(5, 5); note != "" {
+		t.Errorf("Expected no note when nothing is truncated, got '%s'", note)
+	}
+}
+
+func TestEmptyListError(t *testing.T) {
+	if err := emptyListError(false, 0, "challenges"); err != nil {
+		t.Errorf("Expected no error without --fail-on-empty, got %v", err)
+	}
+
+	if err := emptyListError(true, 2, "challenges"); err != nil {
+		t.Errorf("Expected no error for a non-empty list, got %v", err)
+	}
+
+	err := emptyListError(true, 0, "wallets")
+	if err == nil || err.Error() != "no wallets found (--fail-on-empty)" {
+		t.Errorf("Expected an empty list error, got %v", err)
+	}
+	if code := cli.ExitCode(err); code != cli.ExitError {
+		t.Errorf("Expected exit code %d, got %d", cli.ExitError, code)
+	}
+}
+
+func TestPageNote(t *testing.T) {
+	two := []*ags.Entitlement{{ItemID: "a"}, {ItemID: "b"}}
+
+	tests := []struct {
+		name     string
+		page     *ags.EntitlementPage
+		offset   int
+		expected string
+	}{
+		{
+			name:     "known total",
+			page:     &ags.EntitlementPage{Entitlements: two, NextOffset: 4, HasMore: true, Total: 5},
+			offset:   2,
+			expected: "(entitlements 3-4 of 5; next page: --offset 4)",
+		},
+		{
+			name:     "unknown total",
+			page:     &ags.EntitlementPage{Entitlements: two, NextOffset: 4, HasMore: true, Total: -1},
+			offset:   2,
+			expected: "(entitlements 3-4; next page: --offset 4)",
+		},
+		{
+			name:     "last page",
+			page:     &ags.EntitlementPage{Entitlements: two, NextOffset: 2, Total: 2},
+			offset:   0,
+			expected: "(entitlements 1-2 of 2)",
+		},
+		{
+			name:     "past the end",
+			page:     &ags.EntitlementPage{NextOffset: 2, Total: 2},
+			offset:   10,
+			expected: "(no entitlements at offset 10 of 2)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if note := pageNote(tt.page, tt.offset); note != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, note)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// NewListNamespacesCommand creates the list-namespaces command
+func NewListNamespacesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-namespaces",
+		Short: "List namespaces accessible to the admin credentials",
+		Long: `List the AGS namespaces the admin client can access.
+
+Requires admin mode: a non-mock --auth-mode with --admin-client-id,
+--admin-client-secret, and --platform-url. Not available in mock mode or with
+user credentials alone.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+
+			// Query namespaces
+			namespaces, err := listNamespaces(ctx, container.NamespaceLister)
+			if err != nil {
+				return err
+			}
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			result, err := formatter.FormatNamespaces(namespaces)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			return cli.PrintResult(cmd, result)
+		},
+	}
+
+	return cmd
+}
+
+// listNamespaces queries the lister, rejecting containers built without admin credentials
+func listNamespaces(ctx context.Context, lister ags.NamespaceLister) ([]*ags.Namespace, error) {
+	if lister == nil {
+		return nil, cli.UsageErrorf("list-namespaces requires admin mode (--admin-client-id and --admin-client-secret with a non-mock --auth-mode)")
+	}
+
+	namespaces, err := lister.ListNamespaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	return namespaces, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+)
+
+// stubNamespaceLister returns fixed namespaces or an error
+type stubNamespaceLister struct {
+	namespaces []*ags.Namespace
+	err        error
+}
+
+func (s *stubNamespaceLister) ListNamespaces(ctx context.Context) ([]*ags.Namespace, error) {
+	return s.namespaces, s.err
+}
+
+func TestListNamespaces(t *testing.T) {
+	lister := &stubNamespaceLister{namespaces: []*ags.Namespace{
+		{Namespace: "mygame", DisplayName: "My Game", Status: "ACTIVE"},
+	}}
+
+	namespaces, err := listNamespaces(context.Background(), lister)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(namespaces) != 1 || namespaces[0].Namespace != "mygame" {
+		t.Errorf("Expected [mygame], got %+v", namespaces)
+	}
+
+	lister.err = errors.New("HTTP 403 Forbidden")
+	if _, err := listNamespaces(context.Background(), lister); !errors.Is(err, lister.err) {
+		t.Errorf("Expected wrapped lister error, got %v", err)
+	}
+}
+
+func TestListNamespaces_RequiresAdminMode(t *testing.T) {
+	_, err := listNamespaces(context.Background(), nil)
+	if err == nil {
+		t.Fatal("Expected error without admin credentials, got nil")
+	}
+
+	var usageErr *cli.UsageError
+	if !errors.As(err, &usageErr) {
+		t.Errorf("Expected usage error, got %T: %v", err, err)
+	}
+	if code := cli.ExitCode(err); code != cli.ExitUsageError {
+		t.Errorf("Expected exit code %d, got %d", cli.ExitUsageError, code)
+	}
+}
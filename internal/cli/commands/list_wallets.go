@@ -7,6 +7,7 @@ package commands
 import (
 	"fmt"
 
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
 	"github.com/spf13/cobra"
@@ -14,6 +15,12 @@ import (
 
 // NewListWalletsCommand creates the list-wallets command
 func NewListWalletsCommand() *cobra.Command {
+	var (
+		maxItems    int
+		filter      ags.InventoryFilter
+		failOnEmpty bool
+	)
+
 	cmd := &cobra.Command{
 		Use:   "list-wallets",
 		Short: "List all user wallets",
@@ -24,24 +31,42 @@ func NewListWalletsCommand() *cobra.Command {
 
 			// Create container
 			container := cli.GetContainerFromFlags(cmd)
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 
 			// Query wallets
-			wallets, err := container.RewardVerifier.QueryUserWallets()
+			wallets, err := container.RewardVerifier.QueryUserWallets(ctx)
 			if err != nil {
 				return fmt.Errorf("failed to query wallets: %w", err)
 			}
+			wallets = filter.Wallets(wallets)
+
+			// Apply display limit
+			total := len(wallets)
+			wallets = limitItems(wallets, maxItems)
 
 			// Format output
-			formatter := output.NewFormatter(format)
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
 			result, err := formatter.FormatWallets(wallets)
 			if err != nil {
 				return fmt.Errorf("failed to format output: %w", err)
 			}
 
-			fmt.Println(result)
-			return nil
+			if err := cli.PrintResult(cmd, withTruncationNote(result, format, len(wallets), total)); err != nil {
+				return err
+			}
+
+			return emptyListError(failOnEmpty, total, "wallets")
 		},
 	}
 
+	cmd.Flags().StringVar(&filter.Currency, "currency", "", "Show only the wallet for this currency code")
+	cmd.Flags().BoolVar(&filter.HideEmpty, "hide-empty", false, "Hide wallets with a zero balance")
+	cmd.Flags().IntVar(&maxItems, "max-items", 0, "Maximum number of wallets to display (0 = unlimited)")
+	cmd.Flags().BoolVar(&failOnEmpty, "fail-on-empty", false, failOnEmptyUsage)
+
 	return cmd
 }
@@ -0,0 +1,149 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/charmbracelet/x/term"
+	"github.com/spf13/cobra"
+)
+
+// NewLoginCommand creates the login command
+func NewLoginCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Log in interactively and cache the user token",
+		Long: `Prompt for email and password, perform the password grant, and cache the
+resulting user token for later commands. The password is read without echo.
+
+--email, --password, --password-stdin, and $CHALLENGE_PASSWORD skip their prompt.
+--client-id, --client-secret, --iam-url, and --namespace select the IAM client as usual.
+
+Later commands run with --auth-mode password and no password reuse the cached token
+(refreshing it when it expires) until it can no longer be refreshed.`,
+		Example: `  challenge-demo login --client-id abc --namespace demo
+  challenge-demo list --auth-mode password --client-id abc --namespace demo`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, _ := cmd.Flags().GetString("format")
+			email, _ := cmd.Flags().GetString("email")
+			password, _ := cmd.Flags().GetString("password")
+			mfaToken, _ := cmd.Flags().GetString(cli.MFATokenFlag)
+			clientID, _ := cmd.Flags().GetString("client-id")
+			clientSecret, _ := cmd.Flags().GetString("client-secret")
+			iamURL, _ := cmd.Flags().GetString("iam-url")
+			namespace, _ := cmd.Flags().GetString(cli.NamespaceFlag)
+
+			if clientID == "" {
+				return cli.UsageErrorf("--client-id is required to log in")
+			}
+
+			cachePath := auth.DefaultTokenCachePath()
+			if cachePath == "" {
+				return fmt.Errorf("cannot cache the token: user cache directory unknown")
+			}
+
+			email, password, err := promptLogin(cmd.InOrStdin(), cmd.ErrOrStderr(), email, password, readPasswordFromTerminal)
+			if err != nil {
+				return err
+			}
+
+			// The provider caches the granted token itself
+			provider := auth.NewPasswordAuthProvider(
+				iamURL,
+				clientID,
+				clientSecret,
+				namespace,
+				email,
+				password,
+				auth.WithMFAToken(mfaToken),
+				auth.WithTokenCache(auth.NewTokenCache(cachePath)),
+			)
+
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			token, err := provider.Authenticate(ctx)
+			if err != nil {
+				return fmt.Errorf("login failed: %w", err)
+			}
+
+			info, err := whoAmI(token, "password", time.Now())
+			if err != nil {
+				return err
+			}
+			if !cli.IsQuiet(cmd) {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Token cached at %s\n", cachePath)
+			}
+
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			formatted, err := formatter.FormatWhoAmI(info)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			return cli.PrintResult(cmd, formatted)
+		},
+	}
+
+	return cmd
+}
+
+// promptLogin prompts on out for whichever of email and password is empty
+// The email is read as a line from in; the password through readPassword, so it isn't echoed.
+func promptLogin(in io.Reader, out io.Writer, email, password string, readPassword func() ([]byte, error)) (string, string, error) {
+	if email == "" {
+		fmt.Fprint(out, "Email: ")
+		line, err := bufio.NewReader(in).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", "", fmt.Errorf("failed to read email: %w", err)
+		}
+		email = strings.TrimSpace(line)
+		if email == "" {
+			return "", "", cli.UsageErrorf("no email given")
+		}
+	}
+
+	if password == "" {
+		fmt.Fprint(out, "Password: ")
+		value, err := readPassword()
+		fmt.Fprintln(out) // The newline typed after the password is not echoed either
+		if err != nil {
+			return "", "", err
+		}
+		password = string(value)
+		if password == "" {
+			return "", "", cli.UsageErrorf("no password given")
+		}
+	}
+
+	return email, password, nil
+}
+
+// readPasswordFromTerminal reads a password from stdin without echoing it
+func readPasswordFromTerminal() ([]byte, error) {
+	fd := os.Stdin.Fd()
+	if !term.IsTerminal(fd) {
+		return nil, cli.UsageErrorf("stdin is not a terminal; pass the password with --password-stdin or $%s", cli.EnvPassword)
+	}
+
+	value, err := term.ReadPassword(fd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read password: %w", err)
+	}
+	return value, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+)
+
+func TestPromptLogin(t *testing.T) {
+	var out bytes.Buffer
+	readPassword := func() ([]byte, error) { return []byte("secret"), nil }
+
+	email, password, err := promptLogin(strings.NewReader(" alice@example.com \n"), &out, "", "", readPassword)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if email != "alice@example.com" || password != "secret" {
+		t.Errorf("Expected alice@example.com / secret, got %q / %q", email, password)
+	}
+	if !strings.Contains(out.String(), "Email: ") || !strings.Contains(out.String(), "Password: ") {
+		t.Errorf("Expected both prompts, got %q", out.String())
+	}
+	if strings.Contains(out.String(), "secret") {
+		t.Errorf("Password must not be echoed, got %q", out.String())
+	}
+}
+
+func TestPromptLogin_SkipsGivenValues(t *testing.T) {
+	var out bytes.Buffer
+	readPassword := func() ([]byte, error) {
+		t.Fatal("Password prompt should be skipped")
+		return nil, nil
+	}
+
+	email, password, err := promptLogin(strings.NewReader(""), &out, "bob@example.com", "from-flag", readPassword)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if email != "bob@example.com" || password != "from-flag" || out.Len() != 0 {
+		t.Errorf("Expected no prompts, got %q / %q and output %q", email, password, out.String())
+	}
+}
+
+func TestPromptLogin_Empty(t *testing.T) {
+	readPassword := func() ([]byte, error) { return nil, nil }
+
+	if _, _, err := promptLogin(strings.NewReader("\n"), &bytes.Buffer{}, "", "", readPassword); cli.ExitCode(err) != cli.ExitUsageError {
+		t.Errorf("Expected a usage error for an empty email, got %v", err)
+	}
+	if _, _, err := promptLogin(strings.NewReader(""), &bytes.Buffer{}, "alice@example.com", "", readPassword); cli.ExitCode(err) != cli.ExitUsageError {
+		t.Errorf("Expected a usage error for an empty password, got %v", err)
+	}
+}
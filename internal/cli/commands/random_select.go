@@ -5,12 +5,13 @@
 package commands
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 	"github.com/spf13/cobra"
 )
 
@@ -20,20 +21,26 @@ func NewRandomSelectCommand() *cobra.Command {
 		count           int
 		replaceExisting bool
 		excludeActive   bool
+		seed            int64
 	)
 
 	cmd := &cobra.Command{
 		Use:   "random-select <challenge-id>",
 		Short: "Randomly select N goals",
 		Long: `Randomly activate N goals from a challenge (M4 feature).
-The system will automatically exclude completed/claimed goals and goals with unmet prerequisites.`,
-		Args: cobra.ExactArgs(1),
+The system will automatically exclude completed/claimed goals and goals with unmet prerequisites.
+
+Use --seed to pin the selection for regression runs: the same seed yields the
+same selection, provided the backend supports seeded selection. Backends
+without seed support ignore it and select randomly.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeChallengeID,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			challengeID := args[0]
 
 			// Validate count
 			if count <= 0 {
-				return fmt.Errorf("count must be greater than 0")
+				return cli.UsageErrorf("count must be greater than 0")
 			}
 
 			// Get format flag
@@ -47,47 +54,50 @@ The system will automatically exclude completed/claimed goals and goals with unm
 				Count:           count,
 				ReplaceExisting: replaceExisting,
 				ExcludeActive:   excludeActive,
+				Seed:            seed,
 			}
 
 			// Call API
-			ctx := context.Background()
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 			result, err := container.APIClient.RandomSelectGoals(ctx, challengeID, req)
 			if err != nil {
 				return fmt.Errorf("failed to random select goals: %w", err)
 			}
 
 			// Format output
+			var b strings.Builder
 			switch format {
-			case "json":
-				output, err := json.MarshalIndent(result, "", "  ")
+			case "json", "jsonl":
+				data, err := output.MarshalJSON(format, result)
 				if err != nil {
 					return fmt.Errorf("failed to format JSON: %w", err)
 				}
-				fmt.Println(string(output))
+				b.Write(data)
 
 			case "table":
-				fmt.Printf("Random Goal Selection Completed\n")
-				fmt.Println("─────────────────────────────────────────")
-				fmt.Printf("Challenge ID:      %s\n", result.ChallengeID)
-				fmt.Printf("Selected Goals:    %d\n", len(result.SelectedGoals))
-				fmt.Printf("Total Active:      %d\n", result.TotalActiveGoals)
-				fmt.Printf("Replaced Goals:    %d\n", len(result.ReplacedGoals))
-				fmt.Println("─────────────────────────────────────────")
-				fmt.Println("Randomly Selected Goals:")
+				fmt.Fprintf(&b, "Random Goal Selection Completed\n")
+				fmt.Fprintln(&b, glyph.Rule(41))
+				fmt.Fprintf(&b, "Challenge ID:      %s\n", result.ChallengeID)
+				fmt.Fprintf(&b, "Selected Goals:    %d\n", len(result.SelectedGoals))
+				fmt.Fprintf(&b, "Total Active:      %d\n", result.TotalActiveGoals)
+				fmt.Fprintf(&b, "Replaced Goals:    %d\n", len(result.ReplacedGoals))
+				fmt.Fprintln(&b, glyph.Rule(41))
+				fmt.Fprintln(&b, "Randomly Selected Goals:")
 				for _, goal := range result.SelectedGoals {
-					fmt.Printf("  - %s (%s)\n", goal.Name, goal.ID)
+					fmt.Fprintf(&b, "  - %s (%s)\n", goal.Name, goal.ID)
 				}
 
 			default: // text
-				fmt.Printf("✅ Successfully selected %d random goals\n", len(result.SelectedGoals))
-				fmt.Printf("   Challenge: %s\n", result.ChallengeID)
-				fmt.Printf("   Total Active: %d\n", result.TotalActiveGoals)
+				fmt.Fprintf(&b, "%s Successfully selected %d random goals\n", glyph.Current().Done, len(result.SelectedGoals))
+				fmt.Fprintf(&b, "   Challenge: %s\n", result.ChallengeID)
+				fmt.Fprintf(&b, "   Total Active: %d\n", result.TotalActiveGoals)
 				if len(result.ReplacedGoals) > 0 {
-					fmt.Printf("   Replaced: %d goals\n", len(result.ReplacedGoals))
+					fmt.Fprintf(&b, "   Replaced: %d goals\n", len(result.ReplacedGoals))
 				}
 			}
 
-			return nil
+			return cli.PrintResult(cmd, b.String())
 		},
 	}
 
@@ -95,6 +105,7 @@ The system will automatically exclude completed/claimed goals and goals with unm
 	cmd.Flags().IntVar(&count, "count", 3, "Number of goals to select")
 	cmd.Flags().BoolVar(&replaceExisting, "replace-existing", false, "Deactivate existing goals first")
 	cmd.Flags().BoolVar(&excludeActive, "exclude-active", false, "Exclude already-active goals")
+	cmd.Flags().Int64Var(&seed, "seed", 0, "Seed for a reproducible selection (0 = random; requires backend support)")
 
 	return cmd
 }
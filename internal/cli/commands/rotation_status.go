@@ -5,7 +5,6 @@
 package commands
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
@@ -16,10 +15,11 @@ import (
 // NewGetRotationStatusCommand creates the get-rotation-status command
 func NewGetRotationStatusCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get-rotation-status <challenge-id>",
-		Short: "Get rotation status for a challenge",
-		Long:  "Get rotation schedule and current period info for a challenge (M5).",
-		Args:  cobra.ExactArgs(1),
+		Use:               "get-rotation-status <challenge-id>",
+		Short:             "Get rotation status for a challenge",
+		Long:              "Get rotation schedule and current period info for a challenge (M5).",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeChallengeID,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			challengeID := args[0]
 
@@ -27,7 +27,8 @@ func NewGetRotationStatusCommand() *cobra.Command {
 			container := cli.GetContainerFromFlags(cmd)
 
 			// Call API
-			ctx := context.Background()
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 			result, err := container.APIClient.GetRotationStatus(ctx, challengeID)
 			if err != nil {
 				return fmt.Errorf("failed to get rotation status: %w", err)
@@ -39,8 +40,7 @@ func NewGetRotationStatusCommand() *cobra.Command {
 				return fmt.Errorf("failed to format output: %w", err)
 			}
 
-			fmt.Println(string(jsonBytes))
-			return nil
+			return cli.PrintResult(cmd, string(jsonBytes))
 		},
 	}
 
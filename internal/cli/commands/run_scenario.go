@@ -0,0 +1,112 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/scenario"
+	"github.com/spf13/cobra"
+)
+
+// NewRunScenarioCommand creates the run-scenario command
+func NewRunScenarioCommand() *cobra.Command {
+	var (
+		fromFile        string
+		reportJUnit     string
+		continueOnError bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "run-scenario",
+		Short: "Run a YAML scenario and gate on its assertions",
+		Long: `Run the steps of a YAML scenario in order (initialize, set-active, trigger,
+claim, verify, wait, assert) and exit non-zero if any step or assertion fails.
+
+Assert steps check live state, e.g.:
+
+  - type: assert
+    assert: goal daily-quests/kill-10 status == completed
+  - type: assert
+    assert: wallet GOLD >= 100
+
+Use --report-junit to write a JUnit XML report for CI.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fromFile == "" {
+				return cli.UsageErrorf("--from-file is required")
+			}
+
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Load and validate scenario before touching anything
+			data, err := os.ReadFile(fromFile)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", fromFile, err)
+			}
+
+			sc, err := scenario.Parse(data)
+			if err != nil {
+				return fmt.Errorf("invalid scenario %s: %w", fromFile, err)
+			}
+			if cmd.Flags().Changed("continue-on-error") {
+				sc.ContinueOnError = continueOnError
+			}
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// Run steps
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			result, err := sc.Run(ctx, scenario.Deps{
+				APIClient:      container.APIClient,
+				EventTrigger:   container.EventTrigger,
+				RewardVerifier: container.RewardVerifier,
+				UserID:         container.UserID,
+				Namespace:      container.Namespace,
+			})
+			if result == nil {
+				return err
+			}
+
+			if reportJUnit != "" {
+				if err := result.JUnitSuite().WriteFile(reportJUnit); err != nil {
+					return err
+				}
+			}
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			formatted, err := formatter.FormatBulkResult(scenarioBulkResult("run-scenario", result))
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			if err := cli.PrintResult(cmd, formatted); err != nil {
+				return err
+			}
+
+			if result.ExitCode() != cli.ExitSuccess {
+				return fmt.Errorf("%d of %d scenario steps failed", result.Failed, len(result.Steps))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "YAML scenario to run (required)")
+	cmd.Flags().StringVar(&reportJUnit, "report-junit", "", "Write a JUnit XML report (one test case per step) to this file")
+	cmd.Flags().BoolVar(&continueOnError, "continue-on-error", false, "Keep running steps after a failure (overrides the scenario file)")
+	_ = cmd.MarkFlagRequired("from-file")
+
+	return cmd
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/scenario"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// seedFixture describes the target player state for the seed command
+//
+// Example:
+//
+//	initialize: true
+//	goals:
+//	  - challenge: daily-quests
+//	    goal: kill-10
+//	    active: true
+//	events:
+//	  - type: login
+//	  - type: stat-update
+//	    stat: kills
+//	    value: 10
+type seedFixture struct {
+	Initialize bool        `yaml:"initialize"`
+	Goals      []seedGoal  `yaml:"goals"`
+	Events     []seedEvent `yaml:"events"`
+}
+
+// seedGoal sets a goal's active state
+type seedGoal struct {
+	Challenge string `yaml:"challenge"`
+	Goal      string `yaml:"goal"`
+	Active    *bool  `yaml:"active"` // Defaults to true
+}
+
+// seedEvent triggers a login or stat-update event
+type seedEvent struct {
+	Type  string `yaml:"type"` // login or stat-update
+	Stat  string `yaml:"stat"`
+	Value int    `yaml:"value"`
+}
+
+// NewSeedCommand creates the seed command
+func NewSeedCommand() *cobra.Command {
+	var (
+		fromFile        string
+		continueOnError bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Prepare a player from a YAML fixture",
+		Long: `Prepare a player by running the steps described in a YAML fixture, in order:
+initialize the player, set goals active/inactive, then trigger events.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fromFile == "" {
+				return cli.UsageErrorf("--from-file is required")
+			}
+
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Load and validate fixture before touching anything
+			data, err := os.ReadFile(fromFile)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", fromFile, err)
+			}
+
+			fixture, err := parseSeedFixture(data)
+			if err != nil {
+				return fmt.Errorf("invalid fixture %s: %w", fromFile, err)
+			}
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// Run steps
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			result, err := runSeed(ctx, seedScenario(fixture, continueOnError), scenario.Deps{
+				APIClient:    container.APIClient,
+				EventTrigger: container.EventTrigger,
+				UserID:       container.UserID,
+				Namespace:    container.Namespace,
+			})
+			if err != nil {
+				return err
+			}
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			formatted, err := formatter.FormatBulkResult(result)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			if err := cli.PrintResult(cmd, formatted); err != nil {
+				return err
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("%d of %d seed steps failed", result.Failed, result.Total)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "YAML fixture describing the target state (required)")
+	cmd.Flags().BoolVar(&continueOnError, "continue-on-error", false, "Keep running steps after a failure")
+	_ = cmd.MarkFlagRequired("from-file")
+
+	return cmd
+}
+
+// parseSeedFixture decodes and validates a YAML fixture
+func parseSeedFixture(data []byte) (*seedFixture, error) {
+	var fixture seedFixture
+	if err := yaml.UnmarshalStrict(data, &fixture); err != nil {
+		return nil, err
+	}
+
+	for i, g := range fixture.Goals {
+		if g.Challenge == "" || g.Goal == "" {
+			return nil, fmt.Errorf("goals[%d]: challenge and goal are required", i)
+		}
+	}
+
+	for i, e := range fixture.Events {
+		switch e.Type {
+		case "login":
+		case "stat-update":
+			if e.Stat == "" {
+				return nil, fmt.Errorf("events[%d]: stat is required for stat-update", i)
+			}
+		default:
+			return nil, fmt.Errorf("events[%d]: unknown event type %q (expected login or stat-update)", i, e.Type)
+		}
+	}
+
+	return &fixture, nil
+}
+
+// seedScenario turns a fixture into ordered steps: initialize, set-active, then events
+func seedScenario(fixture *seedFixture, continueOnError bool) *scenario.Scenario {
+	sc := &scenario.Scenario{Name: "seed", ContinueOnError: continueOnError, Steps: []scenario.Step{}}
+
+	if fixture.Initialize {
+		sc.Steps = append(sc.Steps, scenario.Step{Type: scenario.StepInitialize})
+	}
+
+	for _, g := range fixture.Goals {
+		sc.Steps = append(sc.Steps, scenario.Step{
+			Type:      scenario.StepSetActive,
+			Challenge: g.Challenge,
+			Goal:      g.Goal,
+			Active:    g.Active,
+		})
+	}
+
+	for _, e := range fixture.Events {
+		sc.Steps = append(sc.Steps, scenario.Step{
+			Type:  scenario.StepTrigger,
+			Event: e.Type,
+			Stat:  e.Stat,
+			Value: e.Value,
+		})
+	}
+
+	return sc
+}
+
+// runSeed runs the fixture's scenario and reports each step as a bulk result item
+func runSeed(ctx context.Context, sc *scenario.Scenario, deps scenario.Deps) (*output.BulkResult, error) {
+	scenarioResult, err := sc.Run(ctx, deps)
+	if scenarioResult == nil {
+		return nil, err
+	}
+
+	return scenarioBulkResult("seed", scenarioResult), nil
+}
+
+// scenarioBulkResult reports each scenario step as a bulk result item
+func scenarioBulkResult(operation string, scenarioResult *scenario.ScenarioResult) *output.BulkResult {
+	result := &output.BulkResult{Operation: operation, Items: []output.BulkItemResult{}}
+	for _, step := range scenarioResult.Steps {
+		result.Add(output.BulkItemResult{
+			ID:         step.Step,
+			Status:     step.Status,
+			DurationMs: step.DurationMs,
+			Error:      step.Error,
+		})
+	}
+
+	return result
+}
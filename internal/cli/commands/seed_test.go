@@ -0,0 +1,145 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/scenario"
+)
+
+// recordingTrigger is an EventTrigger that records calls
+type recordingTrigger struct {
+	calls []string
+	err   error
+}
+
+func (r *recordingTrigger) TriggerLogin(ctx context.Context, userID, namespace string) error {
+	r.calls = append(r.calls, "login")
+	return r.err
+}
+
+func (r *recordingTrigger) TriggerStatUpdate(ctx context.Context, userID, namespace, statCode string, value, inc int) error {
+	r.calls = append(r.calls, fmt.Sprintf("stat-update %s=%d", statCode, value))
+	return r.err
+}
+
+func (r *recordingTrigger) Close() error {
+	return nil
+}
+
+const seedYAML = `
+initialize: true
+goals:
+  - challenge: daily
+    goal: kill-10
+  - challenge: daily
+    goal: login-once
+    active: false
+events:
+  - type: login
+  - type: stat-update
+    stat: kills
+    value: 10
+`
+
+func newSeedMockAPI() *api.MockAPIClient {
+	return api.NewMockAPIClient([]api.Challenge{
+		{ID: "daily", Goals: []api.Goal{
+			{ID: "kill-10", Status: "in_progress"},
+			{ID: "login-once", Status: "not_started", IsActive: true},
+		}},
+	})
+}
+
+func TestSeed_RunsStepsInOrder(t *testing.T) {
+	fixture, err := parseSeedFixture([]byte(seedYAML))
+	if err != nil {
+		t.Fatalf("Unexpected parse error: %v", err)
+	}
+
+	apiClient := newSeedMockAPI()
+	trigger := &recordingTrigger{}
+
+	deps := scenario.Deps{APIClient: apiClient, EventTrigger: trigger, UserID: "user-1", Namespace: "demo"}
+	result, err := runSeed(context.Background(), seedScenario(fixture, false), deps)
+	if err != nil {
+		t.Fatalf("Unexpected seed error: %v", err)
+	}
+
+	expectedIDs := []string{
+		"initialize",
+		"set-active daily/kill-10=true",
+		"set-active daily/login-once=false",
+		"trigger login",
+		"trigger stat-update kills=10",
+	}
+
+	if result.Total != len(expectedIDs) || result.Succeeded != len(expectedIDs) {
+		t.Fatalf("Expected %d successful steps, got %d/%d", len(expectedIDs), result.Succeeded, result.Total)
+	}
+
+	for i, id := range expectedIDs {
+		if result.Items[i].ID != id {
+			t.Errorf("Step %d: expected '%s', got '%s'", i, id, result.Items[i].ID)
+		}
+	}
+
+	if len(trigger.calls) != 2 || trigger.calls[0] != "login" || trigger.calls[1] != "stat-update kills=10" {
+		t.Errorf("Expected login then stat-update, got %v", trigger.calls)
+	}
+
+	goals := apiClient.Challenges[0].Goals
+	if !goals[0].IsActive || goals[1].IsActive {
+		t.Errorf("Expected kill-10 active and login-once inactive, got %v/%v", goals[0].IsActive, goals[1].IsActive)
+	}
+}
+
+func TestSeed_StopsAfterFailure(t *testing.T) {
+	fixture, err := parseSeedFixture([]byte(seedYAML))
+	if err != nil {
+		t.Fatalf("Unexpected parse error: %v", err)
+	}
+
+	trigger := &recordingTrigger{err: errors.New("connection refused")}
+
+	deps := scenario.Deps{APIClient: newSeedMockAPI(), EventTrigger: trigger, UserID: "user-1", Namespace: "demo"}
+	result, err := runSeed(context.Background(), seedScenario(fixture, false), deps)
+	if err != nil {
+		t.Fatalf("Unexpected seed error: %v", err)
+	}
+
+	if result.Succeeded != 3 || result.Failed != 1 || result.Skipped != 1 {
+		t.Errorf("Expected 3 succeeded, 1 failed, 1 skipped, got %d/%d/%d", result.Succeeded, result.Failed, result.Skipped)
+	}
+
+	if len(trigger.calls) != 1 {
+		t.Errorf("Expected steps after the failure not to run, got calls %v", trigger.calls)
+	}
+}
+
+func TestParseSeedFixture_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+	}{
+		{name: "unknown field", yaml: "initialise: true\n"},
+		{name: "missing goal", yaml: "goals:\n  - challenge: daily\n"},
+		{name: "unknown event type", yaml: "events:\n  - type: logout\n"},
+		{name: "stat-update without stat", yaml: "events:\n  - type: stat-update\n    value: 3\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseSeedFixture([]byte(tt.yaml)); err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+}
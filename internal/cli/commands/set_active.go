@@ -5,11 +5,12 @@
 package commands
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 	"github.com/spf13/cobra"
 )
 
@@ -23,7 +24,8 @@ func NewSetGoalActiveCommand() *cobra.Command {
 		Long: `Activate or deactivate a goal for the current player.
 Active goals receive event updates and can be claimed.
 Inactive goals do not receive event updates.`,
-		Args: cobra.ExactArgs(2),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeChallengeAndGoalID,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			challengeID := args[0]
 			goalID := args[1]
@@ -35,31 +37,33 @@ Inactive goals do not receive event updates.`,
 			container := cli.GetContainerFromFlags(cmd)
 
 			// Call API
-			ctx := context.Background()
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 			result, err := container.APIClient.SetGoalActive(ctx, challengeID, goalID, isActive)
 			if err != nil {
 				return fmt.Errorf("failed to set goal active status: %w", err)
 			}
 
 			// Format output
+			var b strings.Builder
 			switch format {
-			case "json":
-				output, err := json.MarshalIndent(result, "", "  ")
+			case "json", "jsonl":
+				data, err := output.MarshalJSON(format, result)
 				if err != nil {
 					return fmt.Errorf("failed to format JSON: %w", err)
 				}
-				fmt.Println(string(output))
+				b.Write(data)
 
 			case "table":
-				fmt.Printf("Goal Active Status Updated\n")
-				fmt.Println("─────────────────────────────────────────")
-				fmt.Printf("Challenge ID: %s\n", result.ChallengeID)
-				fmt.Printf("Goal ID:      %s\n", result.GoalID)
-				fmt.Printf("Active:       %v\n", result.IsActive)
-				fmt.Printf("Assigned At:  %s\n", result.AssignedAt)
-				fmt.Println("─────────────────────────────────────────")
+				fmt.Fprintf(&b, "Goal Active Status Updated\n")
+				fmt.Fprintln(&b, glyph.Rule(41))
+				fmt.Fprintf(&b, "Challenge ID: %s\n", result.ChallengeID)
+				fmt.Fprintf(&b, "Goal ID:      %s\n", result.GoalID)
+				fmt.Fprintf(&b, "Active:       %v\n", result.IsActive)
+				fmt.Fprintf(&b, "Assigned At:  %s\n", result.AssignedAt)
+				fmt.Fprintln(&b, glyph.Rule(41))
 				if result.Message != "" {
-					fmt.Printf("Message: %s\n", result.Message)
+					fmt.Fprintf(&b, "Message: %s\n", result.Message)
 				}
 
 			default: // text
@@ -67,15 +71,15 @@ Inactive goals do not receive event updates.`,
 				if result.IsActive {
 					action = "activated"
 				}
-				fmt.Printf("✅ Goal %s successfully\n", action)
-				fmt.Printf("   Challenge: %s\n", result.ChallengeID)
-				fmt.Printf("   Goal: %s\n", result.GoalID)
+				fmt.Fprintf(&b, "%s Goal %s successfully\n", glyph.Current().Done, action)
+				fmt.Fprintf(&b, "   Challenge: %s\n", result.ChallengeID)
+				fmt.Fprintf(&b, "   Goal: %s\n", result.GoalID)
 				if result.Message != "" {
-					fmt.Printf("   %s\n", result.Message)
+					fmt.Fprintf(&b, "   %s\n", result.Message)
 				}
 			}
 
-			return nil
+			return cli.PrintResult(cmd, b.String())
 		},
 	}
 
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// statusTimeout bounds the token and backend lookups so one slow service doesn't hang the report
+const statusTimeout = 10 * time.Second
+
+// NewStatusCommand creates the status command
+func NewStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show auth, backend, challenge, and inventory status at a glance",
+		Long: `Print a compact summary of the current session: the user token and its expiry,
+whether the Challenge Service is reachable, challenge completion counts, and the
+number of entitlements and wallets in AGS Platform.
+
+All sections are queried concurrently. A failing section is reported without
+hiding the others, and the command exits non-zero.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// Query all sections
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			ctx, cancel := context.WithTimeout(ctx, statusTimeout)
+			defer cancel()
+			report := collectStatus(ctx, container)
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			formatted, err := formatter.FormatStatus(report)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			if err := cli.PrintResult(cmd, formatted); err != nil {
+				return err
+			}
+
+			if !report.OK() {
+				return errors.New("one or more status checks failed")
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// collectStatus queries the auth provider, Challenge Service, and reward verifier concurrently
+func collectStatus(ctx context.Context, container *app.Container) *output.StatusReport {
+	report := &output.StatusReport{
+		Auth: output.AuthStatus{UserID: container.UserID, Namespace: container.Namespace},
+	}
+
+	var (
+		wg         sync.WaitGroup
+		challenges []api.Challenge
+		invErrs    [2]string // Entitlement and wallet query errors
+	)
+
+	wg.Add(4)
+
+	go func() {
+		defer wg.Done()
+		token, err := container.AuthProvider.GetToken(ctx)
+		if err != nil {
+			report.Auth.Error = err.Error()
+			return
+		}
+		report.Auth.Valid = container.AuthProvider.IsTokenValid(token)
+		if !token.ExpiresAt.IsZero() {
+			expiresAt := token.ExpiresAt
+			report.Auth.ExpiresAt = &expiresAt
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		start := time.Now()
+		var err error
+		challenges, err = container.APIClient.ListChallenges(ctx)
+		report.Backend.LatencyMs = time.Since(start).Milliseconds()
+		if err != nil {
+			report.Backend.Error = err.Error()
+			return
+		}
+		report.Backend.Reachable = true
+	}()
+
+	go func() {
+		defer wg.Done()
+		ents, err := container.RewardVerifier.QueryUserEntitlements(ctx, map[string]string{})
+		if err != nil {
+			invErrs[0] = "entitlements: " + err.Error()
+			return
+		}
+		report.Inventory.Entitlements = len(ents)
+	}()
+
+	go func() {
+		defer wg.Done()
+		wallets, err := container.RewardVerifier.QueryUserWallets(ctx)
+		if err != nil {
+			invErrs[1] = "wallets: " + err.Error()
+			return
+		}
+		report.Inventory.Wallets = len(wallets)
+	}()
+
+	wg.Wait()
+
+	if report.Backend.Reachable {
+		report.Challenges = summarizeChallenges(challenges)
+	} else {
+		report.Challenges.Error = "backend unreachable"
+	}
+
+	for _, msg := range invErrs {
+		if msg == "" {
+			continue
+		}
+		if report.Inventory.Error != "" {
+			report.Inventory.Error += "; "
+		}
+		report.Inventory.Error += msg
+	}
+
+	return report
+}
+
+// summarizeChallenges counts challenges and goals by state
+func summarizeChallenges(challenges []api.Challenge) output.ChallengeSummary {
+	summary := output.ChallengeSummary{Challenges: len(challenges)}
+	for _, c := range challenges {
+		summary.Goals += len(c.Goals)
+		summary.Completed += c.CompletedGoals()
+		for _, g := range c.Goals {
+			if g.Status == "claimed" {
+				summary.Claimed++
+			}
+			if g.IsActive {
+				summary.Active++
+			}
+		}
+	}
+	return summary
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+)
+
+func statusContainer(apiClient *api.MockAPIClient, verifier *ags.MockRewardVerifier) *app.Container {
+	return &app.Container{
+		AuthProvider:   auth.NewMockAuthProvider("test-user", "demo"),
+		APIClient:      apiClient,
+		RewardVerifier: verifier,
+		UserID:         "test-user",
+		Namespace:      "demo",
+	}
+}
+
+func TestCollectStatus_AllSections(t *testing.T) {
+	apiClient := api.NewMockAPIClient(claimHistoryFixture())
+	report := collectStatus(context.Background(), statusContainer(apiClient, ags.NewMockRewardVerifier()))
+
+	if !report.OK() {
+		t.Fatalf("Expected all sections OK, got %+v", report)
+	}
+	if !report.Auth.Valid || report.Auth.ExpiresAt == nil || report.Auth.UserID != "test-user" {
+		t.Errorf("Expected valid auth with expiry, got %+v", report.Auth)
+	}
+	if !report.Backend.Reachable {
+		t.Errorf("Expected backend reachable, got %+v", report.Backend)
+	}
+
+	expected := output.ChallengeSummary{Challenges: 2, Goals: 6, Completed: 5, Claimed: 4}
+	if report.Challenges != expected {
+		t.Errorf("Expected %+v, got %+v", expected, report.Challenges)
+	}
+	if report.Inventory.Entitlements == 0 || report.Inventory.Wallets == 0 {
+		t.Errorf("Expected entitlements and wallets, got %+v", report.Inventory)
+	}
+
+	for _, format := range []string{"text", "table"} {
+		formatter, err := output.NewFormatter(format)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		formatted, err := formatter.FormatStatus(report)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for _, section := range []string{"auth", "backend", "challenges", "inventory"} {
+			if !strings.Contains(strings.ToLower(formatted), section) {
+				t.Errorf("Expected %s output to include %s, got:\n%s", format, section, formatted)
+			}
+		}
+		if !strings.Contains(formatted, "5/6 goals completed") {
+			t.Errorf("Expected %s output to include completion summary, got:\n%s", format, formatted)
+		}
+	}
+}
+
+func TestCollectStatus_FailingSubQuery(t *testing.T) {
+	apiClient := api.NewMockAPIClient(claimHistoryFixture())
+	apiClient.Error = errors.New("connection refused")
+	report := collectStatus(context.Background(), statusContainer(apiClient, ags.NewMockRewardVerifier()))
+
+	if report.OK() {
+		t.Fatal("Expected report not OK when the backend fails")
+	}
+	if report.Backend.Reachable || !strings.Contains(report.Backend.Error, "connection refused") {
+		t.Errorf("Expected backend error, got %+v", report.Backend)
+	}
+	if report.Challenges.Error == "" {
+		t.Error("Expected challenge section to report the backend failure")
+	}
+
+	// Other sections are still filled
+	if !report.Auth.Valid {
+		t.Errorf("Expected auth to be unaffected, got %+v", report.Auth)
+	}
+	if report.Inventory.Error != "" || report.Inventory.Wallets == 0 {
+		t.Errorf("Expected inventory to be unaffected, got %+v", report.Inventory)
+	}
+
+	formatted, err := (&output.JSONFormatter{}).FormatStatus(report)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var decoded map[string]map[string]interface{}
+	if err := json.Unmarshal([]byte(formatted), &decoded); err != nil {
+		t.Fatalf("Expected valid JSON, got %v:\n%s", err, formatted)
+	}
+	if decoded["backend"]["reachable"] != false || decoded["inventory"]["wallets"] == float64(0) {
+		t.Errorf("Unexpected JSON sections: %v", decoded)
+	}
+}
+
+func TestCollectStatus_InventoryFailure(t *testing.T) {
+	verifier := ags.NewMockRewardVerifier()
+	verifier.Error = errors.New("platform unavailable")
+	report := collectStatus(context.Background(), statusContainer(api.NewMockAPIClient(nil), verifier))
+
+	if !report.Backend.Reachable {
+		t.Errorf("Expected backend reachable, got %+v", report.Backend)
+	}
+	if !strings.Contains(report.Inventory.Error, "entitlements: platform unavailable") ||
+		!strings.Contains(report.Inventory.Error, "wallets: platform unavailable") {
+		t.Errorf("Expected both inventory errors, got %q", report.Inventory.Error)
+	}
+}
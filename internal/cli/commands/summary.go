@@ -0,0 +1,108 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// NewSummaryCommand creates the summary command
+func NewSummaryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Show how complete the user's challenges are overall",
+		Long: `Aggregate the user's challenges into completion stats: the completed share
+of goals per challenge and overall, goal counts by status, and the rewards of
+completed goals that are still waiting to be claimed.
+
+Completed and claimed goals both count as complete.`,
+		Example: `  challenge-demo summary
+  challenge-demo summary --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// List challenges
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			challenges, err := container.APIClient.ListChallenges(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list challenges: %w", err)
+			}
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			formatted, err := formatter.FormatCompletionSummary(summarizeCompletion(challenges))
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			return cli.PrintResult(cmd, formatted)
+		},
+	}
+
+	return cmd
+}
+
+// summarizeCompletion tallies goal completion per challenge and overall
+// Pending rewards are summed per reward type and ID, in the order they first appear.
+func summarizeCompletion(challenges []api.Challenge) *output.CompletionSummary {
+	summary := &output.CompletionSummary{
+		Challenges:     make([]output.ChallengeCompletion, 0, len(challenges)),
+		GoalsByStatus:  make(map[string]int, len(output.GoalStatuses)),
+		PendingRewards: []api.Reward{},
+	}
+	for _, status := range output.GoalStatuses {
+		summary.GoalsByStatus[status] = 0
+	}
+
+	pending := make(map[[2]string]int) // Reward type and ID → index in PendingRewards
+	for i := range challenges {
+		c := &challenges[i]
+		completion := output.ChallengeCompletion{
+			ChallengeID: c.ID,
+			Name:        c.Name,
+			Goals:       len(c.Goals),
+			Completed:   c.CompletedGoals(),
+		}
+
+		for _, g := range c.Goals {
+			summary.GoalsByStatus[g.Status]++
+			if g.Status != "completed" {
+				continue
+			}
+
+			completion.Claimable++
+			key := [2]string{g.Reward.Type, g.Reward.RewardID}
+			if idx, ok := pending[key]; ok {
+				summary.PendingRewards[idx].Quantity += g.Reward.Quantity
+			} else {
+				pending[key] = len(summary.PendingRewards)
+				summary.PendingRewards = append(summary.PendingRewards, g.Reward)
+			}
+		}
+
+		completion.CompletionPct = output.ProgressPct(int32(completion.Completed), int32(completion.Goals))
+		summary.Challenges = append(summary.Challenges, completion)
+		summary.Goals += completion.Goals
+		summary.Completed += completion.Completed
+		summary.Claimable += completion.Claimable
+	}
+	summary.CompletionPct = output.ProgressPct(int32(summary.Completed), int32(summary.Goals))
+
+	return summary
+}
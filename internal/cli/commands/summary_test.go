@@ -0,0 +1,101 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+)
+
+func TestSummarizeCompletion(t *testing.T) {
+	challenges := claimHistoryFixture()
+	// A second completed sword goal sums into the same pending reward
+	challenges[1].Goals = append(challenges[1].Goals, api.Goal{ID: "duel", Name: "Duel", Status: "completed",
+		Reward: api.Reward{Type: "ITEM", RewardID: "sword", Quantity: 2}})
+
+	summary := summarizeCompletion(challenges)
+
+	if summary.Goals != 7 || summary.Completed != 6 || summary.CompletionPct != 85 {
+		t.Errorf("Expected 6/7 goals (85%%), got %d/%d (%d%%)", summary.Completed, summary.Goals, summary.CompletionPct)
+	}
+	expectedStatus := map[string]int{"not_started": 0, "in_progress": 1, "completed": 2, "claimed": 4}
+	for status, count := range expectedStatus {
+		if summary.GoalsByStatus[status] != count {
+			t.Errorf("Expected %d %s goals, got %d", count, status, summary.GoalsByStatus[status])
+		}
+	}
+
+	if len(summary.Challenges) != 2 {
+		t.Fatalf("Expected 2 challenges, got %d", len(summary.Challenges))
+	}
+	daily, winter := summary.Challenges[0], summary.Challenges[1]
+	if daily.Completed != 3 || daily.Goals != 3 || daily.CompletionPct != 100 || daily.Claimable != 1 {
+		t.Errorf("Expected daily 3/3 (100%%) with 1 claimable, got %+v", daily)
+	}
+	if winter.Completed != 3 || winter.Goals != 4 || winter.CompletionPct != 75 || winter.Claimable != 1 {
+		t.Errorf("Expected winter 3/4 (75%%) with 1 claimable, got %+v", winter)
+	}
+
+	if summary.Claimable != 2 || len(summary.PendingRewards) != 1 || summary.PendingRewards[0].Quantity != 3 {
+		t.Errorf("Expected 2 claimable goals pending 3 swords, got %d, %+v", summary.Claimable, summary.PendingRewards)
+	}
+	// Summing must not modify the challenges' own rewards
+	if challenges[0].Goals[1].Reward.Quantity != 1 {
+		t.Errorf("Expected the fixture reward unchanged, got %+v", challenges[0].Goals[1].Reward)
+	}
+}
+
+func TestSummarizeCompletion_NoChallenges(t *testing.T) {
+	summary := summarizeCompletion(nil)
+
+	if summary.Goals != 0 || summary.CompletionPct != 0 {
+		t.Errorf("Expected an empty summary, got %+v", summary)
+	}
+
+	// JSON keeps empty lists and zero counts rather than null
+	formatter, err := output.NewFormatter("json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	formatted, err := formatter.FormatCompletionSummary(summary)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(formatted), &decoded); err != nil {
+		t.Fatalf("Expected valid JSON, got %v:\n%s", err, formatted)
+	}
+	if decoded["challenges"] == nil || decoded["pending_rewards"] == nil {
+		t.Errorf("Expected empty lists, got:\n%s", formatted)
+	}
+	if byStatus, ok := decoded["goals_by_status"].(map[string]interface{}); !ok || len(byStatus) != len(output.GoalStatuses) {
+		t.Errorf("Expected every goal status counted, got:\n%s", formatted)
+	}
+}
+
+func TestFormatCompletionSummary(t *testing.T) {
+	summary := summarizeCompletion(claimHistoryFixture())
+
+	for _, format := range []string{"text", "table", "markdown"} {
+		formatter, err := output.NewFormatter(format)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		formatted, err := formatter.FormatCompletionSummary(summary)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for _, want := range []string{"Daily Quests", "Winter Event", "5/6", "83%", "ITEM sword"} {
+			if !strings.Contains(formatted, want) {
+				t.Errorf("%s: expected %q in output, got:\n%s", format, want, formatted)
+			}
+		}
+	}
+}
@@ -7,13 +7,60 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/events"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
 	"github.com/spf13/cobra"
 )
 
+// progressPollInterval is how often --wait-for-progress re-checks the goal after an event
+const progressPollInterval = 500 * time.Millisecond
+
+// progressWait holds the --wait-for-progress flags shared by the trigger-event subcommands
+type progressWait struct {
+	goalID      string
+	challengeID string // Optional; the goal is searched across all challenges when empty
+	timeout     time.Duration
+}
+
+// addFlags registers the --wait-for-progress flags on cmd
+func (w *progressWait) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&w.goalID, "wait-for-progress", "", "After sending, wait until this goal's progress changes and report the delta")
+	cmd.Flags().StringVar(&w.challengeID, "wait-challenge", "", "Challenge of the --wait-for-progress goal (default: search all challenges)")
+	cmd.Flags().DurationVar(&w.timeout, "wait-timeout", 30*time.Second, "How long --wait-for-progress waits for the progress to change")
+}
+
+// before snapshots the watched goal so the change can be measured; it returns nil when no goal is watched
+func (w *progressWait) before(ctx context.Context, apiClient api.APIClient) (*output.ProgressDelta, error) {
+	if w.goalID == "" {
+		return nil, nil
+	}
+
+	progress, err := snapshotProgress(ctx, apiClient, w.challengeID, w.goalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read goal progress before event: %w", err)
+	}
+	return progress, nil
+}
+
+// after waits until the snapshotted goal changes; it does nothing without a snapshot
+func (w *progressWait) after(ctx context.Context, apiClient api.APIClient, progress *output.ProgressDelta) error {
+	if progress == nil {
+		return nil
+	}
+
+	if err := awaitProgress(ctx, apiClient, progress, progressPollInterval, w.timeout); err != nil {
+		return fmt.Errorf("goal progress did not change: %w", err)
+	}
+	return nil
+}
+
 // NewTriggerCommand creates the trigger-event command
 func NewTriggerCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,62 +72,28 @@ func NewTriggerCommand() *cobra.Command {
 	// Add subcommands
 	cmd.AddCommand(newTriggerLoginCommand())
 	cmd.AddCommand(newTriggerStatUpdateCommand())
+	cmd.AddCommand(newTriggerRunCommand())
 
 	return cmd
 }
 
 func newTriggerLoginCommand() *cobra.Command {
+	var wait progressWait
+
 	cmd := &cobra.Command{
 		Use:   "login",
 		Short: "Trigger user login event",
 		Long:  "Trigger a user login event to update login-based challenge progress.",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Get format flag
-			format, _ := cmd.Flags().GetString("format")
-
-			// Create container
-			container := cli.GetContainerFromFlags(cmd)
-
-			// Get user ID and namespace (use container's values)
-			userID := container.UserID
-			namespace := container.Namespace
-
-			// Trigger event
-			ctx := context.Background()
-			start := time.Now()
-			err := container.EventTrigger.TriggerLogin(ctx, userID, namespace)
-			duration := time.Since(start)
-
-			// Format result
-			formatter := output.NewFormatter(format)
-			result := &output.EventResult{
-				Event:      "login",
-				UserID:     userID,
-				Timestamp:  time.Now(),
-				Status:     "success",
-				DurationMs: duration.Milliseconds(),
-				Error:      err,
-			}
-
-			if err != nil {
-				result.Status = "error"
-			}
-
-			formattedResult, formatErr := formatter.FormatEventResult(result)
-			if formatErr != nil {
-				return fmt.Errorf("failed to format output: %w", formatErr)
-			}
-
-			fmt.Print(formattedResult)
-
-			if err != nil {
-				return fmt.Errorf("event trigger failed: %w", err)
-			}
-
-			return nil
+			result := &output.EventResult{Event: "login"}
+			return runTriggerEvent(cmd, &wait, result, func(ctx context.Context, trigger events.EventTrigger, userID, namespace string) error {
+				return trigger.TriggerLogin(ctx, userID, namespace)
+			})
 		},
 	}
 
+	wait.addFlags(cmd)
+
 	return cmd
 }
 
@@ -88,69 +101,219 @@ func newTriggerStatUpdateCommand() *cobra.Command {
 	var statCode string
 	var value int
 	var inc int
+	var increment bool
+	var totalsFile string
+	var wait progressWait
 
 	cmd := &cobra.Command{
 		Use:   "stat-update",
 		Short: "Trigger statistic update event",
-		Long:  "Trigger a statistic update event with custom stat code and value.",
+		Long: `Trigger a statistic update event with custom stat code and value.
+
+By default --value is the new absolute stat value, exactly as carried by the AGS
+StatItemUpdated event. With --increment, --value is instead added to a running
+total tracked locally in --totals-file, and the summed absolute value is sent
+(with --inc set to the increment). The local total is NOT read from the Statistic
+service: it starts at 0 and only reflects values sent by this tool.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if statCode == "" {
-				return fmt.Errorf("--stat-code is required")
+				return cli.UsageErrorf("--stat-code is required")
 			}
 
-			// Get format flag
-			format, _ := cmd.Flags().GetString("format")
-
-			// Create container
-			container := cli.GetContainerFromFlags(cmd)
-
-			// Get user ID and namespace (use container's values)
-			userID := container.UserID
-			namespace := container.Namespace
-
-			// Trigger event
-			ctx := context.Background()
-			start := time.Now()
-			err := container.EventTrigger.TriggerStatUpdate(ctx, userID, namespace, statCode, value, inc)
-			duration := time.Since(start)
-
-			// Format result
-			formatter := output.NewFormatter(format)
-			result := &output.EventResult{
-				Event:      "stat-update",
-				UserID:     userID,
-				StatCode:   statCode,
-				Value:      value,
-				Timestamp:  time.Now(),
-				Status:     "success",
-				DurationMs: duration.Milliseconds(),
-				Error:      err,
+			if increment && cmd.Flags().Changed("inc") {
+				return cli.UsageErrorf("--inc cannot be combined with --increment (the increment is sent as inc)")
 			}
 
+			tracker, err := events.NewStatTracker(totalsFile)
 			if err != nil {
-				result.Status = "error"
+				return err
 			}
 
-			formattedResult, formatErr := formatter.FormatEventResult(result)
-			if formatErr != nil {
-				return fmt.Errorf("failed to format output: %w", formatErr)
-			}
+			result := &output.EventResult{Event: "stat-update", StatCode: statCode, Value: value}
+			return runTriggerEvent(cmd, &wait, result, func(ctx context.Context, trigger events.EventTrigger, userID, namespace string) error {
+				// Resolve the absolute value to send
+				sendInc := inc
+				if increment {
+					total, err := tracker.Increment(userID, namespace, statCode, value)
+					if err != nil {
+						return cli.UsageErrorf("invalid increment: %w", err)
+					}
+					result.Value, sendInc = total, value
+				} else {
+					tracker.Set(userID, namespace, statCode, value)
+				}
 
-			fmt.Print(formattedResult)
+				if err := trigger.TriggerStatUpdate(ctx, userID, namespace, statCode, result.Value, sendInc); err != nil {
+					return err
+				}
 
-			if err != nil {
-				return fmt.Errorf("event trigger failed: %w", err)
-			}
-
-			return nil
+				// Persist the new total only if the event was delivered (absolute mode only updates existing files)
+				if increment || fileExists(totalsFile) {
+					if saveErr := tracker.Save(); saveErr != nil {
+						fmt.Fprintf(os.Stderr, "Warning: %v\n", saveErr)
+					}
+				}
+				return nil
+			})
 		},
 	}
 
+	wait.addFlags(cmd)
+
 	cmd.Flags().StringVar(&statCode, "stat-code", "", "Statistic code (required)")
-	cmd.Flags().IntVar(&value, "value", 0, "New statistic value (required)")
+	cmd.Flags().IntVar(&value, "value", 0, "New statistic value, or amount to add with --increment (required)")
 	cmd.Flags().IntVar(&inc, "inc", 0, "Increment value (for baseline calculation in relative progress mode)")
+	cmd.Flags().BoolVar(&increment, "increment", false, "Treat --value as an amount to add to the locally tracked total")
+	cmd.Flags().StringVar(&totalsFile, "totals-file", events.DefaultStatTotalsPath(), "File used to track running stat totals for --increment")
 	_ = cmd.MarkFlagRequired("stat-code")
 	_ = cmd.MarkFlagRequired("value")
 
 	return cmd
 }
+
+// runTriggerEvent sends an event, optionally waits for goal progress, and prints the result
+// result holds the event-specific fields; the user, timing, status, and progress are filled in here.
+func runTriggerEvent(cmd *cobra.Command, wait *progressWait, result *output.EventResult, send func(ctx context.Context, trigger events.EventTrigger, userID, namespace string) error) error {
+	// Get format flag
+	format, _ := cmd.Flags().GetString("format")
+	formatter, err := output.NewFormatter(format)
+	if err != nil {
+		return &cli.UsageError{Err: err}
+	}
+
+	// Create container
+	container := cli.GetContainerFromFlags(cmd)
+	if container.EventTrigger == nil {
+		return fmt.Errorf("event handler not connected (check --event-handler-url)")
+	}
+
+	ctx, stop := cli.CommandContext(cmd)
+	defer stop()
+
+	// Snapshot the watched goal before the event
+	result.Progress, err = wait.before(ctx, container.APIClient)
+	if err != nil {
+		return err
+	}
+
+	// Trigger event
+	start := time.Now()
+	err = send(ctx, container.EventTrigger, container.UserID, container.Namespace)
+	duration := time.Since(start)
+
+	if err == nil {
+		api.EventSent(container.APIClient)
+	}
+
+	var waitErr error
+	if err == nil {
+		waitErr = wait.after(ctx, container.APIClient, result.Progress)
+	}
+
+	// Format result
+	result.UserID = container.UserID
+	result.Timestamp = time.Now()
+	result.Status = "success"
+	result.DurationMs = duration.Milliseconds()
+	result.Error = err
+	if err != nil {
+		result.Status = "error"
+	}
+
+	formattedResult, formatErr := formatter.FormatEventResult(result)
+	if formatErr != nil {
+		return fmt.Errorf("failed to format output: %w", formatErr)
+	}
+
+	if printErr := cli.PrintResult(cmd, formattedResult); printErr != nil {
+		return printErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("event trigger failed: %w", err)
+	}
+	if waitErr != nil {
+		return waitErr
+	}
+
+	return nil
+}
+
+// fileExists reports whether path names an existing file
+func fileExists(path string) bool {
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// snapshotProgress records the goal's current progress before an event
+// With an empty challengeID, the goal is looked up across all challenges and must be unique.
+func snapshotProgress(ctx context.Context, apiClient api.APIClient, challengeID, goalID string) (*output.ProgressDelta, error) {
+	if challengeID == "" {
+		challenges, err := apiClient.ListChallenges(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list challenges: %w", err)
+		}
+
+		matches := []string{}
+		for i := range challenges {
+			if findGoal(&challenges[i], goalID) != nil {
+				matches = append(matches, challenges[i].ID)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("goal %s not found in any challenge", goalID)
+		case 1:
+			challengeID = matches[0]
+		default:
+			return nil, fmt.Errorf("goal %s is in several challenges (%s); use --wait-challenge", goalID, strings.Join(matches, ", "))
+		}
+	}
+
+	challenge, err := apiClient.GetChallenge(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	goal := findGoal(challenge, goalID)
+	if goal == nil {
+		return nil, fmt.Errorf("goal %s not found in challenge %s", goalID, challengeID)
+	}
+
+	return &output.ProgressDelta{
+		ChallengeID: challengeID,
+		GoalID:      goalID,
+		Before:      goal.Progress,
+		After:       goal.Progress,
+	}, nil
+}
+
+// awaitProgress polls the challenge until the goal's progress differs from the snapshot
+// The delta's After, Delta, and Changed fields are updated with the last observation. Each
+// poll bypasses --cache-ttl, since the event changes progress behind the cache's back.
+func awaitProgress(ctx context.Context, apiClient api.APIClient, progress *output.ProgressDelta, interval, timeout time.Duration) error {
+	err := retry.Poll(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		api.InvalidateCache(apiClient)
+		challenge, err := apiClient.GetChallenge(ctx, progress.ChallengeID)
+		if err != nil {
+			return false, err
+		}
+		goal := findGoal(challenge, progress.GoalID)
+		if goal == nil {
+			return false, fmt.Errorf("goal %s not found in challenge %s", progress.GoalID, progress.ChallengeID)
+		}
+
+		progress.After = goal.Progress
+		progress.Delta = progress.After - progress.Before
+		progress.Changed = progress.Delta != 0
+		return progress.Changed, nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s/%s stayed at %d: %w", progress.ChallengeID, progress.GoalID, progress.Before, err)
+	}
+
+	return nil
+}
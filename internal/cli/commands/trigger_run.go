@@ -0,0 +1,202 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/scenario"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// eventSequence is a scripted list of events for trigger-event run
+//
+// Example:
+//
+//	delay: 500ms             # Default pause after each event (optional)
+//	continue_on_error: true  # Keep triggering events after a failure (optional)
+//	events:
+//	  - type: login
+//	  - type: stat-update
+//	    stat: kills
+//	    value: 10
+//	    delay: 2s            # Overrides the default for this event
+type eventSequence struct {
+	Delay           time.Duration   `yaml:"delay"`
+	ContinueOnError bool            `yaml:"continue_on_error"`
+	Events          []sequenceEvent `yaml:"events"`
+}
+
+// sequenceEvent is one event of a sequence
+type sequenceEvent struct {
+	Type  string         `yaml:"type"` // login or stat-update
+	Stat  string         `yaml:"stat"`
+	Value int            `yaml:"value"`
+	Delay *time.Duration `yaml:"delay"` // Pause before the next event; defaults to the sequence delay
+}
+
+// newTriggerRunCommand creates the trigger-event run command
+func newTriggerRunCommand() *cobra.Command {
+	var (
+		dryRun          bool
+		continueOnError bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "run <file.yaml>",
+		Short: "Replay a scripted sequence of events",
+		Long: `Read a YAML sequence of events and trigger them in order, printing a result
+per step. Each event is a login or stat-update, optionally followed by a delay:
+
+  delay: 500ms
+  continue_on_error: true
+  events:
+    - type: login
+    - type: stat-update
+      stat: kills
+      value: 10
+      delay: 2s
+
+Use --dry-run to validate the file and list its steps without triggering anything.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+
+			// Load and validate sequence before touching anything
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", args[0], err)
+			}
+
+			sequence, err := parseEventSequence(data)
+			if err != nil {
+				return fmt.Errorf("invalid event sequence %s: %w", args[0], err)
+			}
+			sc := sequenceScenario(sequence)
+			if cmd.Flags().Changed("continue-on-error") {
+				sc.ContinueOnError = continueOnError
+			}
+
+			var result *output.BulkResult
+			if dryRun {
+				result = dryRunBulkResult("trigger-event run (dry run)", sc)
+			} else {
+				// Create container
+				container := cli.GetContainerFromFlags(cmd)
+
+				// Run steps
+				ctx, stop := cli.CommandContext(cmd)
+				defer stop()
+				scenarioResult, err := sc.Run(ctx, scenario.Deps{
+					APIClient:    container.APIClient,
+					EventTrigger: container.EventTrigger,
+					UserID:       container.UserID,
+					Namespace:    container.Namespace,
+				})
+				if scenarioResult == nil {
+					return err
+				}
+				result = scenarioBulkResult("trigger-event run", scenarioResult)
+			}
+
+			// Format output
+			formatted, err := formatter.FormatBulkResult(result)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			if err := cli.PrintResult(cmd, formatted); err != nil {
+				return err
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("%d of %d event steps failed", result.Failed, result.Total)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the file and list its steps without triggering events")
+	cmd.Flags().BoolVar(&continueOnError, "continue-on-error", false, "Keep triggering events after a failure (overrides the sequence file)")
+
+	return cmd
+}
+
+// parseEventSequence decodes and validates a YAML event sequence
+func parseEventSequence(data []byte) (*eventSequence, error) {
+	var sequence eventSequence
+	if err := yaml.UnmarshalStrict(data, &sequence); err != nil {
+		return nil, err
+	}
+
+	if len(sequence.Events) == 0 {
+		return nil, fmt.Errorf("sequence has no events")
+	}
+	if sequence.Delay < 0 {
+		return nil, fmt.Errorf("delay cannot be negative")
+	}
+
+	for i, e := range sequence.Events {
+		switch e.Type {
+		case scenario.EventLogin:
+		case scenario.EventStatUpdate:
+			if e.Stat == "" {
+				return nil, fmt.Errorf("events[%d]: stat is required for stat-update", i)
+			}
+		default:
+			return nil, fmt.Errorf("events[%d]: unknown event type %q (expected login or stat-update)", i, e.Type)
+		}
+		if e.Delay != nil && *e.Delay < 0 {
+			return nil, fmt.Errorf("events[%d]: delay cannot be negative", i)
+		}
+	}
+
+	return &sequence, nil
+}
+
+// sequenceScenario turns a sequence into trigger steps, with a wait step after each delayed event
+// No wait follows the last event, since nothing comes after it.
+func sequenceScenario(sequence *eventSequence) *scenario.Scenario {
+	sc := &scenario.Scenario{Name: "trigger-event run", ContinueOnError: sequence.ContinueOnError, Steps: []scenario.Step{}}
+
+	for i, e := range sequence.Events {
+		sc.Steps = append(sc.Steps, scenario.Step{
+			Type:  scenario.StepTrigger,
+			Event: e.Type,
+			Stat:  e.Stat,
+			Value: e.Value,
+		})
+
+		delay := sequence.Delay
+		if e.Delay != nil {
+			delay = *e.Delay
+		}
+		if delay > 0 && i < len(sequence.Events)-1 {
+			sc.Steps = append(sc.Steps, scenario.Step{Type: scenario.StepWait, Duration: delay})
+		}
+	}
+
+	return sc
+}
+
+// dryRunBulkResult lists a scenario's steps as skipped without running them
+func dryRunBulkResult(operation string, sc *scenario.Scenario) *output.BulkResult {
+	result := &output.BulkResult{Operation: operation, Items: []output.BulkItemResult{}}
+	for _, step := range sc.Steps {
+		result.Add(output.BulkItemResult{ID: step.String(), Status: scenario.StatusSkipped})
+	}
+	return result
+}
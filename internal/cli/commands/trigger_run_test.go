@@ -0,0 +1,138 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/scenario"
+)
+
+const sequenceYAML = `
+delay: 1ms
+events:
+  - type: login
+  - type: stat-update
+    stat: kills
+    value: 5
+    delay: 0s
+  - type: stat-update
+    stat: kills
+    value: 10
+`
+
+func TestSequenceScenario_RunsEventsInOrder(t *testing.T) {
+	sequence, err := parseEventSequence([]byte(sequenceYAML))
+	if err != nil {
+		t.Fatalf("Unexpected parse error: %v", err)
+	}
+
+	trigger := &recordingTrigger{}
+	deps := scenario.Deps{APIClient: api.NewMockAPIClient(nil), EventTrigger: trigger, UserID: "user-1", Namespace: "demo"}
+	scenarioResult, err := sequenceScenario(sequence).Run(context.Background(), deps)
+	if err != nil {
+		t.Fatalf("Unexpected run error: %v", err)
+	}
+	result := scenarioBulkResult("trigger-event run", scenarioResult)
+
+	// The default delay follows login only: the second event overrides it and the last needs none
+	expectedIDs := []string{
+		"trigger login",
+		"wait 1ms",
+		"trigger stat-update kills=5",
+		"trigger stat-update kills=10",
+	}
+	if result.Total != len(expectedIDs) || result.Succeeded != len(expectedIDs) {
+		t.Fatalf("Expected %d successful steps, got %d/%d", len(expectedIDs), result.Succeeded, result.Total)
+	}
+	for i, id := range expectedIDs {
+		if result.Items[i].ID != id {
+			t.Errorf("Step %d: expected '%s', got '%s'", i, id, result.Items[i].ID)
+		}
+	}
+
+	expectedCalls := "login,stat-update kills=5,stat-update kills=10"
+	if got := strings.Join(trigger.calls, ","); got != expectedCalls {
+		t.Errorf("Expected calls %s, got %s", expectedCalls, got)
+	}
+}
+
+func TestSequenceScenario_StopsAfterFailure(t *testing.T) {
+	sequence, err := parseEventSequence([]byte(sequenceYAML))
+	if err != nil {
+		t.Fatalf("Unexpected parse error: %v", err)
+	}
+
+	trigger := &recordingTrigger{err: errors.New("connection refused")}
+	deps := scenario.Deps{APIClient: api.NewMockAPIClient(nil), EventTrigger: trigger, UserID: "user-1", Namespace: "demo"}
+	scenarioResult, _ := sequenceScenario(sequence).Run(context.Background(), deps)
+	if scenarioResult == nil {
+		t.Fatal("Expected a result")
+	}
+
+	if scenarioResult.Failed != 1 || scenarioResult.Skipped != 3 {
+		t.Errorf("Expected 1 failed and 3 skipped, got %d/%d", scenarioResult.Failed, scenarioResult.Skipped)
+	}
+	if len(trigger.calls) != 1 {
+		t.Errorf("Expected steps after the failure not to run, got calls %v", trigger.calls)
+	}
+}
+
+func TestSequenceScenario_ContinueOnError(t *testing.T) {
+	sequence, err := parseEventSequence([]byte("continue_on_error: true\n" + sequenceYAML))
+	if err != nil {
+		t.Fatalf("Unexpected parse error: %v", err)
+	}
+
+	trigger := &recordingTrigger{err: errors.New("connection refused")}
+	deps := scenario.Deps{APIClient: api.NewMockAPIClient(nil), EventTrigger: trigger, UserID: "user-1", Namespace: "demo"}
+	scenarioResult, _ := sequenceScenario(sequence).Run(context.Background(), deps)
+	if scenarioResult == nil {
+		t.Fatal("Expected a result")
+	}
+
+	if len(trigger.calls) != 3 {
+		t.Errorf("Expected every event to be triggered, got calls %v", trigger.calls)
+	}
+}
+
+func TestParseEventSequence_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{"no events", "delay: 1s\n", "no events"},
+		{"missing stat code", "events:\n  - type: stat-update\n    value: 1\n", "stat is required"},
+		{"unknown type", "events:\n  - type: logout\n", "unknown event type"},
+		{"negative delay", "events:\n  - type: login\n    delay: -1s\n", "delay cannot be negative"},
+		{"unknown field", "events:\n  - type: login\n    statCode: kills\n", "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseEventSequence([]byte(tt.yaml))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDryRunBulkResult(t *testing.T) {
+	sequence, err := parseEventSequence([]byte(sequenceYAML))
+	if err != nil {
+		t.Fatalf("Unexpected parse error: %v", err)
+	}
+
+	result := dryRunBulkResult("trigger-event run (dry run)", sequenceScenario(sequence))
+	if result.Total != 4 || result.Skipped != 4 || result.Failed != 0 {
+		t.Errorf("Expected 4 skipped steps, got %+v", result)
+	}
+}
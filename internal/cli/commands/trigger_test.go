@@ -0,0 +1,192 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
+	"github.com/spf13/cobra"
+)
+
+// progressingTrigger is an EventTrigger that applies stat updates to the mock backend's goal progress,
+// as the event handler would
+type progressingTrigger struct {
+	recordingTrigger
+	apiClient *api.MockAPIClient
+}
+
+func (p *progressingTrigger) TriggerStatUpdate(ctx context.Context, userID, namespace, statCode string, value, inc int) error {
+	_ = p.recordingTrigger.TriggerStatUpdate(ctx, userID, namespace, statCode, value, inc)
+	for i := range p.apiClient.Challenges {
+		for j := range p.apiClient.Challenges[i].Goals {
+			goal := &p.apiClient.Challenges[i].Goals[j]
+			if goal.Requirement.StatCode == statCode {
+				goal.Progress = int32(value)
+			}
+		}
+	}
+	return nil
+}
+
+func newProgressMockAPI() *api.MockAPIClient {
+	return api.NewMockAPIClient([]api.Challenge{
+		{ID: "daily", Goals: []api.Goal{
+			{ID: "kill-10", Progress: 3, Requirement: api.Requirement{StatCode: "kills", TargetValue: 10}},
+			{ID: "login-once", Progress: 0, Requirement: api.Requirement{StatCode: "login_count", TargetValue: 1}},
+		}},
+		{ID: "weekly", Goals: []api.Goal{
+			{ID: "login-once", Progress: 0},
+		}},
+	})
+}
+
+func TestWaitForProgress_ReportsDelta(t *testing.T) {
+	ctx := context.Background()
+	apiClient := newProgressMockAPI()
+	trigger := &progressingTrigger{apiClient: apiClient}
+
+	progress, err := snapshotProgress(ctx, apiClient, "", "kill-10")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if progress.ChallengeID != "daily" || progress.Before != 3 {
+		t.Fatalf("Expected daily/kill-10 at 3, got %+v", progress)
+	}
+
+	if err := trigger.TriggerStatUpdate(ctx, "user", "ns", "kills", 8, 5); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := awaitProgress(ctx, apiClient, progress, time.Millisecond, time.Second); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := output.ProgressDelta{ChallengeID: "daily", GoalID: "kill-10", Before: 3, After: 8, Delta: 5, Changed: true}
+	if *progress != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *progress)
+	}
+
+	text, err := (&output.TextFormatter{}).FormatEventResult(&output.EventResult{Event: "stat-update", Progress: progress})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(text, "Progress: daily/kill-10 3 -> 8 (+5)") {
+		t.Errorf("Expected progress line in text output, got:\n%s", text)
+	}
+}
+
+func TestWaitForProgress_WithCache(t *testing.T) {
+	ctx := context.Background()
+	backend := newProgressMockAPI()
+	trigger := &progressingTrigger{apiClient: backend}
+
+	// --cache-ttl far longer than the wait: every poll would hit the snapshot's cached challenge
+	apiClient := api.NewCachingAPIClient(backend, time.Hour)
+
+	progress, err := snapshotProgress(ctx, apiClient, "daily", "kill-10")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := trigger.TriggerStatUpdate(ctx, "user", "ns", "kills", 8, 5); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := awaitProgress(ctx, apiClient, progress, time.Millisecond, 50*time.Millisecond); err != nil {
+		t.Fatalf("Expected the wait to see the new progress through the cache, got %v", err)
+	}
+	if progress.After != 8 || progress.Delta != 5 {
+		t.Errorf("Expected 3 -> 8, got %+v", progress)
+	}
+}
+
+func TestWaitForProgress_Timeout(t *testing.T) {
+	ctx := context.Background()
+	apiClient := newProgressMockAPI()
+
+	progress, err := snapshotProgress(ctx, apiClient, "daily", "kill-10")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The event handler never updates the goal
+	err = awaitProgress(ctx, apiClient, progress, 5*time.Millisecond, 20*time.Millisecond)
+	if !errors.Is(err, retry.ErrPollTimeout) {
+		t.Fatalf("Expected poll timeout, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "daily/kill-10 stayed at 3") {
+		t.Errorf("Expected error naming the goal, got %q", err.Error())
+	}
+	if progress.Changed || progress.Delta != 0 || progress.After != 3 {
+		t.Errorf("Expected unchanged progress, got %+v", progress)
+	}
+}
+
+func TestSnapshotProgress_GoalLookup(t *testing.T) {
+	tests := []struct {
+		name        string
+		challengeID string
+		goalID      string
+		wantErr     string
+	}{
+		{name: "ambiguous goal", goalID: "login-once", wantErr: "several challenges (daily, weekly)"},
+		{name: "unknown goal", goalID: "missing", wantErr: "not found in any challenge"},
+		{name: "goal not in challenge", challengeID: "weekly", goalID: "kill-10", wantErr: "not found in challenge weekly"},
+		{name: "disambiguated", challengeID: "weekly", goalID: "login-once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := snapshotProgress(context.Background(), newProgressMockAPI(), tt.challengeID, tt.goalID)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestTriggerCommands_NoEventHandler(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"login", []string{"trigger-event", "login"}},
+		{"stat-update", []string{"trigger-event", "stat-update", "--stat-code", "kills", "--value", "5", "--totals-file", filepath.Join(t.TempDir(), "totals.json")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// No --event-handler-url, so the container has no EventTrigger
+			root := &cobra.Command{Use: "challenge-demo", SilenceUsage: true, SilenceErrors: true}
+			root.PersistentFlags().String("auth-mode", "mock", "")
+			root.PersistentFlags().String("user-id", "test-user", "")
+			root.PersistentFlags().String("namespace", "demo", "")
+			root.PersistentFlags().String("format", "json", "")
+			root.AddCommand(NewTriggerCommand())
+			root.SetArgs(tt.args)
+			root.SetOut(io.Discard)
+
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), "event handler not connected") {
+				t.Errorf("Expected an event handler error, got %v", err)
+			}
+		})
+	}
+}
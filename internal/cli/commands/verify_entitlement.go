@@ -22,7 +22,7 @@ func NewVerifyEntitlementCommand() *cobra.Command {
 		Long:  "Check if a specific item entitlement exists for the user in AGS Platform.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if itemID == "" {
-				return fmt.Errorf("--item-id is required")
+				return cli.UsageErrorf("--item-id is required")
 			}
 
 			// Get format flag
@@ -30,22 +30,26 @@ func NewVerifyEntitlementCommand() *cobra.Command {
 
 			// Create container
 			container := cli.GetContainerFromFlags(cmd)
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 
 			// Query entitlement
-			ent, err := container.RewardVerifier.GetUserEntitlement(itemID)
+			ent, err := container.RewardVerifier.GetUserEntitlement(ctx, itemID)
 			if err != nil {
 				return fmt.Errorf("failed to get entitlement: %w", err)
 			}
 
 			// Format output
-			formatter := output.NewFormatter(format)
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
 			result, err := formatter.FormatEntitlement(ent)
 			if err != nil {
 				return fmt.Errorf("failed to format output: %w", err)
 			}
 
-			fmt.Println(result)
-			return nil
+			return cli.PrintResult(cmd, result)
 		},
 	}
 
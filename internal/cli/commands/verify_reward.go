@@ -0,0 +1,127 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/junit"
+	"github.com/spf13/cobra"
+)
+
+// NewVerifyRewardCommand creates the verify-reward command
+func NewVerifyRewardCommand() *cobra.Command {
+	var reportJUnit string
+
+	cmd := &cobra.Command{
+		Use:   "verify-reward <challenge-id> <goal-id>",
+		Short: "Verify a goal's reward was granted",
+		Long: `Look up the goal's reward and check that it landed in AGS Platform.
+ITEM rewards are checked against the user's entitlement, WALLET rewards against
+the wallet balance. The command fails if the expected quantity is not present.`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeChallengeAndGoalID,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			challengeID := args[0]
+			goalID := args[1]
+
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// Look up the goal's reward
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			challenge, err := container.APIClient.GetChallenge(ctx, challengeID)
+			if err != nil {
+				return fmt.Errorf("failed to get challenge: %w", err)
+			}
+
+			goal := findGoal(challenge, goalID)
+			if goal == nil {
+				return fmt.Errorf("goal %s not found in challenge %s", goalID, challengeID)
+			}
+
+			// Check the matching verifier
+			result, err := verifyReward(ctx, container.RewardVerifier, goal.Reward)
+			if err != nil {
+				return err
+			}
+			result.ChallengeID = challengeID
+			result.GoalID = goalID
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			formatted, err := formatter.FormatVerifyRewardResult(result)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			if err := cli.PrintResult(cmd, formatted); err != nil {
+				return err
+			}
+
+			if reportJUnit != "" {
+				if err := verifyRewardSuite(result).WriteFile(reportJUnit); err != nil {
+					return err
+				}
+			}
+
+			if !result.Verified {
+				return fmt.Errorf("reward not verified: expected %d, found %d", result.ExpectedQuantity, result.ActualQuantity)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&reportJUnit, "report-junit", "", "Write a JUnit XML report to this file")
+
+	return cmd
+}
+
+// verifyRewardSuite reports a verification result as a single-case JUnit suite
+func verifyRewardSuite(result *output.VerifyRewardResult) *junit.Suite {
+	tc := junit.Case{Name: fmt.Sprintf("%s/%s %s %s", result.ChallengeID, result.GoalID, result.RewardType, result.RewardID)}
+	if !result.Verified {
+		tc.Failure = fmt.Sprintf("expected %d, found %d", result.ExpectedQuantity, result.ActualQuantity)
+		if result.Error != nil {
+			tc.Failure += fmt.Sprintf(" (%v)", result.Error)
+		}
+	}
+
+	return &junit.Suite{Name: "verify-reward", Cases: []junit.Case{tc}}
+}
+
+// verifyReward queries the verifier matching the reward type and compares quantities
+// A missing entitlement or wallet is reported as an actual quantity of 0.
+func verifyReward(ctx context.Context, verifier ags.RewardVerifier, reward api.Reward) (*output.VerifyRewardResult, error) {
+	result := &output.VerifyRewardResult{
+		RewardType:       reward.Type,
+		RewardID:         reward.RewardID,
+		ExpectedQuantity: int64(reward.Quantity),
+	}
+
+	quantity, err := ags.GrantedQuantity(ctx, verifier, reward.Type, reward.RewardID)
+	if errors.Is(err, ags.ErrUnsupportedRewardType) {
+		return nil, err
+	}
+	result.ActualQuantity = quantity
+	result.Error = err
+
+	result.Verified = result.ActualQuantity >= result.ExpectedQuantity
+	return result, nil
+}
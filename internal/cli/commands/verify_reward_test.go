@@ -0,0 +1,108 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+func TestVerifyReward(t *testing.T) {
+	verifier := &ags.MockRewardVerifier{
+		Entitlements: []*ags.Entitlement{{ItemID: "winter_sword", Quantity: 1, Status: "ACTIVE"}},
+		Wallets:      []*ags.Wallet{{CurrencyCode: "GOLD", Balance: 40, Status: "ACTIVE"}},
+	}
+
+	tests := []struct {
+		name         string
+		reward       api.Reward
+		wantVerified bool
+		wantActual   int64
+		wantErr      bool
+	}{
+		{
+			name:         "item present",
+			reward:       api.Reward{Type: "ITEM", RewardID: "winter_sword", Quantity: 1},
+			wantVerified: true,
+			wantActual:   1,
+		},
+		{
+			name:         "item missing",
+			reward:       api.Reward{Type: "ITEM", RewardID: "shield", Quantity: 1},
+			wantVerified: false,
+			wantActual:   0,
+		},
+		{
+			name:         "wallet balance below expected",
+			reward:       api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 50},
+			wantVerified: false,
+			wantActual:   40,
+		},
+		{
+			name:         "wallet balance covers expected",
+			reward:       api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 40},
+			wantVerified: true,
+			wantActual:   40,
+		},
+		{
+			name:    "unsupported type",
+			reward:  api.Reward{Type: "BADGE", RewardID: "x", Quantity: 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := verifyReward(context.Background(), verifier, tt.reward)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.Verified != tt.wantVerified {
+				t.Errorf("Expected verified %v, got %v", tt.wantVerified, result.Verified)
+			}
+			if result.ActualQuantity != tt.wantActual {
+				t.Errorf("Expected actual quantity %d, got %d", tt.wantActual, result.ActualQuantity)
+			}
+			if result.ExpectedQuantity != int64(tt.reward.Quantity) {
+				t.Errorf("Expected expected quantity %d, got %d", tt.reward.Quantity, result.ExpectedQuantity)
+			}
+		})
+	}
+}
+
+func TestVerifyRewardSuite(t *testing.T) {
+	verifier := &ags.MockRewardVerifier{
+		Entitlements: []*ags.Entitlement{{ItemID: "winter_sword", Quantity: 1, Status: "ACTIVE"}},
+	}
+
+	passed, err := verifyReward(context.Background(), verifier, api.Reward{Type: "ITEM", RewardID: "winter_sword", Quantity: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	failed, err := verifyReward(context.Background(), verifier, api.Reward{Type: "ITEM", RewardID: "shield", Quantity: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if suite := verifyRewardSuite(passed); len(suite.Cases) != 1 || suite.Cases[0].Failure != "" {
+		t.Errorf("Expected one passing case, got %+v", suite.Cases)
+	}
+
+	suite := verifyRewardSuite(failed)
+	if len(suite.Cases) != 1 || !strings.Contains(suite.Cases[0].Failure, "expected 1, found 0") {
+		t.Errorf("Expected one failing case with quantities, got %+v", suite.Cases)
+	}
+}
@@ -22,7 +22,7 @@ func NewVerifyWalletCommand() *cobra.Command {
 		Long:  "Check wallet balance for a specific currency code in AGS Platform.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if currencyCode == "" {
-				return fmt.Errorf("--currency is required")
+				return cli.UsageErrorf("--currency is required")
 			}
 
 			// Get format flag
@@ -30,22 +30,26 @@ func NewVerifyWalletCommand() *cobra.Command {
 
 			// Create container
 			container := cli.GetContainerFromFlags(cmd)
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
 
 			// Query wallet
-			wallet, err := container.RewardVerifier.GetUserWallet(currencyCode)
+			wallet, err := container.RewardVerifier.GetUserWallet(ctx, currencyCode)
 			if err != nil {
 				return fmt.Errorf("failed to get wallet: %w", err)
 			}
 
 			// Format output
-			formatter := output.NewFormatter(format)
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
 			result, err := formatter.FormatWallet(wallet)
 			if err != nil {
 				return fmt.Errorf("failed to format output: %w", err)
 			}
 
-			fmt.Println(result)
-			return nil
+			return cli.PrintResult(cmd, result)
 		},
 	}
 
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// NewWalletHistoryCommand creates the wallet-history command
+func NewWalletHistoryCommand() *cobra.Command {
+	var (
+		currencyCode string
+		limit        int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "wallet-history",
+		Short: "Show recent wallet transactions for user",
+		Long: `Show recent transactions for a currency wallet in AGS Platform, newest first.
+Use this to tell "grant never happened" apart from "grant happened then was deducted".
+Balance-after is derived from the current balance.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if currencyCode == "" {
+				return cli.UsageErrorf("--currency is required")
+			}
+
+			if limit < 1 {
+				return cli.UsageErrorf("--limit must be at least 1")
+			}
+
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+
+			// Query transactions
+			txs, err := container.RewardVerifier.QueryWalletTransactions(ctx, currencyCode, limit)
+			if err != nil {
+				return fmt.Errorf("failed to query wallet transactions: %w", err)
+			}
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			result, err := formatter.FormatWalletTransactions(txs)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			return cli.PrintResult(cmd, result)
+		},
+	}
+
+	cmd.Flags().StringVar(&currencyCode, "currency", "", "Currency code to query (required)")
+	cmd.Flags().IntVar(&limit, "limit", 20, "Maximum number of transactions to show")
+	_ = cmd.MarkFlagRequired("currency")
+
+	return cmd
+}
@@ -6,7 +6,9 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"syscall"
@@ -23,11 +25,20 @@ func NewWatchCommand() *cobra.Command {
 	var interval time.Duration
 	var challengeID string
 	var once bool
+	var exitOnComplete bool
+	var timeout time.Duration
 
 	cmd := &cobra.Command{
 		Use:   "watch",
 		Short: "Continuously monitor challenges",
-		Long:  "Watch challenges and output updates at regular intervals.",
+		Long: `Watch challenges and output updates at regular intervals.
+
+With --format json or jsonl (and without --once), each tick prints one JSON line with the
+goals whose progress or status changed, e.g. for jq:
+
+  watch --format json | jq -c '.changes[] | select(.new_status == "completed")'
+
+The first line has "initial": true and lists every goal as its baseline.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get format flag
 			format, _ := cmd.Flags().GetString("format")
@@ -36,7 +47,10 @@ func NewWatchCommand() *cobra.Command {
 			container := cli.GetContainerFromFlags(cmd)
 
 			ctx := context.Background()
-			formatter := output.NewFormatter(format)
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
 
 			// Setup signal handling for Ctrl+C
 			sigChan := make(chan os.Signal, 1)
@@ -45,10 +59,27 @@ func NewWatchCommand() *cobra.Command {
 			ticker := time.NewTicker(interval)
 			defer ticker.Stop()
 
+			// Optional deadline (nil channel never fires)
+			var timeoutChan <-chan time.Time
+			if timeout > 0 {
+				timer := time.NewTimer(timeout)
+				defer timer.Stop()
+				timeoutChan = timer.C
+			}
+
+			// --quiet keeps watching (e.g. with --exit-on-complete) without printing
+			out := cmd.OutOrStdout()
+			if cli.IsQuiet(cmd) {
+				out = io.Discard
+			}
+
 			var prevChallenges []api.Challenge
+			fetched := false
 
 			// Helper to fetch and print
 			fetchAndPrint := func() error {
+				// Progress changes outside this process, so every tick bypasses --cache-ttl
+				api.InvalidateCache(container.APIClient)
 				challenges, err := container.APIClient.ListChallenges(ctx)
 				if err != nil {
 					return err
@@ -65,6 +96,26 @@ func NewWatchCommand() *cobra.Command {
 					challenges = filtered
 				}
 
+				// JSON mode emits one structured diff per tick (the first tick is the baseline)
+				if output.IsJSON(format) && !once {
+					diff := watchDiff{Timestamp: time.Now(), Initial: !fetched}
+					if fetched {
+						diff.Changes = detectChanges(prevChallenges, challenges)
+					} else {
+						diff.Changes = baselineChanges(challenges)
+					}
+
+					data, err := json.Marshal(diff)
+					if err != nil {
+						return err
+					}
+					fmt.Fprintln(out, string(data))
+
+					prevChallenges = challenges
+					fetched = true
+					return nil
+				}
+
 				// Detect changes (simple comparison)
 				changeCount := 0
 				if len(prevChallenges) > 0 {
@@ -79,19 +130,19 @@ func NewWatchCommand() *cobra.Command {
 
 				// Print timestamp and change info (text mode only)
 				if format == "text" || format == "" {
-					fmt.Printf("[%s] ", time.Now().Format("2006-01-02 15:04:05"))
+					fmt.Fprintf(out, "[%s] ", time.Now().Format("2006-01-02 15:04:05"))
 					if len(prevChallenges) > 0 {
 						if changeCount > 0 {
-							fmt.Printf("%d change(s) detected\n", changeCount)
+							fmt.Fprintf(out, "%d change(s) detected\n", changeCount)
 						} else {
-							fmt.Println("No changes")
+							fmt.Fprintln(out, "No changes")
 						}
 					} else {
-						fmt.Println("Initial fetch")
+						fmt.Fprintln(out, "Initial fetch")
 					}
 				}
 
-				fmt.Println(result)
+				fmt.Fprintln(out, result)
 
 				prevChallenges = challenges
 				return nil
@@ -107,16 +158,31 @@ func NewWatchCommand() *cobra.Command {
 				return nil
 			}
 
+			if exitOnComplete && allGoalsComplete(prevChallenges) {
+				return nil
+			}
+
 			// Continuous watching
 			for {
 				select {
 				case <-ticker.C:
 					if err := fetchAndPrint(); err != nil {
 						fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+						continue
+					}
+
+					if exitOnComplete && allGoalsComplete(prevChallenges) {
+						return nil
+					}
+
+				case <-timeoutChan:
+					if exitOnComplete {
+						return fmt.Errorf("timed out after %s waiting for goals to complete", timeout)
 					}
+					return fmt.Errorf("watch timed out after %s", timeout)
 
 				case <-sigChan:
-					fmt.Println("\nStopping watch...")
+					fmt.Fprintln(out, "\nStopping watch...")
 					return nil
 				}
 			}
@@ -126,13 +192,67 @@ func NewWatchCommand() *cobra.Command {
 	cmd.Flags().DurationVar(&interval, "interval", 5*time.Second, "Refresh interval")
 	cmd.Flags().StringVar(&challengeID, "challenge", "", "Watch specific challenge only")
 	cmd.Flags().BoolVar(&once, "once", false, "Print once and exit")
+	cmd.Flags().BoolVar(&exitOnComplete, "exit-on-complete", false, "Exit 0 once all watched goals are completed or claimed")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "Exit non-zero if still watching after this duration (0 = no timeout)")
 
 	return cmd
 }
 
-// detectChangeCount counts the number of goals that have changed
-func detectChangeCount(prev, curr []api.Challenge) int {
-	changes := 0
+// allGoalsComplete returns true if every goal of every challenge is completed or claimed
+// An empty challenge list (e.g., the watched challenge was not found) is never complete.
+func allGoalsComplete(challenges []api.Challenge) bool {
+	if len(challenges) == 0 {
+		return false
+	}
+
+	for _, c := range challenges {
+		for _, g := range c.Goals {
+			if g.Status != "completed" && g.Status != "claimed" {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// watchDiff is the per-tick JSON object emitted by watch in JSON mode
+type watchDiff struct {
+	Timestamp time.Time    `json:"timestamp"`
+	Initial   bool         `json:"initial"`
+	Changes   []goalChange `json:"changes"`
+}
+
+// goalChange describes a goal's progress and status transition
+type goalChange struct {
+	ChallengeID string `json:"challenge_id"`
+	GoalID      string `json:"goal_id"`
+	OldProgress int32  `json:"old_progress"`
+	NewProgress int32  `json:"new_progress"`
+	OldStatus   string `json:"old_status"`
+	NewStatus   string `json:"new_status"`
+}
+
+// baselineChanges lists every goal as a change from the zero state
+func baselineChanges(curr []api.Challenge) []goalChange {
+	changes := []goalChange{}
+	for _, c := range curr {
+		for _, g := range c.Goals {
+			changes = append(changes, goalChange{
+				ChallengeID: c.ID,
+				GoalID:      g.ID,
+				NewProgress: g.Progress,
+				NewStatus:   g.Status,
+			})
+		}
+	}
+	return changes
+}
+
+// detectChanges returns the goals whose progress or status changed
+// Challenges and goals not present in prev are ignored.
+func detectChanges(prev, curr []api.Challenge) []goalChange {
+	changes := []goalChange{}
 
 	// Create map of prev challenges for quick lookup
 	prevMap := make(map[string]api.Challenge)
@@ -161,10 +281,22 @@ func detectChangeCount(prev, curr []api.Challenge) int {
 			}
 
 			if currGoal.Progress != prevGoal.Progress || currGoal.Status != prevGoal.Status {
-				changes++
+				changes = append(changes, goalChange{
+					ChallengeID: currChallenge.ID,
+					GoalID:      currGoal.ID,
+					OldProgress: prevGoal.Progress,
+					NewProgress: currGoal.Progress,
+					OldStatus:   prevGoal.Status,
+					NewStatus:   currGoal.Status,
+				})
 			}
 		}
 	}
 
 	return changes
 }
+
+// detectChangeCount counts the number of goals that have changed
+func detectChangeCount(prev, curr []api.Challenge) int {
+	return len(detectChanges(prev, curr))
+}
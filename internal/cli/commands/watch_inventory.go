@@ -0,0 +1,224 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
+	"github.com/spf13/cobra"
+)
+
+// Expectation kinds for watch-inventory
+const (
+	expectItem   = "item"
+	expectWallet = "wallet"
+)
+
+// inventoryExpectation is a parsed --expect value
+type inventoryExpectation struct {
+	Kind string // item or wallet
+	ID   string // Item ID or currency code
+	Min  int64  // Minimum quantity/balance; 0 means "any increase" for wallets
+}
+
+// inventoryPoll is the state observed by a single poll
+type inventoryPoll struct {
+	Timestamp time.Time `json:"timestamp"`
+	Expect    string    `json:"expect"`
+	Quantity  int64     `json:"quantity"`
+	Target    int64     `json:"target"`
+	Met       bool      `json:"met"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// NewWatchInventoryCommand creates the watch-inventory command
+func NewWatchInventoryCommand() *cobra.Command {
+	var (
+		expect   string
+		timeout  time.Duration
+		interval time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch-inventory",
+		Short: "Wait for a reward grant to appear in AGS Platform",
+		Long: `Poll the user's entitlements or wallet until an expected grant appears,
+printing what was observed on every poll.
+
+  --expect item:winter_sword        entitlement exists (quantity >= 1)
+  --expect item:winter_sword>=3     entitlement quantity >= 3
+  --expect wallet:GOLD>=250         wallet balance >= 250
+  --expect wallet:GOLD              wallet balance increases from the first poll
+
+Exits 0 once the expectation holds, non-zero on timeout.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			exp, err := parseInventoryExpectation(expect)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			out := cmd.OutOrStdout()
+			if cli.IsQuiet(cmd) {
+				out = io.Discard
+			}
+
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			last, err := watchInventory(ctx, container.RewardVerifier, exp, interval, timeout, func(p inventoryPoll) {
+				printInventoryPoll(out, p, format)
+			})
+			if err != nil {
+				if errors.Is(err, retry.ErrPollTimeout) && last != nil {
+					return fmt.Errorf("%s not met: %w (last seen: %d)", exp, err, last.Quantity)
+				}
+				return fmt.Errorf("%s not met: %w", exp, err)
+			}
+
+			if !output.IsJSON(format) {
+				fmt.Fprintf(out, "✓ %s met (%d)\n", exp, last.Quantity)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&expect, "expect", "", "Expected grant: item:<id>[>=n] or wallet:<currency>[>=n] (required)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Second, "Give up after this long")
+	cmd.Flags().DurationVar(&interval, "interval", 2*time.Second, "Polling interval")
+	_ = cmd.MarkFlagRequired("expect")
+
+	return cmd
+}
+
+// parseInventoryExpectation parses "item:<id>[>=n]" or "wallet:<currency>[>=n]"
+func parseInventoryExpectation(s string) (*inventoryExpectation, error) {
+	kind, rest, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok || (kind != expectItem && kind != expectWallet) {
+		return nil, fmt.Errorf("invalid --expect %q (expected item:<id>[>=n] or wallet:<currency>[>=n])", s)
+	}
+
+	exp := &inventoryExpectation{Kind: kind, ID: rest}
+	if id, minStr, found := strings.Cut(rest, ">="); found {
+		n, err := strconv.ParseInt(strings.TrimSpace(minStr), 10, 64)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid --expect %q: minimum must be a positive integer", s)
+		}
+		exp.ID = id
+		exp.Min = n
+	}
+
+	exp.ID = strings.TrimSpace(exp.ID)
+	if exp.ID == "" {
+		return nil, fmt.Errorf("invalid --expect %q: missing %s ID", s, kind)
+	}
+
+	// An item "appears" once it has any quantity
+	if exp.Kind == expectItem && exp.Min == 0 {
+		exp.Min = 1
+	}
+
+	return exp, nil
+}
+
+// String describes the expectation
+func (e *inventoryExpectation) String() string {
+	if e.Min == 0 {
+		return fmt.Sprintf("%s:%s increase", e.Kind, e.ID)
+	}
+	return fmt.Sprintf("%s:%s>=%d", e.Kind, e.ID, e.Min)
+}
+
+// quantity returns the current entitlement quantity or wallet balance
+func (e *inventoryExpectation) quantity(ctx context.Context, verifier ags.RewardVerifier) (int64, error) {
+	if e.Kind == expectItem {
+		ent, err := verifier.GetUserEntitlement(ctx, e.ID)
+		if err != nil {
+			return 0, err
+		}
+		return int64(ent.Quantity), nil
+	}
+
+	wallet, err := verifier.GetUserWallet(ctx, e.ID)
+	if err != nil {
+		return 0, err
+	}
+	return wallet.Balance, nil
+}
+
+// watchInventory polls the verifier until the expectation holds or the timeout elapses
+// onPoll is called with every observation. A wallet expectation without a minimum
+// targets one more than the first balance observed (a missing wallet counts as 0).
+//
+// Returns:
+//   - *inventoryPoll: The last observation (nil if nothing was polled)
+//   - error: Non-nil (wrapping retry.ErrPollTimeout) if the expectation never held
+func watchInventory(ctx context.Context, verifier ags.RewardVerifier, exp *inventoryExpectation, interval, timeout time.Duration, onPoll func(inventoryPoll)) (*inventoryPoll, error) {
+	target := exp.Min
+	var last *inventoryPoll
+
+	err := retry.Poll(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		p := inventoryPoll{Timestamp: time.Now(), Expect: exp.String()}
+
+		qty, err := exp.quantity(ctx, verifier)
+		if err != nil {
+			// Missing entitlement or wallet is expected until the grant lands
+			p.Error = err.Error()
+			if target == 0 {
+				target = 1 // A missing wallet counts as a zero balance
+			}
+		} else {
+			if target == 0 {
+				target = qty + 1
+			}
+			p.Quantity = qty
+			p.Met = qty >= target
+		}
+		p.Target = target
+
+		last = &p
+		onPoll(p)
+		return p.Met, nil
+	})
+
+	return last, err
+}
+
+// printInventoryPoll prints one observation: a JSON line for --format json, text otherwise
+func printInventoryPoll(out io.Writer, p inventoryPoll, format string) {
+	if output.IsJSON(format) {
+		data, err := json.Marshal(p)
+		if err == nil {
+			fmt.Fprintln(out, string(data))
+		}
+		return
+	}
+
+	ts := p.Timestamp.Format("15:04:05")
+	switch {
+	case p.Error != "":
+		fmt.Fprintf(out, "[%s] %s: not found yet (%s)\n", ts, p.Expect, p.Error)
+	case p.Met:
+		fmt.Fprintf(out, "[%s] %s: %d (met)\n", ts, p.Expect, p.Quantity)
+	default:
+		fmt.Fprintf(out, "[%s] %s: %d (waiting for %d)\n", ts, p.Expect, p.Quantity, p.Target)
+	}
+}
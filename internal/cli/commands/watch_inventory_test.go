@@ -0,0 +1,136 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
+)
+
+// delayedGrantVerifier surfaces the entitlement only after a number of lookups
+type delayedGrantVerifier struct {
+	*ags.MockRewardVerifier
+	grantAfter int
+	calls      int
+}
+
+func (v *delayedGrantVerifier) GetUserEntitlement(ctx context.Context, itemID string) (*ags.Entitlement, error) {
+	v.calls++
+	if v.calls == v.grantAfter {
+		v.Entitlements = append(v.Entitlements, &ags.Entitlement{ItemID: itemID, Quantity: 1, Status: "ACTIVE"})
+	}
+	return v.MockRewardVerifier.GetUserEntitlement(ctx, itemID)
+}
+
+func TestParseInventoryExpectation(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantKind string
+		wantID   string
+		wantMin  int64
+		wantErr  bool
+	}{
+		{input: "item:winter_sword", wantKind: "item", wantID: "winter_sword", wantMin: 1},
+		{input: "item:winter_sword>=3", wantKind: "item", wantID: "winter_sword", wantMin: 3},
+		{input: "wallet:GOLD>=250", wantKind: "wallet", wantID: "GOLD", wantMin: 250},
+		{input: "wallet:GOLD", wantKind: "wallet", wantID: "GOLD", wantMin: 0},
+		{input: "winter_sword", wantErr: true},
+		{input: "stat:kills", wantErr: true},
+		{input: "item:", wantErr: true},
+		{input: "wallet:GOLD>=0", wantErr: true},
+		{input: "wallet:GOLD>=lots", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			exp, err := parseInventoryExpectation(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got %+v", exp)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if exp.Kind != tt.wantKind || exp.ID != tt.wantID || exp.Min != tt.wantMin {
+				t.Errorf("Expected %s:%s min %d, got %s:%s min %d",
+					tt.wantKind, tt.wantID, tt.wantMin, exp.Kind, exp.ID, exp.Min)
+			}
+		})
+	}
+}
+
+func TestWatchInventory_ItemAppearsAfterPolls(t *testing.T) {
+	verifier := &delayedGrantVerifier{MockRewardVerifier: &ags.MockRewardVerifier{}, grantAfter: 3}
+	exp, _ := parseInventoryExpectation("item:winter_sword")
+
+	var polls []inventoryPoll
+	last, err := watchInventory(context.Background(), verifier, exp, time.Millisecond, time.Second, func(p inventoryPoll) {
+		polls = append(polls, p)
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(polls) != 3 {
+		t.Errorf("Expected 3 polls, got %d", len(polls))
+	}
+	if polls[0].Error == "" || polls[0].Met {
+		t.Errorf("Expected first poll to report the missing item, got %+v", polls[0])
+	}
+	if !last.Met || last.Quantity != 1 {
+		t.Errorf("Expected last poll met with quantity 1, got %+v", last)
+	}
+}
+
+func TestWatchInventory_WalletIncrease(t *testing.T) {
+	verifier := &ags.MockRewardVerifier{
+		Wallets: []*ags.Wallet{{CurrencyCode: "GOLD", Balance: 100, Status: "ACTIVE"}},
+	}
+	exp, _ := parseInventoryExpectation("wallet:GOLD")
+
+	polls := 0
+	last, err := watchInventory(context.Background(), verifier, exp, time.Millisecond, time.Second, func(p inventoryPoll) {
+		polls++
+		if polls == 2 {
+			verifier.Wallets[0].Balance += 50
+		}
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if last.Quantity != 150 || last.Target != 101 {
+		t.Errorf("Expected balance 150 against target 101, got %d against %d", last.Quantity, last.Target)
+	}
+}
+
+func TestWatchInventory_Timeout(t *testing.T) {
+	verifier := &ags.MockRewardVerifier{
+		Wallets: []*ags.Wallet{{CurrencyCode: "GOLD", Balance: 100, Status: "ACTIVE"}},
+	}
+	exp, _ := parseInventoryExpectation("wallet:GOLD>=250")
+
+	polls := 0
+	last, err := watchInventory(context.Background(), verifier, exp, 5*time.Millisecond, 30*time.Millisecond, func(p inventoryPoll) {
+		polls++
+	})
+	if !errors.Is(err, retry.ErrPollTimeout) {
+		t.Fatalf("Expected ErrPollTimeout, got %v", err)
+	}
+
+	if polls < 2 {
+		t.Errorf("Expected several polls before timing out, got %d", polls)
+	}
+	if last == nil || last.Met || last.Quantity != 100 {
+		t.Errorf("Expected last poll unmet with balance 100, got %+v", last)
+	}
+}
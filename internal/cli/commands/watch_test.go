@@ -0,0 +1,118 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+func TestAllGoalsComplete(t *testing.T) {
+	tests := []struct {
+		name       string
+		challenges []api.Challenge
+		expected   bool
+	}{
+		{
+			name:       "no challenges",
+			challenges: []api.Challenge{},
+			expected:   false,
+		},
+		{
+			name: "completed and claimed",
+			challenges: []api.Challenge{
+				{ID: "c1", Goals: []api.Goal{{ID: "g1", Status: "completed"}, {ID: "g2", Status: "claimed"}}},
+			},
+			expected: true,
+		},
+		{
+			name: "one goal in progress",
+			challenges: []api.Challenge{
+				{ID: "c1", Goals: []api.Goal{{ID: "g1", Status: "completed"}}},
+				{ID: "c2", Goals: []api.Goal{{ID: "g2", Status: "in_progress"}}},
+			},
+			expected: false,
+		},
+		{
+			name: "not started",
+			challenges: []api.Challenge{
+				{ID: "c1", Goals: []api.Goal{{ID: "g1", Status: "not_started"}}},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allGoalsComplete(tt.challenges); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDetectChanges(t *testing.T) {
+	prev := []api.Challenge{
+		{ID: "c1", Goals: []api.Goal{
+			{ID: "g1", Progress: 2, Status: "in_progress"},
+			{ID: "g2", Progress: 0, Status: "not_started"},
+		}},
+	}
+	curr := []api.Challenge{
+		{ID: "c1", Goals: []api.Goal{
+			{ID: "g1", Progress: 5, Status: "completed"},
+			{ID: "g2", Progress: 0, Status: "not_started"},
+			{ID: "g3", Progress: 1, Status: "in_progress"},
+		}},
+		{ID: "c2", Goals: []api.Goal{{ID: "g4", Progress: 1, Status: "in_progress"}}},
+	}
+
+	changes := detectChanges(prev, curr)
+	if len(changes) != 1 {
+		t.Fatalf("Expected 1 change, got %d: %+v", len(changes), changes)
+	}
+
+	expected := goalChange{
+		ChallengeID: "c1",
+		GoalID:      "g1",
+		OldProgress: 2,
+		NewProgress: 5,
+		OldStatus:   "in_progress",
+		NewStatus:   "completed",
+	}
+	if changes[0] != expected {
+		t.Errorf("Expected %+v, got %+v", expected, changes[0])
+	}
+
+	if count := detectChangeCount(prev, curr); count != 1 {
+		t.Errorf("Expected change count 1, got %d", count)
+	}
+
+	if unchanged := detectChanges(prev, prev); len(unchanged) != 0 {
+		t.Errorf("Expected no changes, got %+v", unchanged)
+	}
+}
+
+func TestBaselineChanges(t *testing.T) {
+	curr := []api.Challenge{
+		{ID: "c1", Goals: []api.Goal{
+			{ID: "g1", Progress: 3, Status: "in_progress"},
+			{ID: "g2", Progress: 0, Status: "not_started"},
+		}},
+	}
+
+	changes := baselineChanges(curr)
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %d", len(changes))
+	}
+
+	if changes[0].OldStatus != "" || changes[0].OldProgress != 0 {
+		t.Errorf("Expected empty old state, got %+v", changes[0])
+	}
+	if changes[0].NewProgress != 3 || changes[0].NewStatus != "in_progress" {
+		t.Errorf("Expected new state 3/in_progress, got %+v", changes[0])
+	}
+}
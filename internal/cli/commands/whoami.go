@@ -0,0 +1,95 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// NewWhoAmICommand creates the whoami command
+func NewWhoAmICommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the user the active token acts as",
+		Long: `Fetch the current access token and print its subject (user ID), namespace,
+and expiry, decoded from the JWT claims. In mock mode this is the mock user.
+
+The token signature is not verified; this only shows what the token claims.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Get format flag
+			format, _ := cmd.Flags().GetString("format")
+			authMode, _ := cmd.Flags().GetString("auth-mode")
+
+			// Create container
+			container := cli.GetContainerFromFlags(cmd)
+
+			// Get and decode token
+			ctx, stop := cli.CommandContext(cmd)
+			defer stop()
+			token, err := container.AuthProvider.GetToken(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get token: %w", err)
+			}
+
+			info, err := whoAmI(token, authMode, time.Now())
+			if err != nil {
+				return err
+			}
+
+			// Format output
+			formatter, err := output.NewFormatter(format)
+			if err != nil {
+				return &cli.UsageError{Err: err}
+			}
+			formatted, err := formatter.FormatWhoAmI(info)
+			if err != nil {
+				return fmt.Errorf("failed to format output: %w", err)
+			}
+
+			return cli.PrintResult(cmd, formatted)
+		},
+	}
+
+	return cmd
+}
+
+// whoAmI decodes the token's claims into the identity shown by whoami
+// The exp claim wins over the provider's expiry; tokens without a sub claim (client
+// credentials) get a note explaining they are not bound to a user.
+func whoAmI(token *auth.Token, authMode string, now time.Time) (*output.WhoAmIInfo, error) {
+	claims, err := auth.ParseJWTClaims(token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode token: %w", err)
+	}
+
+	info := &output.WhoAmIInfo{
+		AuthMode:  authMode,
+		Subject:   claims.Subject,
+		Namespace: claims.Namespace,
+		ClientID:  claims.ClientID,
+	}
+
+	expiresAt := claims.Expiry()
+	if expiresAt.IsZero() {
+		expiresAt = token.ExpiresAt
+	}
+	if !expiresAt.IsZero() {
+		info.ExpiresAt = &expiresAt
+		info.ExpiresInSeconds = int64(expiresAt.Sub(now) / time.Second)
+	}
+
+	if claims.Subject == "" {
+		info.Note = "token has no sub claim (client credentials tokens are not bound to a user)"
+	}
+
+	return info, nil
+}
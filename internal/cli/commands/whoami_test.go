@@ -0,0 +1,83 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package commands
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+)
+
+func TestWhoAmI_MockUser(t *testing.T) {
+	token, err := auth.NewMockAuthProvider("test-user", "demo").GetToken(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	info, err := whoAmI(token, "mock", time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if info.Subject != "test-user" || info.Namespace != "demo" || info.AuthMode != "mock" {
+		t.Errorf("Expected mock user test-user @ demo, got %+v", info)
+	}
+	if info.ExpiresAt == nil || info.ExpiresInSeconds <= 0 {
+		t.Errorf("Expected a future expiry, got %+v", info)
+	}
+	if info.Note != "" {
+		t.Errorf("Expected no note for a user token, got %q", info.Note)
+	}
+
+	for _, format := range []string{"text", "table"} {
+		formatter, _ := output.NewFormatter(format)
+		formatted, err := formatter.FormatWhoAmI(info)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !strings.Contains(formatted, "test-user") || !strings.Contains(formatted, "(in ") {
+			t.Errorf("Expected %s output to show the user and time until expiry, got:\n%s", format, formatted)
+		}
+	}
+}
+
+func TestWhoAmI_ClientToken(t *testing.T) {
+	// Client credentials tokens have no sub claim (and here no exp either)
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"namespace":"demo","client_id":"svc"}`))
+	expiresAt := time.Now().Add(-5 * time.Minute)
+	token := &auth.Token{AccessToken: "header." + payload + ".sig", ExpiresAt: expiresAt}
+
+	info, err := whoAmI(token, "client", time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if info.Subject != "" || info.ClientID != "svc" {
+		t.Errorf("Expected no subject and client svc, got %+v", info)
+	}
+	if !strings.Contains(info.Note, "no sub claim") {
+		t.Errorf("Expected a note about the missing sub claim, got %q", info.Note)
+	}
+	if info.ExpiresAt == nil || !info.ExpiresAt.Equal(expiresAt) || info.ExpiresInSeconds >= 0 {
+		t.Errorf("Expected the provider expiry (already past), got %+v", info)
+	}
+
+	formatted, _ := (&output.TextFormatter{}).FormatWhoAmI(info)
+	if !strings.Contains(formatted, "User: (none)") || !strings.Contains(formatted, "expired") {
+		t.Errorf("Unexpected text output:\n%s", formatted)
+	}
+}
+
+func TestWhoAmI_OpaqueToken(t *testing.T) {
+	_, err := whoAmI(&auth.Token{AccessToken: "opaque"}, "password", time.Now())
+	if err == nil || !strings.Contains(err.Error(), "failed to decode token") {
+		t.Errorf("Expected decode error, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+// ConfigFlag is the global flag naming the config file
+const ConfigFlag = "config"
+
+// configAnnotation marks flags whose value was applied from the config file
+const configAnnotation = "challenge-demo/config"
+
+// DefaultConfigPath returns ~/.challenge-demo/config.yaml (empty if the home directory is unknown)
+func DefaultConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".challenge-demo", "config.yaml")
+}
+
+// LoadConfig reads a YAML config file of global flag defaults
+//
+// Keys are global flag names, e.g.:
+//
+//	backend-url: https://demo.accelbyte.io/challenge
+//	auth-mode: password
+//	namespace: mygame
+//	email: player@example.com
+//	password: hunter2
+//
+// Returns:
+//   - map[string]string: Flag name to value
+//   - error: Non-nil if the file cannot be read or is not a flat mapping
+func LoadConfig(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	raw := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		switch value.(type) {
+		case map[interface{}]interface{}, []interface{}:
+			return nil, fmt.Errorf("invalid config %s: %s must be a single value", path, key)
+		case nil:
+			continue
+		}
+		values[key] = fmt.Sprint(value)
+	}
+
+	return values, nil
+}
+
+// ApplyConfig sets flag defaults from config values
+// Flags set on the command line are left alone, and applied values do not count as
+// "changed" (so they are not recorded by the audit log); check FlagSet instead. Error
+// messages never include the values of sensitive flags.
+//
+// Returns:
+//   - error: Non-nil if a key is not a known flag or a value is invalid for its flag
+func ApplyConfig(flags *pflag.FlagSet, values map[string]string) error {
+	// Apply in a stable order so the first error is deterministic
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		flag := flags.Lookup(key)
+		if flag == nil || key == ConfigFlag {
+			return fmt.Errorf("unknown config key %q", key)
+		}
+		if flag.Changed {
+			continue
+		}
+
+		if err := flag.Value.Set(values[key]); err != nil {
+			if IsSensitiveFlag(key) {
+				return fmt.Errorf("invalid value for config key %q", key)
+			}
+			return fmt.Errorf("invalid value %q for config key %q: %w", values[key], key, err)
+		}
+		_ = flags.SetAnnotation(key, configAnnotation, []string{"true"})
+	}
+
+	return nil
+}
+
+// FlagSet reports whether a flag was given on the command line or applied from the config file
+// Use it instead of flags.Changed for settings that only take effect when set.
+func FlagSet(flags *pflag.FlagSet, name string) bool {
+	flag := flags.Lookup(name)
+	if flag == nil {
+		return false
+	}
+	_, fromConfig := flag.Annotations[configAnnotation]
+	return flag.Changed || fromConfig
+}
+
+// LoadAndApplyConfig applies the config file to flags
+// An empty path falls back to DefaultConfigPath, which is optional: it is skipped
+// when missing. An explicitly given path must exist.
+func LoadAndApplyConfig(flags *pflag.FlagSet, path string) error {
+	explicit := path != ""
+	if !explicit {
+		path = DefaultConfigPath()
+		if path == "" {
+			return nil
+		}
+	}
+
+	values, err := LoadConfig(path)
+	if err != nil {
+		if !explicit && errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	if err := ApplyConfig(flags, values); err != nil {
+		return fmt.Errorf("config %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const testConfigYAML = `backend-url: https://file.example.com/challenge
+namespace: file-ns
+password: file-secret
+format: table
+timeout: 45s
+`
+
+// newConfiguredRoot builds a root command that applies the config like main.go
+// and records the flag values seen by the subcommand.
+func newConfiguredRoot(configPath string, seen map[string]string) *cobra.Command {
+	root := &cobra.Command{
+		Use: "challenge-demo",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			path, _ := cmd.Flags().GetString(ConfigFlag)
+			return LoadAndApplyConfig(cmd.Root().PersistentFlags(), path)
+		},
+	}
+	root.PersistentFlags().String(ConfigFlag, configPath, "")
+	root.PersistentFlags().String("backend-url", "http://localhost:8000/challenge", "")
+	root.PersistentFlags().String("namespace", "test", "")
+	root.PersistentFlags().String("password", "", "")
+	root.PersistentFlags().String("format", "json", "")
+	root.PersistentFlags().Duration("timeout", 30*time.Second, "")
+	root.SilenceErrors = true
+	root.SilenceUsage = true
+
+	root.AddCommand(&cobra.Command{
+		Use: "list-challenges",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, name := range []string{"backend-url", "namespace", "password", "format", "timeout"} {
+				seen[name] = cmd.Flag(name).Value.String()
+			}
+			return nil
+		},
+	})
+
+	return root
+}
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	return path
+}
+
+func TestConfig_FlagsOverrideFile(t *testing.T) {
+	path := writeConfig(t, testConfigYAML)
+
+	tests := []struct {
+		name string
+		args []string
+		want map[string]string
+	}{
+		{
+			name: "file only",
+			args: []string{"list-challenges"},
+			want: map[string]string{
+				"backend-url": "https://file.example.com/challenge",
+				"namespace":   "file-ns",
+				"password":    "file-secret",
+				"format":      "table",
+				"timeout":     "45s",
+			},
+		},
+		{
+			name: "flags win",
+			args: []string{"list-challenges", "--namespace", "flag-ns", "--format=json", "--timeout", "5s"},
+			want: map[string]string{
+				"backend-url": "https://file.example.com/challenge",
+				"namespace":   "flag-ns",
+				"password":    "file-secret",
+				"format":      "json",
+				"timeout":     "5s",
+			},
+		},
+		{
+			name: "flag equal to the default still wins",
+			args: []string{"list-challenges", "--backend-url", "http://localhost:8000/challenge"},
+			want: map[string]string{
+				"backend-url": "http://localhost:8000/challenge",
+				"namespace":   "file-ns",
+				"password":    "file-secret",
+				"format":      "table",
+				"timeout":     "45s",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := map[string]string{}
+			root := newConfiguredRoot(path, seen)
+			root.SetArgs(tt.args)
+
+			if err := root.Execute(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			for name, want := range tt.want {
+				if seen[name] != want {
+					t.Errorf("Expected %s=%q, got %q", name, want, seen[name])
+				}
+			}
+		})
+	}
+}
+
+func TestConfig_AppliedValuesNotChanged(t *testing.T) {
+	path := writeConfig(t, testConfigYAML)
+	root := newConfiguredRoot("", map[string]string{})
+
+	if err := LoadAndApplyConfig(root.PersistentFlags(), path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if root.PersistentFlags().Changed("namespace") {
+		t.Error("Expected config values not to mark flags as changed")
+	}
+}
+
+func TestFlagSet(t *testing.T) {
+	path := writeConfig(t, testConfigYAML)
+	root := newConfiguredRoot("", map[string]string{})
+	flags := root.PersistentFlags()
+	if err := flags.Parse([]string{"--format=json"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := LoadAndApplyConfig(flags, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"namespace", true},     // From the config file
+		{"format", true},        // From the command line
+		{"backend-url", true},   // From the config file
+		{ConfigFlag, false},     // Default only
+		{"unknown-flag", false}, // Not defined
+	}
+	for _, tt := range tests {
+		if got := FlagSet(flags, tt.name); got != tt.want {
+			t.Errorf("Expected FlagSet(%s)=%v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantErr    string
+		notInError string
+	}{
+		{name: "unknown key", content: "backend_url: x\n", wantErr: `unknown config key "backend_url"`},
+		{name: "config key", content: "config: other.yaml\n", wantErr: `unknown config key "config"`},
+		{name: "nested value", content: "namespace:\n  name: x\n", wantErr: "must be a single value"},
+		{name: "invalid value", content: "timeout: soon\n", wantErr: `invalid value "soon"`},
+		{name: "not yaml", content: "{{", wantErr: "invalid config"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := newConfiguredRoot(writeConfig(t, tt.content), map[string]string{})
+			root.SetArgs([]string{"list-challenges"})
+
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestApplyConfig_SecretNotInError(t *testing.T) {
+	root := &cobra.Command{Use: "challenge-demo"}
+	root.PersistentFlags().Int("client-secret-rotation", 0, "")
+
+	err := ApplyConfig(root.PersistentFlags(), map[string]string{"client-secret-rotation": "s3cr3t-value"})
+	if err == nil {
+		t.Fatal("Expected error for invalid value")
+	}
+	if strings.Contains(err.Error(), "s3cr3t-value") {
+		t.Errorf("Expected secret to be omitted from error, got %v", err)
+	}
+}
+
+func TestLoadAndApplyConfig_MissingFile(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.yaml")
+
+	// Explicit path must exist
+	root := newConfiguredRoot(missing, map[string]string{})
+	if err := LoadAndApplyConfig(root.PersistentFlags(), missing); err == nil {
+		t.Error("Expected error for missing explicit config")
+	}
+
+	// Default path is optional
+	t.Setenv("HOME", t.TempDir())
+	if err := LoadAndApplyConfig(root.PersistentFlags(), ""); err != nil {
+		t.Errorf("Expected missing default config to be skipped, got %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// Global flags that print each API call as a curl command on stderr
+const (
+	DumpCurlFlag      = "dump-curl"
+	DumpCurlTokenFlag = "dump-curl-token" // Keep the bearer token instead of redacting it
+)
+
+// CurlAPIClient wraps an APIClient and writes every call's request to w as an
+// equivalent curl command, so a failing call can be reproduced outside the CLI
+type CurlAPIClient struct {
+	api.APIClient
+	w            io.Writer
+	includeToken bool
+
+	mu          sync.Mutex
+	lastRequest *api.RequestDebugInfo // Last dumped request, to detect calls that sent none
+}
+
+// NewCurlAPIClient wraps client, writing a curl command to w after each call
+// The Authorization value is redacted unless includeToken is set.
+func NewCurlAPIClient(client api.APIClient, w io.Writer, includeToken bool) *CurlAPIClient {
+	return &CurlAPIClient{APIClient: client, w: w, includeToken: includeToken}
+}
+
+// ListChallenges lists challenges and dumps the call as curl
+func (c *CurlAPIClient) ListChallenges(ctx context.Context) ([]api.Challenge, error) {
+	defer c.dump()
+	return c.APIClient.ListChallenges(ctx)
+}
+
+// ListChallengesWithFilter lists challenges and dumps the call as curl
+func (c *CurlAPIClient) ListChallengesWithFilter(ctx context.Context, activeOnly bool) ([]api.Challenge, error) {
+	defer c.dump()
+	return c.APIClient.ListChallengesWithFilter(ctx, activeOnly)
+}
+
+// GetChallenge gets a challenge and dumps the call as curl
+func (c *CurlAPIClient) GetChallenge(ctx context.Context, challengeID string) (*api.Challenge, error) {
+	defer c.dump()
+	return c.APIClient.GetChallenge(ctx, challengeID)
+}
+
+// ClaimReward claims a reward and dumps the call as curl
+func (c *CurlAPIClient) ClaimReward(ctx context.Context, challengeID, goalID string) (*api.ClaimResult, error) {
+	defer c.dump()
+	return c.APIClient.ClaimReward(ctx, challengeID, goalID)
+}
+
+// GetClaimHistory gets the claim history and dumps the call as curl
+func (c *CurlAPIClient) GetClaimHistory(ctx context.Context, challengeID string) ([]api.ClaimRecord, error) {
+	defer c.dump()
+	return c.APIClient.GetClaimHistory(ctx, challengeID)
+}
+
+// BatchClaimRewards claims several rewards and dumps the call as curl
+func (c *CurlAPIClient) BatchClaimRewards(ctx context.Context, challengeID string, goalIDs []string) (*api.BatchClaimResponse, error) {
+	defer c.dump()
+	return c.APIClient.BatchClaimRewards(ctx, challengeID, goalIDs)
+}
+
+// InitializePlayer initializes the player and dumps the call as curl
+func (c *CurlAPIClient) InitializePlayer(ctx context.Context) (*api.InitializeResponse, error) {
+	defer c.dump()
+	return c.APIClient.InitializePlayer(ctx)
+}
+
+// PreviewInitializePlayer previews initialization and dumps the call as curl
+func (c *CurlAPIClient) PreviewInitializePlayer(ctx context.Context) (*api.InitializeResponse, error) {
+	defer c.dump()
+	return c.APIClient.PreviewInitializePlayer(ctx)
+}
+
+// SetGoalActive sets a goal's active state and dumps the call as curl
+func (c *CurlAPIClient) SetGoalActive(ctx context.Context, challengeID, goalID string, isActive bool) (*api.SetGoalActiveResponse, error) {
+	defer c.dump()
+	return c.APIClient.SetGoalActive(ctx, challengeID, goalID, isActive)
+}
+
+// BatchSelectGoals selects goals and dumps the call as curl
+func (c *CurlAPIClient) BatchSelectGoals(ctx context.Context, challengeID string, req *api.BatchSelectRequest) (*api.BatchSelectResponse, error) {
+	defer c.dump()
+	return c.APIClient.BatchSelectGoals(ctx, challengeID, req)
+}
+
+// RandomSelectGoals selects random goals and dumps the call as curl
+func (c *CurlAPIClient) RandomSelectGoals(ctx context.Context, challengeID string, req *api.RandomSelectRequest) (*api.RandomSelectResponse, error) {
+	defer c.dump()
+	return c.APIClient.RandomSelectGoals(ctx, challengeID, req)
+}
+
+// GetRotationStatus gets the rotation status and dumps the call as curl
+func (c *CurlAPIClient) GetRotationStatus(ctx context.Context, challengeID string) (*api.RotationStatusResponse, error) {
+	defer c.dump()
+	return c.APIClient.GetRotationStatus(ctx, challengeID)
+}
+
+// dump writes the last request as curl, if the call sent a new one
+// Nothing is written for clients without HTTP traffic (e.g. the mock backend).
+func (c *CurlAPIClient) dump() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	req := c.APIClient.GetLastRequest()
+	if req == nil || req == c.lastRequest {
+		return
+	}
+	c.lastRequest = req
+
+	_, _ = io.WriteString(c.w, CurlCommand(req, c.includeToken)+"\n\n")
+}
+
+// CurlCommand renders a recorded request as a multi-line curl command
+// Headers are sorted by name and, unless includeToken is set, redacted like --verbose
+// redacts them. Every argument is single-quoted for POSIX shells.
+func CurlCommand(req *api.RequestDebugInfo, includeToken bool) string {
+	args := []string{"curl", "-X", req.Method, shellQuote(req.URL)}
+
+	names := make([]string, 0, len(req.Headers))
+	for name := range req.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := req.Headers[name]
+		if !includeToken {
+			value = RedactHeader(name, value)
+		}
+		args = append(args, "-H "+shellQuote(name+": "+value))
+	}
+
+	if req.Body != "" {
+		args = append(args, "--data-raw "+shellQuote(req.Body))
+	}
+
+	// Keep the method and URL on the first line, then one option per line
+	return strings.Join(args[:4], " ") + joinContinued(args[4:])
+}
+
+// joinContinued prefixes each option with a shell line continuation
+func joinContinued(options []string) string {
+	var b strings.Builder
+	for _, option := range options {
+		b.WriteString(" \\\n  ")
+		b.WriteString(option)
+	}
+	return b.String()
+}
+
+// shellQuote single-quotes s for a POSIX shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+func TestCurlCommand(t *testing.T) {
+	req := &api.RequestDebugInfo{
+		Method: "POST",
+		URL:    "http://localhost:8000/v1/challenges/daily/goals/kill-10/active",
+		Headers: map[string]string{
+			"Authorization": "Bearer abc.def.ghi",
+			"Content-Type":  "application/json",
+			"X-Request-Id":  "req-1",
+		},
+		Body: `{"is_active":true,"note":"it's on"}`,
+	}
+
+	expected := "curl -X POST 'http://localhost:8000/v1/challenges/daily/goals/kill-10/active' \\\n" +
+		"  -H 'Authorization: Bearer [REDACTED]' \\\n" +
+		"  -H 'Content-Type: application/json' \\\n" +
+		"  -H 'X-Request-Id: req-1' \\\n" +
+		`  --data-raw '{"is_active":true,"note":"it'\''s on"}'`
+	if got := CurlCommand(req, false); got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	if got := CurlCommand(req, true); !strings.Contains(got, "-H 'Authorization: Bearer abc.def.ghi'") {
+		t.Errorf("Expected the bearer token to be included, got:\n%s", got)
+	}
+
+	// GET requests have no body
+	get := &api.RequestDebugInfo{Method: "GET", URL: "http://localhost:8000/v1/challenges"}
+	if got := CurlCommand(get, false); got != "curl -X GET 'http://localhost:8000/v1/challenges'" {
+		t.Errorf("Expected a bare GET command, got %q", got)
+	}
+}
+
+func TestCurlAPIClient_Dump(t *testing.T) {
+	inner := &debugAPIClient{
+		MockAPIClient: api.NewMockAPIClient(nil),
+		request:       &api.RequestDebugInfo{Method: "GET", URL: "http://localhost:8000/v1/challenges"},
+	}
+
+	var buf bytes.Buffer
+	client := NewCurlAPIClient(inner, &buf, false)
+	_, _ = client.ListChallenges(context.Background())
+	if !strings.Contains(buf.String(), "curl -X GET 'http://localhost:8000/v1/challenges'") {
+		t.Errorf("Expected the curl command, got:\n%s", buf.String())
+	}
+
+	// A call that sent no new request must not repeat the previous one
+	buf.Reset()
+	_, _ = client.GetChallenge(context.Background(), "daily")
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing dumped without a new request, got:\n%s", buf.String())
+	}
+
+	// The mock backend has no HTTP traffic
+	buf.Reset()
+	_, _ = NewCurlAPIClient(api.NewMockAPIClient(nil), &buf, false).ListChallenges(context.Background())
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing dumped for a client without HTTP traffic, got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// FormatFlag is the global flag selecting the output formatter
+const FormatFlag = "format"
+
+// ExtraFormatsAnnotation is the command annotation listing (comma-separated) --format
+// values the command renders itself, beyond the registered formatters (e.g. graph's dot)
+const ExtraFormatsAnnotation = "extra-formats"
+
+// ValidateFormatFlag checks that --format names a registered formatter
+// Commands resolve the formatter only after doing their work, so an unknown format
+// is rejected up front rather than after a claim or event has already been sent.
+//
+// Returns:
+//   - error: Usage error listing the available formats if --format is unknown, or if
+//     --format template is given without --template or --template-file
+func ValidateFormatFlag(flags *pflag.FlagSet) error {
+	format, err := flags.GetString(FormatFlag)
+	if err != nil {
+		return nil // Flag not defined on this command tree
+	}
+
+	// --format template only names the output of the template flags
+	if format == output.TemplateFormat {
+		text, _ := flags.GetString(TemplateFlag)
+		path, _ := flags.GetString(TemplateFileFlag)
+		if text == "" && path == "" {
+			return UsageErrorf("--%s %s requires --%s or --%s", FormatFlag, output.TemplateFormat, TemplateFlag, TemplateFileFlag)
+		}
+		return nil
+	}
+
+	if _, err := output.NewFormatter(format); err != nil {
+		return &UsageError{Err: err}
+	}
+	return nil
+}
+
+// ValidateCommandFormat checks --format for cmd: a registered formatter or one of the
+// formats in cmd's ExtraFormatsAnnotation
+func ValidateCommandFormat(cmd *cobra.Command) error {
+	flags := cmd.Root().PersistentFlags()
+	format, _ := flags.GetString(FormatFlag)
+	for _, extra := range strings.Split(cmd.Annotations[ExtraFormatsAnnotation], ",") {
+		if extra != "" && extra == format {
+			return nil
+		}
+	}
+	return ValidateFormatFlag(flags)
+}
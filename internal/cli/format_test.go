@@ -0,0 +1,107 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func TestValidateFormatFlag_Template(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+	}{
+		{name: "inline template", args: []string{"--format", "template", "--template", "{{len .}}"}},
+		{name: "template file", args: []string{"--format", "template", "--template-file", "report.tmpl"}},
+		{name: "no template", args: []string{"--format", "template"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String(FormatFlag, "json", "")
+			flags.String(TemplateFlag, "", "")
+			flags.String(TemplateFileFlag, "", "")
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			err := ValidateFormatFlag(flags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			var usageErr *UsageError
+			if err != nil && (!errors.As(err, &usageErr) || !strings.Contains(err.Error(), "--template")) {
+				t.Errorf("Expected usage error naming --template, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateFormatFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		wantErr bool
+	}{
+		{name: "json", format: "json"},
+		{name: "table", format: "table"},
+		{name: "text", format: "text"},
+		{name: "unknown", format: "yaml", wantErr: true},
+		{name: "empty", format: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String(FormatFlag, "json", "")
+			_ = flags.Set(FormatFlag, tt.format)
+
+			err := ValidateFormatFlag(flags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil {
+				return
+			}
+
+			var usageErr *UsageError
+			if !errors.As(err, &usageErr) {
+				t.Errorf("Expected UsageError, got %T", err)
+			}
+			if !strings.Contains(err.Error(), "json, jsonl, markdown, table, text") {
+				t.Errorf("Expected available formats in error, got %q", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateCommandFormat(t *testing.T) {
+	root := &cobra.Command{Use: "challenge-demo"}
+	root.PersistentFlags().String(FormatFlag, "json", "")
+	graph := &cobra.Command{Use: "graph", Annotations: map[string]string{ExtraFormatsAnnotation: "dot"}}
+	list := &cobra.Command{Use: "list-challenges"}
+	root.AddCommand(graph, list)
+
+	_ = root.PersistentFlags().Set(FormatFlag, "dot")
+	if err := ValidateCommandFormat(graph); err != nil {
+		t.Errorf("Expected dot accepted for graph, got %v", err)
+	}
+	var usageErr *UsageError
+	if err := ValidateCommandFormat(list); !errors.As(err, &usageErr) {
+		t.Errorf("Expected usage error for dot on list-challenges, got %v", err)
+	}
+
+	_ = root.PersistentFlags().Set(FormatFlag, "table")
+	if err := ValidateCommandFormat(graph); err != nil {
+		t.Errorf("Expected registered formats accepted for graph, got %v", err)
+	}
+}
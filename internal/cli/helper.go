@@ -5,11 +5,18 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/events"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // Exit codes
@@ -17,48 +24,222 @@ const (
 	ExitSuccess      = 0 // Command succeeded
 	ExitError        = 1 // General error (API, auth, network)
 	ExitUsageError   = 2 // Invalid flags or arguments
+	ExitNotFound     = 3 // Challenge or goal not found
 	ExitUnauthorized = 4 // Authentication failed
+	ExitConflict     = 5 // Rejected by the goal's state (already claimed, not completed)
+
+	ExitInterrupted = 130 // Cancelled by Ctrl+C (128 + SIGINT, as the shell reports it)
 )
 
+// ContainerConfigFromFlags reads the connection and auth settings from the global flags
+func ContainerConfigFromFlags(flags *pflag.FlagSet) app.ContainerConfig {
+	cfg := app.ContainerConfig{EventTriggerOptions: EventTriggerOptions(flags)}
+	cfg.BackendURL, _ = flags.GetString("backend-url")
+	cfg.AuthMode, _ = flags.GetString("auth-mode")
+	cfg.EventHandlerURL, _ = flags.GetString("event-handler-url")
+	cfg.UserID, _ = flags.GetString("user-id")
+	cfg.Namespace, _ = flags.GetString(NamespaceFlag)
+	cfg.Email, _ = flags.GetString("email")
+	cfg.Password, _ = flags.GetString("password")
+	cfg.MFAToken, _ = flags.GetString(MFATokenFlag)
+	cfg.AccessToken, _ = flags.GetString(AccessTokenFlag)
+	cfg.ClientID, _ = flags.GetString("client-id")
+	cfg.ClientSecret, _ = flags.GetString("client-secret")
+	cfg.IAMURL, _ = flags.GetString("iam-url")
+	cfg.PlatformURL, _ = flags.GetString("platform-url")
+	cfg.AdminClientID, _ = flags.GetString("admin-client-id")
+	cfg.AdminClientSecret, _ = flags.GetString("admin-client-secret")
+	cfg.TokenRefreshBuffer, _ = flags.GetDuration(TokenRefreshBufferFlag)
+	return cfg
+}
+
 // GetContainerFromFlags creates a Container from Cobra command flags
 func GetContainerFromFlags(cmd *cobra.Command) *app.Container {
-	backendURL, _ := cmd.Flags().GetString("backend-url")
-	authMode, _ := cmd.Flags().GetString("auth-mode")
-	eventHandlerURL, _ := cmd.Flags().GetString("event-handler-url")
-	userID, _ := cmd.Flags().GetString("user-id")
-	namespace, _ := cmd.Flags().GetString("namespace")
-	email, _ := cmd.Flags().GetString("email")
-	password, _ := cmd.Flags().GetString("password")
-	clientID, _ := cmd.Flags().GetString("client-id")
-	clientSecret, _ := cmd.Flags().GetString("client-secret")
-	iamURL, _ := cmd.Flags().GetString("iam-url")
-	platformURL, _ := cmd.Flags().GetString("platform-url")
-	adminClientID, _ := cmd.Flags().GetString("admin-client-id")
-	adminClientSecret, _ := cmd.Flags().GetString("admin-client-secret")
-
-	return app.NewContainer(
-		backendURL,
-		authMode,
-		eventHandlerURL,
-		userID,
-		namespace,
-		email,
-		password,
-		clientID,
-		clientSecret,
-		iamURL,
-		platformURL,
-		adminClientID,
-		adminClientSecret,
-	)
+	container := app.NewContainer(ContainerConfigFromFlags(cmd.Flags()))
+
+	// Configure the HTTP client before any wrapping hides it
+	ConfigureAPIClient(cmd.Flags(), container)
+
+	// Dump each API call's request and response to stderr
+	if verbose, _ := cmd.Flags().GetBool(VerboseFlag); verbose {
+		container.APIClient = NewVerboseAPIClient(container.APIClient, cmd.ErrOrStderr())
+	}
+
+	// Print each API call as a curl command on stderr
+	if dumpCurl, _ := cmd.Flags().GetBool(DumpCurlFlag); dumpCurl {
+		includeToken, _ := cmd.Flags().GetBool(DumpCurlTokenFlag)
+		container.APIClient = NewCurlAPIClient(container.APIClient, cmd.ErrOrStderr(), includeToken)
+	}
+
+	// Serve repeated reads from memory; wraps last so cache hits send nothing
+	CacheAPIClient(cmd.Flags(), container)
+
+	return container
+}
+
+// ConfigureAPIClient applies --request-id and --metrics-port to the container's API client
+// Containers built with app.NewContainer directly (the TUI) must call it themselves.
+func ConfigureAPIClient(flags *pflag.FlagSet, container *app.Container) {
+	applyRequestID(flags, container)
+	ObserveAPIMetrics(flags, container)
+}
+
+// MFATokenFlag is the global flag passing an MFA code to the password grant
+const MFATokenFlag = "mfa-token"
+
+// AccessTokenFlag is the global flag passing a user token for --auth-mode token
+const AccessTokenFlag = "access-token"
+
+// TokenRefreshBufferFlag is the global flag setting how long before expiry tokens are refreshed
+const TokenRefreshBufferFlag = "token-refresh-buffer"
+
+// Event handler connection flags
+const (
+	EventHandlerTLSFlag        = "event-handler-tls"
+	EventHandlerCAFlag         = "event-handler-ca"
+	EventHandlerServerNameFlag = "event-handler-server-name"
+	EventHandlerTimeoutFlag    = "event-handler-timeout"
+)
+
+// EventTriggerOptions builds the event handler connection options from the global flags
+// --event-handler-ca and --event-handler-server-name imply --event-handler-tls.
+func EventTriggerOptions(flags *pflag.FlagSet) []events.TriggerOption {
+	useTLS, _ := flags.GetBool(EventHandlerTLSFlag)
+	caFile, _ := flags.GetString(EventHandlerCAFlag)
+	serverName, _ := flags.GetString(EventHandlerServerNameFlag)
+	timeout, _ := flags.GetDuration(EventHandlerTimeoutFlag)
+
+	opts := []events.TriggerOption{events.WithDialTimeout(timeout)}
+	if useTLS || caFile != "" || serverName != "" {
+		opts = append(opts, events.WithTLS(caFile, serverName))
+	}
+	return opts
+}
+
+// UsageError marks an error caused by invalid flags or arguments
+type UsageError struct {
+	Err error
+}
+
+// Error implements the error interface
+func (e *UsageError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *UsageError) Unwrap() error {
+	return e.Err
+}
+
+// ExplainedError replaces the message of a recognized API error with a plain explanation
+// (e.g. "challenge 'x' not found"); Err still decides the exit code.
+type ExplainedError struct {
+	Msg string
+	Err error
+}
+
+// Error implements the error interface
+func (e *ExplainedError) Error() string {
+	return e.Msg
+}
+
+// Unwrap returns the underlying error
+func (e *ExplainedError) Unwrap() error {
+	return e.Err
+}
+
+// UsageErrorf formats a UsageError (exit code ExitUsageError)
+func UsageErrorf(format string, args ...interface{}) error {
+	return &UsageError{Err: fmt.Errorf(format, args...)}
+}
+
+// ExitCode maps a command error to the process exit code
+//
+//   - nil: ExitSuccess
+//   - *UsageError (invalid flags or arguments): ExitUsageError
+//   - context.Canceled (Ctrl+C, see CommandContext): ExitInterrupted
+//   - auth.ErrAuthFailed or an HTTP 401/403 response: ExitUnauthorized
+//   - an HTTP 404 response: ExitNotFound
+//   - an HTTP 409/412 or failed-precondition response: ExitConflict
+//   - anything else: ExitError
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+
+	var usageErr *UsageError
+	if errors.As(err, &usageErr) {
+		return ExitUsageError
+	}
+
+	// Before the auth check: a password grant cancelled by Ctrl+C also wraps ErrAuthFailed
+	if errors.Is(err, context.Canceled) {
+		return ExitInterrupted
+	}
+
+	if errors.Is(err, auth.ErrAuthFailed) {
+		return ExitUnauthorized
+	}
+	if code, ok := retry.StatusCode(err); ok {
+		switch code {
+		case 401, 403:
+			return ExitUnauthorized
+		case 404:
+			return ExitNotFound
+		case 409, 412:
+			return ExitConflict
+		}
+	}
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) && apiErr.GRPCCode == api.GRPCCodeFailedPrecondition {
+		return ExitConflict
+	}
+
+	return ExitError
+}
+
+// MarkUsageErrors turns Cobra's flag parsing and argument validation errors into UsageErrors
+// Call it once all subcommands are added to root.
+func MarkUsageErrors(root *cobra.Command) {
+	root.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return &UsageError{Err: err}
+	})
+
+	var wrap func(cmd *cobra.Command)
+	wrap = func(cmd *cobra.Command) {
+		if validate := cmd.Args; validate != nil {
+			cmd.Args = func(cmd *cobra.Command, args []string) error {
+				if err := validate(cmd, args); err != nil {
+					return &UsageError{Err: err}
+				}
+				return nil
+			}
+		}
+		for _, sub := range cmd.Commands() {
+			wrap(sub)
+		}
+	}
+	wrap(root)
+}
+
+// ValidateFlags checks required flags and flag groups, returning a UsageError
+// Cobra runs the same checks after the persistent pre-run hook, but reports them as plain errors.
+func ValidateFlags(cmd *cobra.Command) error {
+	if err := cmd.ValidateRequiredFlags(); err != nil {
+		return &UsageError{Err: err}
+	}
+	if err := cmd.ValidateFlagGroups(); err != nil {
+		return &UsageError{Err: err}
+	}
+	return nil
 }
 
-// HandleError prints an error and exits with appropriate code
+// HandleError prints an error and exits with the code from ExitCode
 func HandleError(err error) {
 	if err == nil {
 		return
 	}
 
 	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-	os.Exit(ExitError)
+	os.Exit(ExitCode(err))
 }
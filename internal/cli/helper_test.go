@@ -0,0 +1,111 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/spf13/cobra"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "nil", err: nil, want: ExitSuccess},
+		{name: "generic error", err: errors.New("connection refused"), want: ExitError},
+		{name: "usage error", err: UsageErrorf("--currency is required"), want: ExitUsageError},
+		{name: "wrapped usage error", err: fmt.Errorf("seed: %w", UsageErrorf("bad flag")), want: ExitUsageError},
+		{name: "auth failed", err: fmt.Errorf("get auth token: %w", fmt.Errorf("password grant failed: %w", auth.ErrAuthFailed)), want: ExitUnauthorized},
+		{name: "HTTP 401", err: fmt.Errorf("failed to list challenges: %w", &api.APIError{Code: 401}), want: ExitUnauthorized},
+		{name: "HTTP 403", err: &api.APIError{Code: 403}, want: ExitUnauthorized},
+		{name: "HTTP 404", err: &api.APIError{Code: 404}, want: ExitNotFound},
+		{name: "explained 404", err: &ExplainedError{Msg: "challenge 'x' not found", Err: &api.APIError{Code: 404}}, want: ExitNotFound},
+		{name: "HTTP 409", err: &api.APIError{Code: 409}, want: ExitConflict},
+		{name: "HTTP 412", err: &api.APIError{Code: 412}, want: ExitConflict},
+		{name: "failed precondition", err: &api.APIError{Code: 400, GRPCCode: api.GRPCCodeFailedPrecondition}, want: ExitConflict},
+		{name: "HTTP 400", err: &api.APIError{Code: 400}, want: ExitError},
+		{name: "HTTP 500", err: &api.APIError{Code: 500}, want: ExitError},
+		{name: "interrupted", err: fmt.Errorf("failed to query entitlements: %w", context.Canceled), want: ExitInterrupted},
+		{name: "interrupted login", err: fmt.Errorf("password grant failed: %w: %w", auth.ErrAuthFailed, context.Canceled), want: ExitInterrupted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("Expected exit code %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+// newUsageRoot builds a root command wired for usage errors like main.go
+func newUsageRoot() *cobra.Command {
+	root := &cobra.Command{
+		Use:  "challenge-demo",
+		Args: cobra.NoArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return ValidateFlags(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	root.SilenceErrors = true
+	root.SilenceUsage = true
+	root.SetOut(io.Discard)
+
+	getChallenge := &cobra.Command{
+		Use:  "get-challenge <challenge-id>",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	root.AddCommand(getChallenge)
+
+	verifyWallet := &cobra.Command{
+		Use:  "verify-wallet",
+		RunE: func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	verifyWallet.Flags().String("currency", "", "")
+	_ = verifyWallet.MarkFlagRequired("currency")
+	verifyWallet.Flags().Int("limit", 10, "")
+	root.AddCommand(verifyWallet)
+
+	MarkUsageErrors(root)
+	return root
+}
+
+func TestMarkUsageErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{name: "valid", args: []string{"get-challenge", "daily-quests"}, want: ExitSuccess},
+		{name: "missing argument", args: []string{"get-challenge"}, want: ExitUsageError},
+		{name: "unknown flag", args: []string{"get-challenge", "daily-quests", "--bogus"}, want: ExitUsageError},
+		{name: "invalid flag value", args: []string{"verify-wallet", "--currency", "GOLD", "--limit", "ten"}, want: ExitUsageError},
+		{name: "missing required flag", args: []string{"verify-wallet"}, want: ExitUsageError},
+		{name: "unknown command", args: []string{"list-everything"}, want: ExitUsageError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := newUsageRoot()
+			root.SetArgs(tt.args)
+
+			err := root.Execute()
+			if got := ExitCode(err); got != tt.want {
+				t.Errorf("Expected exit code %d, got %d (err: %v)", tt.want, got, err)
+			}
+		})
+	}
+}
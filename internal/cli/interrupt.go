@@ -0,0 +1,48 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+)
+
+// ErrInterrupted is the cancellation cause of a command context stopped by Ctrl+C or SIGTERM
+var ErrInterrupted = errors.New("interrupted")
+
+// CommandContext returns the context for a command's API calls, cancelled on Ctrl+C (SIGINT)
+// or SIGTERM so in-flight requests and retry backoffs stop promptly. The first signal prints
+// a notice to stderr; ExitCode then maps the resulting cancellation to ExitInterrupted.
+// Call stop once the command is done to restore the default signal handling.
+func CommandContext(cmd *cobra.Command) (ctx context.Context, stop func()) {
+	parent := cmd.Context()
+	if parent == nil {
+		parent = context.Background()
+	}
+
+	ctx, cancel := context.WithCancelCause(parent)
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-sigChan:
+			fmt.Fprintln(cmd.ErrOrStderr(), "Interrupted, cancelling in-flight requests")
+			cancel(ErrInterrupted)
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(sigChan)
+		cancel(nil)
+	}
+}
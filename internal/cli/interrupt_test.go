@@ -0,0 +1,62 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestCommandContext_Interrupt(t *testing.T) {
+	var stderr bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetErr(&stderr)
+
+	ctx, stop := CommandContext(cmd)
+	defer stop()
+
+	self, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := self.Signal(os.Interrupt); err != nil {
+		t.Skipf("Cannot send SIGINT on this platform: %v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected SIGINT to cancel the context")
+	}
+
+	if !errors.Is(context.Cause(ctx), ErrInterrupted) {
+		t.Errorf("Expected cause ErrInterrupted, got %v", context.Cause(ctx))
+	}
+	if ExitCode(ctx.Err()) != ExitInterrupted {
+		t.Errorf("Expected exit code %d, got %d", ExitInterrupted, ExitCode(ctx.Err()))
+	}
+	if !strings.Contains(stderr.String(), "Interrupted") {
+		t.Errorf("Expected an interrupt notice on stderr, got %q", stderr.String())
+	}
+}
+
+func TestCommandContext_Stop(t *testing.T) {
+	ctx, stop := CommandContext(&cobra.Command{})
+	stop()
+
+	if ctx.Err() == nil {
+		t.Fatal("Expected stop to cancel the context")
+	}
+	if context.Cause(ctx) == ErrInterrupted {
+		t.Error("Expected stop not to report an interrupt")
+	}
+}
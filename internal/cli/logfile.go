@@ -0,0 +1,56 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/spf13/pflag"
+)
+
+// LogFileFlag is the global flag appending log output to a file instead of stderr
+const LogFileFlag = "log-file"
+
+// LogBuffer holds log output in memory while the TUI owns the terminal
+type LogBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// Write implements io.Writer; safe for concurrent loggers
+func (b *LogBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// Flush writes the buffered logs to w and empties the buffer
+func (b *LogBuffer) Flush(w io.Writer) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, err := b.buf.WriteTo(w)
+	return err
+}
+
+// BufferLogsForTUI keeps log records off the terminal while the TUI draws its screen
+// Records are buffered at the configured level; flush the returned buffer to stderr once
+// the TUI exits. Returns nil when --log-file already sends logs elsewhere.
+func BufferLogsForTUI(flags *pflag.FlagSet) *LogBuffer {
+	if path, _ := flags.GetString(LogFileFlag); path != "" {
+		return nil
+	}
+
+	level, err := logLevelFromFlags(flags)
+	if err != nil {
+		return nil // Rejected by ApplyLogFlags before any command runs
+	}
+
+	buffer := &LogBuffer{}
+	slog.SetDefault(NewLevelLogger(buffer, level))
+	return buffer
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bytes"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBufferLogsForTUI(t *testing.T) {
+	previous := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	flags := newLogFlags()
+	if err := flags.Parse([]string{"-v"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	buffer := BufferLogsForTUI(flags)
+	if buffer == nil {
+		t.Fatal("Expected a log buffer without --log-file")
+	}
+	slog.Info("while the TUI runs")
+	slog.Debug("below the level")
+
+	var stderr bytes.Buffer
+	if err := buffer.Flush(&stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "while the TUI runs") {
+		t.Errorf("Expected the buffered info record, got %q", stderr.String())
+	}
+	if strings.Contains(stderr.String(), "below the level") {
+		t.Errorf("Expected records below -v dropped, got %q", stderr.String())
+	}
+
+	// Flushing empties the buffer
+	stderr.Reset()
+	_ = buffer.Flush(&stderr)
+	if stderr.Len() != 0 {
+		t.Errorf("Expected an empty buffer after flushing, got %q", stderr.String())
+	}
+}
+
+func TestBufferLogsForTUI_LogFile(t *testing.T) {
+	flags := newLogFlags()
+	if err := flags.Parse([]string{"--log-file=" + filepath.Join(t.TempDir(), "demo.log")}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if buffer := BufferLogsForTUI(flags); buffer != nil {
+		t.Error("Expected no buffer when --log-file is set")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/metrics"
+	"github.com/spf13/pflag"
+)
+
+// MetricsPortFlag is the global flag serving Challenge Service request metrics for Prometheus
+const MetricsPortFlag = "metrics-port"
+
+// APIMetrics collects Challenge Service request metrics while --metrics-port is set
+var APIMetrics = metrics.NewRegistry()
+
+// StartMetricsServer serves APIMetrics on --metrics-port, on all interfaces
+// Returns a nil server if the flag is 0 (the default), so metrics stay off.
+//
+// Returns:
+//   - error: Usage error if the port is out of range, or the listen error
+func StartMetricsServer(flags *pflag.FlagSet) (*metrics.Server, error) {
+	port, err := metricsPort(flags)
+	if err != nil || port == 0 {
+		return nil, err
+	}
+
+	server, err := metrics.Serve(fmt.Sprintf(":%d", port), APIMetrics)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Serving API metrics", "address", server.Addr(), "path", metrics.Path)
+	return server, nil
+}
+
+// ObserveAPIMetrics records the container's Challenge Service requests in APIMetrics
+// Does nothing unless --metrics-port is set; the offline mock backend makes no requests.
+func ObserveAPIMetrics(flags *pflag.FlagSet, container *app.Container) {
+	if port, err := metricsPort(flags); err != nil || port == 0 {
+		return
+	}
+
+	if client, ok := container.APIClient.(*api.HTTPAPIClient); ok {
+		client.SetObserver(APIMetrics)
+	}
+}
+
+// metricsPort returns --metrics-port, or 0 if the flag is not defined
+func metricsPort(flags *pflag.FlagSet) (int, error) {
+	if flags.Lookup(MetricsPortFlag) == nil {
+		return 0, nil
+	}
+
+	port, err := flags.GetInt(MetricsPortFlag)
+	if err != nil {
+		return 0, &UsageError{Err: err}
+	}
+	if port < 0 || port > 65535 {
+		return 0, UsageErrorf("--%s must be between 1 and 65535 (0 = off), got %d", MetricsPortFlag, port)
+	}
+	return port, nil
+}
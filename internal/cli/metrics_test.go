@@ -0,0 +1,49 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestStartMetricsServer(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+	}{
+		{name: "off by default", args: nil},
+		{name: "out of range", args: []string{"--metrics-port=70000"}, wantErr: true},
+		{name: "negative", args: []string{"--metrics-port=-1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("challenge-demo", pflag.ContinueOnError)
+			flags.Int(MetricsPortFlag, 0, "")
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			server, err := StartMetricsServer(flags)
+			if tt.wantErr {
+				var usageErr *UsageError
+				if !errors.As(err, &usageErr) {
+					t.Errorf("Expected usage error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if server != nil {
+				t.Errorf("Expected no metrics server, got one on %s", server.Addr())
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"regexp"
+
+	"github.com/spf13/pflag"
+)
+
+// NamespaceFlag is the global flag selecting the AccelByte namespace
+// Like every global flag it can be given after the subcommand to override the config
+// file for a single invocation.
+const NamespaceFlag = "namespace"
+
+// namespacePattern matches AGS namespace names: lowercase letters and digits, in
+// groups joined by single hyphens
+var namespacePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// maxNamespaceLength is the longest namespace AGS accepts
+const maxNamespaceLength = 64
+
+// ValidateNamespaceFlag checks --namespace against the AGS naming rule
+// Typos like "My_Game" would otherwise only show up as confusing empty results.
+//
+// Returns:
+//   - error: Usage error if the namespace is empty or not a valid AGS namespace
+func ValidateNamespaceFlag(flags *pflag.FlagSet) error {
+	namespace, err := flags.GetString(NamespaceFlag)
+	if err != nil {
+		return nil // Flag not defined on this command tree
+	}
+
+	if len(namespace) > maxNamespaceLength {
+		return UsageErrorf("--%s %q is longer than %d characters", NamespaceFlag, namespace, maxNamespaceLength)
+	}
+	if !namespacePattern.MatchString(namespace) {
+		return UsageErrorf("--%s %q is not a valid namespace (use lowercase letters, digits, and single hyphens)", NamespaceFlag, namespace)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestValidateNamespaceFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		wantErr   bool
+	}{
+		{name: "default", namespace: "test"},
+		{name: "hyphenated", namespace: "accelbyte-demo-2"},
+		{name: "digits", namespace: "game01"},
+		{name: "empty", namespace: "", wantErr: true},
+		{name: "uppercase", namespace: "MyGame", wantErr: true},
+		{name: "underscore", namespace: "my_game", wantErr: true},
+		{name: "leading hyphen", namespace: "-game", wantErr: true},
+		{name: "trailing hyphen", namespace: "game-", wantErr: true},
+		{name: "double hyphen", namespace: "my--game", wantErr: true},
+		{name: "whitespace", namespace: "my game", wantErr: true},
+		{name: "max length", namespace: strings.Repeat("a", 64)},
+		{name: "too long", namespace: strings.Repeat("a", 65), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("challenge-demo", pflag.ContinueOnError)
+			flags.String(NamespaceFlag, "test", "")
+			if err := flags.Set(NamespaceFlag, tt.namespace); err != nil {
+				t.Fatalf("Failed to set flag: %v", err)
+			}
+
+			err := ValidateNamespaceFlag(flags)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			var usageErr *UsageError
+			if !errors.As(err, &usageErr) {
+				t.Errorf("Expected usage error, got %v", err)
+			}
+		})
+	}
+}
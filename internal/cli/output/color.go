@@ -0,0 +1,70 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+)
+
+var (
+	colorDisabled   bool
+	colorDisabledMu sync.RWMutex
+)
+
+// detectColor reports whether stdout is a terminal (piped output stays plain)
+var detectColor = func() bool {
+	return term.IsTerminal(os.Stdout.Fd())
+}
+
+// colorRenderer renders status colors with the color profile of the stdout terminal
+var colorRenderer = lipgloss.NewRenderer(os.Stdout)
+
+// statusStyles color goal and challenge statuses like the TUI
+var statusStyles = map[string]lipgloss.Style{
+	"completed":   colorRenderer.NewStyle().Foreground(lipgloss.Color("2")),   // Green
+	"claimed":     colorRenderer.NewStyle().Foreground(lipgloss.Color("2")),   // Green
+	"in_progress": colorRenderer.NewStyle().Foreground(lipgloss.Color("220")), // Yellow
+	"not_started": colorRenderer.NewStyle().Foreground(lipgloss.Color("245")), // Gray
+}
+
+// SetColorDisabled turns ANSI colors in table output off (--no-color, NO_COLOR)
+func SetColorDisabled(disabled bool) {
+	colorDisabledMu.Lock()
+	defer colorDisabledMu.Unlock()
+	colorDisabled = disabled
+}
+
+// ColorDisabled reports whether colors were turned off by SetColorDisabled
+func ColorDisabled() bool {
+	colorDisabledMu.RLock()
+	defer colorDisabledMu.RUnlock()
+	return colorDisabled
+}
+
+// ColorEnabled reports whether table output is colored
+// Colors are off when disabled by SetColorDisabled or when stdout is not a terminal.
+func ColorEnabled() bool {
+	return !ColorDisabled() && detectColor()
+}
+
+// colorStatus colors status and pads it to width
+// Padding is added outside the color codes so table columns stay aligned.
+func colorStatus(status string, width int) string {
+	padding := ""
+	if len(status) < width {
+		padding = strings.Repeat(" ", width-len(status))
+	}
+
+	style, ok := statusStyles[status]
+	if !ok || !ColorEnabled() {
+		return status + padding
+	}
+	return style.Render(status) + padding
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/muesli/termenv"
+)
+
+// forceColor pretends stdout is a 256-color terminal for the rest of the test
+func forceColor(t *testing.T, terminal bool) {
+	t.Helper()
+	prevDetect, prevProfile := detectColor, colorRenderer.ColorProfile()
+	detectColor = func() bool { return terminal }
+	colorRenderer.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() {
+		detectColor = prevDetect
+		colorRenderer.SetColorProfile(prevProfile)
+		SetColorDisabled(false)
+	})
+}
+
+func TestTableFormatter_FormatChallenge_Color(t *testing.T) {
+	challenge := api.Challenge{
+		ID:   "daily",
+		Name: "Daily",
+		Goals: []api.Goal{
+			{ID: "a", Name: "Done", Status: "completed"},
+			{ID: "b", Name: "Claimed", Status: "claimed"},
+			{ID: "c", Name: "Going", Status: "in_progress"},
+			{ID: "d", Name: "Todo", Status: "not_started"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		terminal  bool
+		disabled  bool
+		wantColor bool
+	}{
+		{name: "terminal", terminal: true, wantColor: true},
+		{name: "piped", terminal: false, wantColor: false},
+		{name: "no-color", terminal: true, disabled: true, wantColor: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forceColor(t, tt.terminal)
+			SetColorDisabled(tt.disabled)
+
+			result, err := (&TableFormatter{}).FormatChallenge(&challenge)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			hasColor := strings.Contains(result, "\x1b[")
+			if hasColor != tt.wantColor {
+				t.Errorf("Expected color %v, got:\n%q", tt.wantColor, result)
+			}
+
+			// Every status is still present and the plain text keeps its column layout
+			for _, status := range []string{"completed", "claimed", "in_progress", "not_started"} {
+				if !strings.Contains(result, status) {
+					t.Errorf("Expected status %s in output:\n%s", status, result)
+				}
+			}
+		})
+	}
+}
+
+func TestColorStatus_Padding(t *testing.T) {
+	forceColor(t, true)
+
+	colored := colorStatus("completed", 15)
+	if !strings.HasSuffix(colored, "completed\x1b[0m      ") {
+		t.Errorf("Expected padding after the color reset, got %q", colored)
+	}
+
+	if got := colorStatus("unknown", 10); got != "unknown   " {
+		t.Errorf("Expected unknown statuses uncolored, got %q", got)
+	}
+}
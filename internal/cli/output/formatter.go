@@ -36,42 +36,248 @@ type Formatter interface {
 
 	// FormatWallets formats a list of wallets
 	FormatWallets(wallets []*ags.Wallet) (string, error)
+
+	// FormatWalletTransactions formats wallet transaction history
+	FormatWalletTransactions(txs []*ags.WalletTransaction) (string, error)
+
+	// FormatNamespaces formats a list of namespaces
+	FormatNamespaces(namespaces []*ags.Namespace) (string, error)
+
+	// FormatBulkResult formats the summary of a multi-item operation
+	FormatBulkResult(result *BulkResult) (string, error)
+
+	// FormatVerifyRewardResult formats a reward verification result
+	FormatVerifyRewardResult(result *VerifyRewardResult) (string, error)
+
+	// FormatVersion formats build information
+	FormatVersion(info *VersionInfo) (string, error)
+
+	// FormatClaimHistory formats claimed goals, most recent first
+	FormatClaimHistory(entries []ClaimHistoryEntry) (string, error)
+
+	// FormatStatus formats the consolidated auth, backend, challenge, and inventory status
+	FormatStatus(report *StatusReport) (string, error)
+
+	// FormatWhoAmI formats the identity of the active token
+	FormatWhoAmI(info *WhoAmIInfo) (string, error)
+
+	// FormatCompletionSummary formats per-challenge and overall completion stats
+	FormatCompletionSummary(summary *CompletionSummary) (string, error)
+
+	// FormatGoalGraph formats a challenge's goal prerequisite graph
+	FormatGoalGraph(graph *GoalGraph) (string, error)
 }
 
 // EventResult represents the result of triggering an event
 type EventResult struct {
-	Event      string        `json:"event"`
-	UserID     string        `json:"user_id"`
-	StatCode   string        `json:"stat_code,omitempty"`
-	Value      int           `json:"value,omitempty"`
-	Timestamp  time.Time     `json:"timestamp"`
-	Status     string        `json:"status"`
-	DurationMs int64         `json:"duration_ms"`
-	Error      error         `json:"error,omitempty"`
-	ErrorMsg   string        `json:"error_msg,omitempty"`
+	Event      string         `json:"event"`
+	UserID     string         `json:"user_id"`
+	StatCode   string         `json:"stat_code,omitempty"`
+	Value      int            `json:"value,omitempty"`
+	Timestamp  time.Time      `json:"timestamp"`
+	Status     string         `json:"status"`
+	DurationMs int64          `json:"duration_ms"`
+	Error      error          `json:"error,omitempty"`
+	ErrorMsg   string         `json:"error_msg,omitempty"`
+	Progress   *ProgressDelta `json:"progress,omitempty"` // Observed goal progress change (--wait-for-progress)
+}
+
+// ProgressDelta is the goal progress change observed around an event
+type ProgressDelta struct {
+	ChallengeID string `json:"challenge_id"`
+	GoalID      string `json:"goal_id"`
+	Before      int32  `json:"before"`
+	After       int32  `json:"after"`
+	Delta       int32  `json:"delta"`
+	Changed     bool   `json:"changed"` // Progress changed before the timeout
 }
 
 // ClaimResult represents the result of claiming a reward
 type ClaimResult struct {
-	ChallengeID string     `json:"challenge_id"`
-	GoalID      string     `json:"goal_id"`
-	Status      string     `json:"status"`
-	Reward      *api.Reward `json:"reward,omitempty"`
-	Timestamp   time.Time  `json:"timestamp"`
-	Error       error      `json:"error,omitempty"`
-	ErrorMsg    string     `json:"error_msg,omitempty"`
-}
-
-// NewFormatter creates a formatter for the given format type
-func NewFormatter(format string) Formatter {
-	switch format {
-	case "json":
-		return &JSONFormatter{}
-	case "table":
-		return &TableFormatter{}
-	case "text":
-		return &TextFormatter{}
+	ChallengeID string           `json:"challenge_id"`
+	GoalID      string           `json:"goal_id"`
+	Status      string           `json:"status"`
+	Reward      *api.Reward      `json:"reward,omitempty"`
+	Timestamp   time.Time        `json:"timestamp"`
+	Error       error            `json:"error,omitempty"`
+	ErrorMsg    string           `json:"error_msg,omitempty"`
+	Explanation string           `json:"explanation,omitempty"` // Likely failure reason (--explain)
+	Grant       *GrantDelta      `json:"grant,omitempty"`       // Observed inventory change (--verify)
+	Requirement *api.Requirement `json:"requirement,omitempty"` // Goal requirement (--verbose)
+}
+
+// GrantDelta is the entitlement quantity or wallet balance change observed around a claim
+type GrantDelta struct {
+	RewardType string `json:"reward_type"`
+	RewardID   string `json:"reward_id"`
+	Before     int64  `json:"before"`
+	After      int64  `json:"after"`
+	Delta      int64  `json:"delta"`
+	Expected   int64  `json:"expected"` // Reward quantity
+	Verified   bool   `json:"verified"` // Delta reached Expected before the timeout
+}
+
+// VerifyRewardResult represents the outcome of checking that a goal's reward was granted
+type VerifyRewardResult struct {
+	ChallengeID      string `json:"challenge_id"`
+	GoalID           string `json:"goal_id"`
+	RewardType       string `json:"reward_type"`
+	RewardID         string `json:"reward_id"`
+	ExpectedQuantity int64  `json:"expected_quantity"`
+	ActualQuantity   int64  `json:"actual_quantity"` // Entitlement quantity or wallet balance
+	Verified         bool   `json:"verified"`
+	Error            error  `json:"error,omitempty"` // Lookup error (e.g., entitlement not found)
+}
+
+// ClaimHistoryEntry is a claimed goal in the claim history
+type ClaimHistoryEntry struct {
+	ChallengeID   string     `json:"challenge_id"`
+	ChallengeName string     `json:"challenge_name"`
+	GoalID        string     `json:"goal_id"`
+	GoalName      string     `json:"goal_name"`
+	Reward        api.Reward `json:"reward"`
+	ClaimedAt     time.Time  `json:"claimed_at"` // Zero if the backend timestamp is missing or invalid
+}
+
+// VersionInfo describes the running build
+type VersionInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"` // GOOS/GOARCH
+}
+
+// WhoAmIInfo describes the user the active token acts as, decoded from its JWT claims
+type WhoAmIInfo struct {
+	AuthMode         string     `json:"auth_mode"`
+	Subject          string     `json:"sub"` // Empty for client credentials tokens
+	Namespace        string     `json:"namespace"`
+	ClientID         string     `json:"client_id,omitempty"`
+	ExpiresAt        *time.Time `json:"exp,omitempty"`
+	ExpiresInSeconds int64      `json:"expires_in_seconds"` // Negative once expired
+	Note             string     `json:"note,omitempty"`
+}
+
+// StatusReport is a single-glance summary of auth, backend, challenges, and inventory
+// Each section is filled independently; a failed query sets that section's Error only.
+type StatusReport struct {
+	Auth       AuthStatus       `json:"auth"`
+	Backend    BackendStatus    `json:"backend"`
+	Challenges ChallengeSummary `json:"challenges"`
+	Inventory  InventorySummary `json:"inventory"`
+}
+
+// AuthStatus describes the current user token
+type AuthStatus struct {
+	UserID    string     `json:"user_id"`
+	Namespace string     `json:"namespace"`
+	Valid     bool       `json:"valid"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// BackendStatus describes whether the Challenge Service answered
+type BackendStatus struct {
+	Reachable bool   `json:"reachable"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ChallengeSummary counts challenges and goals by state
+type ChallengeSummary struct {
+	Challenges int    `json:"challenges"`
+	Goals      int    `json:"goals"`
+	Completed  int    `json:"completed"` // Completed or claimed
+	Claimed    int    `json:"claimed"`
+	Active     int    `json:"active"`
+	Error      string `json:"error,omitempty"`
+}
+
+// InventorySummary counts the user's entitlements and wallets
+type InventorySummary struct {
+	Entitlements int    `json:"entitlements"`
+	Wallets      int    `json:"wallets"`
+	Error        string `json:"error,omitempty"`
+}
+
+// OK reports whether every section was queried successfully
+func (r *StatusReport) OK() bool {
+	return r.Auth.Error == "" && r.Auth.Valid && r.Backend.Reachable &&
+		r.Challenges.Error == "" && r.Inventory.Error == ""
+}
+
+// GoalStatuses lists the goal statuses in progression order
+var GoalStatuses = []string{"not_started", "in_progress", "completed", "claimed"}
+
+// CompletionSummary aggregates goal completion across all of the user's challenges
+type CompletionSummary struct {
+	Challenges     []ChallengeCompletion `json:"challenges"`
+	Goals          int                   `json:"goals"`
+	Completed      int                   `json:"completed"` // Completed or claimed
+	CompletionPct  int                   `json:"completion_pct"`
+	GoalsByStatus  map[string]int        `json:"goals_by_status"`
+	Claimable      int                   `json:"claimable"`       // Completed goals not yet claimed
+	PendingRewards []api.Reward          `json:"pending_rewards"` // Claimable rewards, summed per type and ID
+}
+
+// ChallengeCompletion is one challenge's share of a CompletionSummary
+type ChallengeCompletion struct {
+	ChallengeID   string `json:"challenge_id"`
+	Name          string `json:"name"`
+	Goals         int    `json:"goals"`
+	Completed     int    `json:"completed"` // Completed or claimed
+	Claimable     int    `json:"claimable"`
+	CompletionPct int    `json:"completion_pct"`
+}
+
+// GoalGraph is the prerequisite graph of one challenge's goals
+type GoalGraph struct {
+	ChallengeID string          `json:"challenge_id"`
+	Name        string          `json:"name"`
+	Goals       []GoalGraphNode `json:"goals"`
+	Cycles      [][]string      `json:"cycles"` // Goal IDs along each cycle, the first repeated at the end
+}
+
+// GoalGraphNode is a goal with the goals it requires and unlocks
+type GoalGraphNode struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Status        string   `json:"status"`
+	Prerequisites []string `json:"prerequisites"`
+	Unlocks       []string `json:"unlocks"`           // Goals listing this goal as a prerequisite
+	Missing       []string `json:"missing,omitempty"` // Prerequisite IDs with no goal in the challenge
+}
+
+// BulkResult summarizes a multi-item operation (e.g., batch claims)
+type BulkResult struct {
+	Operation string           `json:"operation"`
+	Total     int              `json:"total"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Skipped   int              `json:"skipped"`
+	Items     []BulkItemResult `json:"items"`
+}
+
+// BulkItemResult represents the outcome of a single item in a bulk operation
+type BulkItemResult struct {
+	ID         string `json:"id"`     // Item identifier (e.g., "challenge-id/goal-id")
+	Status     string `json:"status"` // "success", "error", or "skipped"
+	DurationMs int64  `json:"duration_ms"`
+	Error      error  `json:"error,omitempty"`
+}
+
+// Add appends an item outcome and updates the summary counters
+func (r *BulkResult) Add(item BulkItemResult) {
+	r.Items = append(r.Items, item)
+	r.Total++
+
+	switch item.Status {
+	case "success":
+		r.Succeeded++
+	case "skipped":
+		r.Skipped++
 	default:
-		return &JSONFormatter{}
+		r.Failed++
 	}
 }
@@ -0,0 +1,161 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+)
+
+// DotFormat is the --format value rendering a goal graph as Graphviz DOT (graph command only)
+const DotFormat = "dot"
+
+// GoalGraphTree renders the graph as an indented tree, each goal under the goals it requires
+// Trees start at the goals without prerequisites, so a goal with several prerequisites
+// appears under each of them. Goals reachable only through a cycle start extra trees, and
+// the edge closing a cycle is marked "(cycle)" instead of followed.
+func GoalGraphTree(graph *GoalGraph) string {
+	nodes := make(map[string]*GoalGraphNode, len(graph.Goals))
+	for i := range graph.Goals {
+		nodes[graph.Goals[i].ID] = &graph.Goals[i]
+	}
+
+	g := glyph.Current()
+	var b strings.Builder
+	printed := make(map[string]bool, len(graph.Goals))
+	onPath := make(map[string]bool, len(graph.Goals))
+
+	var walk func(node *GoalGraphNode, linePrefix, childPrefix string)
+	walk = func(node *GoalGraphNode, linePrefix, childPrefix string) {
+		printed[node.ID] = true
+		onPath[node.ID] = true
+		b.WriteString(linePrefix + goalGraphLabel(node) + "\n")
+
+		for i, id := range node.Unlocks {
+			child, ok := nodes[id]
+			if !ok {
+				continue
+			}
+
+			branch, indent := g.TreeBranch, g.TreePipe
+			if i == len(node.Unlocks)-1 {
+				branch, indent = g.TreeLast, strings.Repeat(" ", len([]rune(g.TreePipe)))
+			}
+			if onPath[id] {
+				b.WriteString(childPrefix + branch + goalGraphLabel(child) + " (cycle)\n")
+				continue
+			}
+			walk(child, childPrefix+branch, childPrefix+indent)
+		}
+
+		onPath[node.ID] = false
+	}
+
+	for i := range graph.Goals {
+		if node := &graph.Goals[i]; len(node.Prerequisites) == len(node.Missing) {
+			walk(node, "", "")
+		}
+	}
+	for i := range graph.Goals {
+		if node := &graph.Goals[i]; !printed[node.ID] {
+			walk(node, "", "")
+		}
+	}
+
+	return b.String()
+}
+
+// goalGraphLabel describes a goal in the tree: status glyph, name, ID, and missing prerequisites
+func goalGraphLabel(node *GoalGraphNode) string {
+	label := fmt.Sprintf("%s (%s)", node.Name, node.ID)
+	if icon := StatusIcon(node.Status); icon != "" {
+		label = icon + " " + label
+	}
+	if len(node.Missing) > 0 {
+		label += fmt.Sprintf(" [missing prerequisite: %s]", strings.Join(node.Missing, ", "))
+	}
+	return label
+}
+
+// GoalGraphDOT renders the graph as a Graphviz digraph, with edges from prerequisite to dependent
+// Edges on a cycle are drawn red, and prerequisite IDs with no goal in the challenge are
+// drawn as dashed nodes.
+func GoalGraphDOT(graph *GoalGraph) string {
+	// Goals list their prerequisites, so cycle [a b a] means a requires b and b requires a
+	onCycle := make(map[[2]string]bool)
+	for _, cycle := range graph.Cycles {
+		for i := 0; i+1 < len(cycle); i++ {
+			onCycle[[2]string{cycle[i+1], cycle[i]}] = true
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("digraph %s {\n", dotQuote(graph.ChallengeID)))
+	b.WriteString(fmt.Sprintf("  label=%s;\n", dotQuote(graph.Name)))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+
+	for _, node := range graph.Goals {
+		b.WriteString(fmt.Sprintf("  %s [label=%s];\n", dotQuote(node.ID), dotQuote(node.Name+"\n"+node.Status)))
+	}
+
+	missing := make(map[string]bool)
+	for _, node := range graph.Goals {
+		for _, id := range node.Missing {
+			if !missing[id] {
+				missing[id] = true
+				b.WriteString(fmt.Sprintf("  %s [label=%s, style=dashed];\n", dotQuote(id), dotQuote(id+"\n(missing)")))
+			}
+		}
+	}
+
+	for _, node := range graph.Goals {
+		for _, prereqID := range node.Prerequisites {
+			attrs := ""
+			if onCycle[[2]string{prereqID, node.ID}] {
+				attrs = " [color=red]"
+			}
+			b.WriteString(fmt.Sprintf("  %s -> %s%s;\n", dotQuote(prereqID), dotQuote(node.ID), attrs))
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotQuote quotes s as a DOT string, escaping quotes and backslashes and encoding newlines
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// goalGraphRequires lists a goal's prerequisites, marking IDs with no goal in the challenge
+func goalGraphRequires(node *GoalGraphNode) string {
+	missing := make(map[string]bool, len(node.Missing))
+	for _, id := range node.Missing {
+		missing[id] = true
+	}
+
+	requires := make([]string, 0, len(node.Prerequisites))
+	for _, id := range node.Prerequisites {
+		if missing[id] {
+			id += " (missing)"
+		}
+		requires = append(requires, id)
+	}
+	return joinOrDash(requires)
+}
+
+// joinOrDash joins IDs with commas, or returns "-" for none
+func joinOrDash(ids []string) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+	return strings.Join(ids, ", ")
+}
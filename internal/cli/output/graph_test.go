@@ -0,0 +1,68 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+)
+
+// goalGraphFixture is a chain, a shared dependent, a missing prerequisite, and a cycle
+func goalGraphFixture() *GoalGraph {
+	return &GoalGraph{
+		ChallengeID: "season",
+		Name:        `Season "1"`,
+		Goals: []GoalGraphNode{
+			{ID: "login", Name: "Login", Status: "claimed", Prerequisites: []string{}, Unlocks: []string{"win-3", "boss"}},
+			{ID: "win-3", Name: "Win 3", Status: "completed", Prerequisites: []string{"login"}, Unlocks: []string{"boss"}},
+			{ID: "boss", Name: "Boss", Status: "not_started", Prerequisites: []string{"login", "win-3", "gone"}, Unlocks: []string{}, Missing: []string{"gone"}},
+			{ID: "x", Name: "X", Status: "in_progress", Prerequisites: []string{"y"}, Unlocks: []string{"y"}},
+			{ID: "y", Name: "Y", Status: "in_progress", Prerequisites: []string{"x"}, Unlocks: []string{"x"}},
+		},
+		Cycles: [][]string{{"x", "y", "x"}},
+	}
+}
+
+func TestGoalGraphTree(t *testing.T) {
+	glyph.Use(glyph.ASCII)
+	defer glyph.Use(glyph.Unicode)
+
+	expected := strings.Join([]string{
+		"$ Login (login)",
+		"|-- + Win 3 (win-3)",
+		"|   `-- o Boss (boss) [missing prerequisite: gone]",
+		"`-- o Boss (boss) [missing prerequisite: gone]",
+		"* X (x)",
+		"`-- * Y (y)",
+		"    `-- * X (x) (cycle)",
+	}, "\n") + "\n"
+
+	if got := GoalGraphTree(goalGraphFixture()); got != expected {
+		t.Errorf("Expected tree:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestGoalGraphDOT(t *testing.T) {
+	got := GoalGraphDOT(goalGraphFixture())
+
+	for _, want := range []string{
+		`digraph "season" {`,
+		`label="Season \"1\"";`,
+		`"boss" [label="Boss\nnot_started"];`,
+		`"gone" [label="gone\n(missing)", style=dashed];`,
+		`"win-3" -> "boss";`,
+		`"y" -> "x" [color=red];`,
+		`"x" -> "y" [color=red];`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected DOT to contain %s, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, `"login" -> "win-3" [color=red]`) {
+		t.Errorf("Expected only cycle edges colored, got:\n%s", got)
+	}
+}
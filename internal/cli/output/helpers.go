@@ -0,0 +1,234 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+)
+
+// Formatting helpers shared by the template formatter and the TUI
+
+// TimeLayout is the layout used to display timestamps
+const TimeLayout = "2006-01-02 15:04"
+
+// DefaultProgressBarWidth is the progress bar width in runes when none is given
+const DefaultProgressBarWidth = 20
+
+// StatusIcon returns the active glyph for a goal status (empty for unknown statuses)
+func StatusIcon(status string) string {
+	g := glyph.Current()
+	switch status {
+	case "not_started":
+		return g.NotStarted
+	case "in_progress":
+		return g.InProgress
+	case "completed":
+		return g.Success
+	case "claimed":
+		return g.Claimed
+	}
+	return ""
+}
+
+// ProgressBar renders "[####----]" with width runes, filled in proportion to current/target
+// A zero target renders an empty bar.
+func ProgressBar(current, target, width int, fill, empty rune) string {
+	if target == 0 {
+		return "[" + strings.Repeat(string(empty), width) + "]"
+	}
+
+	filled := (current * width) / target
+	if filled > width {
+		filled = width
+	}
+	if filled < 0 {
+		filled = 0
+	}
+
+	return fmt.Sprintf("[%s%s]",
+		strings.Repeat(string(fill), filled),
+		strings.Repeat(string(empty), width-filled))
+}
+
+// DefaultGoalProgressWidth is the progress bar width of goals in text and table output
+const DefaultGoalProgressWidth = 10
+
+var (
+	goalProgressWidth   = DefaultGoalProgressWidth
+	goalProgressWidthMu sync.RWMutex
+)
+
+// SetGoalProgressWidth sets the progress bar width of goals in text and table output
+// (0 turns the bar off, leaving the plain "4/10" count)
+func SetGoalProgressWidth(width int) {
+	goalProgressWidthMu.Lock()
+	defer goalProgressWidthMu.Unlock()
+	goalProgressWidth = width
+}
+
+// goalProgressBarWidth returns the goal progress bar width (0 when bars are off)
+func goalProgressBarWidth() int {
+	goalProgressWidthMu.RLock()
+	defer goalProgressWidthMu.RUnlock()
+	return goalProgressWidth
+}
+
+// goalProgress renders goal progress as "[████░░░░░░] 4/10", or just "4/10" when bars are off
+// ASCII mode and --no-color draw a plain "[####------]" bar instead.
+func goalProgress(current, target int32) string {
+	width := goalProgressBarWidth()
+	count := fmt.Sprintf("%d/%d", current, target)
+	if width <= 0 {
+		return count
+	}
+
+	g := glyph.Current()
+	if ColorDisabled() {
+		g = glyph.ASCII
+	}
+	return ProgressBar(int(current), int(target), width, g.ProgressFill, g.ProgressEmpty) + " " + count
+}
+
+// ProgressPct returns progress as a whole percentage of target, capped to 0-100
+func ProgressPct(progress, target int32) int {
+	if target <= 0 {
+		return 0
+	}
+	pct := int(int64(progress) * 100 / int64(target))
+	if pct > 100 {
+		return 100
+	}
+	if pct < 0 {
+		return 0
+	}
+	return pct
+}
+
+// FormatTime formats a time.Time or RFC3339 string with TimeLayout
+// Zero times render empty; empty or unparseable strings are returned unchanged.
+func FormatTime(v interface{}) string {
+	switch t := v.(type) {
+	case time.Time:
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(TimeLayout)
+	case string:
+		parsed, ok := ParseTimestamp(t)
+		if !ok {
+			return t
+		}
+		return parsed.Format(TimeLayout)
+	}
+	return fmt.Sprint(v)
+}
+
+// ParseTimestamp parses an RFC3339 backend timestamp (e.g. Goal.ClaimedAt)
+// Returns false for empty or unparseable strings.
+func ParseTimestamp(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// statusSection is one line of a StatusReport as rendered by the text and table formatters
+type statusSection struct {
+	Name   string
+	OK     bool
+	Detail string
+}
+
+// statusSections describes each section of the report in display order
+func statusSections(r *StatusReport) []statusSection {
+	auth := statusSection{Name: "Auth", OK: r.Auth.Error == "" && r.Auth.Valid}
+	switch {
+	case r.Auth.Error != "":
+		auth.Detail = r.Auth.Error
+	case !r.Auth.Valid:
+		auth.Detail = fmt.Sprintf("%s @ %s, token invalid or expired", r.Auth.UserID, r.Auth.Namespace)
+	case r.Auth.ExpiresAt != nil:
+		auth.Detail = fmt.Sprintf("%s @ %s, expires in %s", r.Auth.UserID, r.Auth.Namespace, time.Until(*r.Auth.ExpiresAt).Round(time.Minute))
+	default:
+		auth.Detail = fmt.Sprintf("%s @ %s", r.Auth.UserID, r.Auth.Namespace)
+	}
+
+	backend := statusSection{Name: "Backend", OK: r.Backend.Reachable}
+	if r.Backend.Reachable {
+		backend.Detail = fmt.Sprintf("reachable (%dms)", r.Backend.LatencyMs)
+	} else {
+		backend.Detail = "unreachable: " + r.Backend.Error
+	}
+
+	challenges := statusSection{Name: "Challenges", OK: r.Challenges.Error == ""}
+	if r.Challenges.Error != "" {
+		challenges.Detail = r.Challenges.Error
+	} else {
+		c := r.Challenges
+		challenges.Detail = fmt.Sprintf("%d challenge(s), %d/%d goals completed, %d claimed, %d active",
+			c.Challenges, c.Completed, c.Goals, c.Claimed, c.Active)
+	}
+
+	inventory := statusSection{Name: "Inventory", OK: r.Inventory.Error == ""}
+	if r.Inventory.Error != "" {
+		inventory.Detail = r.Inventory.Error
+	} else {
+		inventory.Detail = fmt.Sprintf("%d entitlement(s), %d wallet(s)", r.Inventory.Entitlements, r.Inventory.Wallets)
+	}
+
+	return []statusSection{auth, backend, challenges, inventory}
+}
+
+// whoAmIExpiry describes when the token expires, e.g. "2025-01-02 15:04 (in 59m)"
+func whoAmIExpiry(info *WhoAmIInfo) string {
+	if info.ExpiresAt == nil {
+		return "unknown"
+	}
+
+	remaining := time.Duration(info.ExpiresInSeconds) * time.Second
+	if remaining < 0 {
+		return fmt.Sprintf("%s (expired %s ago)", FormatTime(*info.ExpiresAt), (-remaining).Round(time.Minute))
+	}
+	return fmt.Sprintf("%s (in %s)", FormatTime(*info.ExpiresAt), remaining.Round(time.Minute))
+}
+
+// whoAmISubject returns the token subject, or a placeholder when the token has none
+func whoAmISubject(info *WhoAmIInfo) string {
+	if info.Subject == "" {
+		return "(none)"
+	}
+	return info.Subject
+}
+
+// goalStatusCounts describes goal counts in progression order, e.g. "not_started 2, in_progress 1, ..."
+func goalStatusCounts(summary *CompletionSummary) string {
+	parts := make([]string, 0, len(GoalStatuses))
+	for _, status := range GoalStatuses {
+		parts = append(parts, fmt.Sprintf("%s %d", status, summary.GoalsByStatus[status]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// pendingRewards describes the claimable rewards, e.g. "ITEM winter_sword x3, WALLET GOLD x250"
+func pendingRewards(summary *CompletionSummary) string {
+	if len(summary.PendingRewards) == 0 {
+		return "none"
+	}
+
+	parts := make([]string, 0, len(summary.PendingRewards))
+	for _, r := range summary.PendingRewards {
+		parts = append(parts, rewardSummary(r))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+)
+
+func TestStatusIcon(t *testing.T) {
+	glyph.Use(glyph.ASCII)
+	defer glyph.Use(glyph.Detect())
+
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{status: "not_started", want: glyph.ASCII.NotStarted},
+		{status: "in_progress", want: glyph.ASCII.InProgress},
+		{status: "completed", want: glyph.ASCII.Success},
+		{status: "claimed", want: glyph.ASCII.Claimed},
+		{status: "unknown", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			if got := StatusIcon(tt.status); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProgressBar(t *testing.T) {
+	tests := []struct {
+		name    string
+		current int
+		target  int
+		width   int
+		want    string
+	}{
+		{name: "empty", current: 0, target: 10, width: 10, want: "[----------]"},
+		{name: "partial", current: 3, target: 10, width: 10, want: "[###-------]"},
+		{name: "full", current: 10, target: 10, width: 10, want: "[##########]"},
+		{name: "over target is capped", current: 15, target: 10, width: 10, want: "[##########]"},
+		{name: "negative is empty", current: -5, target: 10, width: 10, want: "[----------]"},
+		{name: "zero target", current: 5, target: 0, width: 4, want: "[----]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProgressBar(tt.current, tt.target, tt.width, '#', '-'); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGoalProgress(t *testing.T) {
+	glyph.Use(glyph.Unicode)
+	defer glyph.Use(glyph.Detect())
+	defer SetGoalProgressWidth(DefaultGoalProgressWidth)
+
+	tests := []struct {
+		name          string
+		current       int32
+		target        int32
+		width         int
+		ascii         bool
+		colorDisabled bool
+		want          string
+	}{
+		{name: "0%", current: 0, target: 10, width: 10, want: "[░░░░░░░░░░] 0/10"},
+		{name: "partial", current: 4, target: 10, width: 10, want: "[████░░░░░░] 4/10"},
+		{name: "100%", current: 10, target: 10, width: 10, want: "[██████████] 10/10"},
+		{name: "custom width", current: 1, target: 2, width: 4, want: "[██░░] 1/2"},
+		{name: "bar off", current: 4, target: 10, width: 0, want: "4/10"},
+		{name: "ascii", current: 4, target: 10, width: 10, ascii: true, want: "[####------] 4/10"},
+		{name: "no color", current: 4, target: 10, width: 10, colorDisabled: true, want: "[####------] 4/10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetGoalProgressWidth(tt.width)
+			SetColorDisabled(tt.colorDisabled)
+			defer SetColorDisabled(false)
+			if tt.ascii {
+				glyph.Use(glyph.ASCII)
+				defer glyph.Use(glyph.Unicode)
+			}
+
+			if got := goalProgress(tt.current, tt.target); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFormatChallenge_GoalProgressBars(t *testing.T) {
+	glyph.Use(glyph.ASCII)
+	defer glyph.Use(glyph.Detect())
+
+	challenge := api.Challenge{
+		ID:   "daily",
+		Name: "Daily",
+		Goals: []api.Goal{
+			{ID: "a", Name: "Fresh", Status: "not_started", Progress: 0, Requirement: api.Requirement{TargetValue: 10}},
+			{ID: "b", Name: "Going", Status: "in_progress", Progress: 4, Requirement: api.Requirement{TargetValue: 10}},
+			{ID: "c", Name: "Done", Status: "completed", Progress: 10, Requirement: api.Requirement{TargetValue: 10}},
+		},
+	}
+	wantBars := []string{"[----------] 0/10", "[####------] 4/10", "[##########] 10/10"}
+
+	formatters := map[string]Formatter{"table": &TableFormatter{}, "text": &TextFormatter{}}
+	for name, f := range formatters {
+		t.Run(name, func(t *testing.T) {
+			result, err := f.FormatChallenge(&challenge)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, bar := range wantBars {
+				if !strings.Contains(result, bar) {
+					t.Errorf("Expected %q in output:\n%s", bar, result)
+				}
+			}
+		})
+	}
+}
+
+func TestProgressPct(t *testing.T) {
+	tests := []struct {
+		progress int32
+		target   int32
+		want     int
+	}{
+		{progress: 0, target: 10, want: 0},
+		{progress: 4, target: 10, want: 40},
+		{progress: 1, target: 3, want: 33},
+		{progress: 15, target: 10, want: 100},
+		{progress: -1, target: 10, want: 0},
+		{progress: 5, target: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := ProgressPct(tt.progress, tt.target); got != tt.want {
+			t.Errorf("ProgressPct(%d, %d): expected %d, got %d", tt.progress, tt.target, tt.want, got)
+		}
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "time", value: time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC), want: "2025-01-02 15:04"},
+		{name: "zero time", value: time.Time{}, want: ""},
+		{name: "RFC3339 string", value: "2025-01-02T15:04:05Z", want: "2025-01-02 15:04"},
+		{name: "empty string", value: "", want: ""},
+		{name: "unparseable string", value: "yesterday", want: "yesterday"},
+		{name: "other type", value: 42, want: "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatTime(tt.value); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
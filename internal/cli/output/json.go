@@ -14,10 +14,14 @@ import (
 // JSONFormatter formats output as JSON
 type JSONFormatter struct{}
 
+func init() {
+	RegisterFormatter("json", func() Formatter { return &JSONFormatter{} })
+}
+
 // FormatChallenges formats challenges as JSON
 func (f *JSONFormatter) FormatChallenges(challenges []api.Challenge) (string, error) {
 	output := map[string]interface{}{
-		"challenges": challenges,
+		"challenges": newChallengeViews(challenges),
 		"total":      len(challenges),
 	}
 
@@ -31,7 +35,12 @@ func (f *JSONFormatter) FormatChallenges(challenges []api.Challenge) (string, er
 
 // FormatChallenge formats a single challenge as JSON
 func (f *JSONFormatter) FormatChallenge(challenge *api.Challenge) (string, error) {
-	data, err := json.MarshalIndent(challenge, "", "  ")
+	var view interface{} = challenge // Marshals as null
+	if challenge != nil {
+		view = newChallengeView(challenge)
+	}
+
+	data, err := json.MarshalIndent(view, "", "  ")
 	if err != nil {
 		return "", err
 	}
@@ -59,6 +68,10 @@ func (f *JSONFormatter) FormatEventResult(result *EventResult) (string, error) {
 		output["error"] = result.Error.Error()
 	}
 
+	if result.Progress != nil {
+		output["progress"] = result.Progress
+	}
+
 	data, err := json.MarshalIndent(output, "", "  ")
 	if err != nil {
 		return "", err
@@ -84,6 +97,22 @@ func (f *JSONFormatter) FormatClaimResult(result *ClaimResult) (string, error) {
 		output["error"] = result.Error.Error()
 	}
 
+	if result.Explanation != "" {
+		output["explanation"] = result.Explanation
+	}
+
+	if result.Grant != nil {
+		output["grant"] = result.Grant
+	}
+
+	if r := result.Requirement; r != nil {
+		output["requirement"] = map[string]interface{}{
+			"stat_code":    r.StatCode,
+			"operator":     r.Operator,
+			"target_value": r.TargetValue,
+		}
+	}
+
 	data, err := json.MarshalIndent(output, "", "  ")
 	if err != nil {
 		return "", err
@@ -158,3 +187,175 @@ func (f *JSONFormatter) FormatWallets(wallets []*ags.Wallet) (string, error) {
 
 	return string(data), nil
 }
+
+// FormatWalletTransactions formats wallet transaction history as JSON
+func (f *JSONFormatter) FormatWalletTransactions(txs []*ags.WalletTransaction) (string, error) {
+	items := make([]map[string]interface{}, 0, len(txs))
+	for _, tx := range txs {
+		items = append(items, walletTransactionView(tx))
+	}
+
+	output := map[string]interface{}{
+		"transactions": items,
+		"total":        len(txs),
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// FormatNamespaces formats a list of namespaces as JSON
+func (f *JSONFormatter) FormatNamespaces(namespaces []*ags.Namespace) (string, error) {
+	output := map[string]interface{}{
+		"namespaces": namespaces,
+		"total":      len(namespaces),
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// FormatBulkResult formats a bulk operation summary as JSON
+func (f *JSONFormatter) FormatBulkResult(result *BulkResult) (string, error) {
+	items := make([]map[string]interface{}, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, bulkItemView(item))
+	}
+
+	output := map[string]interface{}{
+		"operation": result.Operation,
+		"total":     result.Total,
+		"succeeded": result.Succeeded,
+		"failed":    result.Failed,
+		"skipped":   result.Skipped,
+		"items":     items,
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// FormatVerifyRewardResult formats a reward verification result as JSON
+func (f *JSONFormatter) FormatVerifyRewardResult(result *VerifyRewardResult) (string, error) {
+	output := map[string]interface{}{
+		"challenge_id":      result.ChallengeID,
+		"goal_id":           result.GoalID,
+		"reward_type":       result.RewardType,
+		"reward_id":         result.RewardID,
+		"expected_quantity": result.ExpectedQuantity,
+		"actual_quantity":   result.ActualQuantity,
+		"verified":          result.Verified,
+	}
+
+	if result.Error != nil {
+		output["error"] = result.Error.Error()
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// FormatVersion formats build information as JSON
+func (f *JSONFormatter) FormatVersion(info *VersionInfo) (string, error) {
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// FormatClaimHistory formats claimed goals as JSON
+func (f *JSONFormatter) FormatClaimHistory(entries []ClaimHistoryEntry) (string, error) {
+	output := map[string]interface{}{
+		"claims": entries,
+		"total":  len(entries),
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// FormatStatus formats the consolidated status as JSON
+func (f *JSONFormatter) FormatStatus(report *StatusReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// walletTransactionView is a wallet transaction as emitted by the JSON formatters
+func walletTransactionView(tx *ags.WalletTransaction) map[string]interface{} {
+	return map[string]interface{}{
+		"timestamp":     tx.CreatedAt,
+		"action":        tx.Action,
+		"amount":        tx.Amount,
+		"balance_after": tx.BalanceAfter,
+		"reason":        tx.Reason,
+	}
+}
+
+// bulkItemView is a bulk operation item as emitted by the JSON formatters
+func bulkItemView(item BulkItemResult) map[string]interface{} {
+	entry := map[string]interface{}{
+		"id":          item.ID,
+		"status":      item.Status,
+		"duration_ms": item.DurationMs,
+	}
+	if item.Error != nil {
+		entry["error"] = item.Error.Error()
+	}
+	return entry
+}
+
+// FormatWhoAmI formats the token identity as JSON
+func (f *JSONFormatter) FormatWhoAmI(info *WhoAmIInfo) (string, error) {
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// FormatCompletionSummary formats the completion stats as JSON
+func (f *JSONFormatter) FormatCompletionSummary(summary *CompletionSummary) (string, error) {
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// FormatGoalGraph formats the goal prerequisite graph as JSON
+func (f *JSONFormatter) FormatGoalGraph(graph *GoalGraph) (string, error) {
+	data, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
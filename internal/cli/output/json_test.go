@@ -0,0 +1,125 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+func progressChallenge() api.Challenge {
+	goal := func(id string, progress, target int32) api.Goal {
+		return api.Goal{ID: id, Progress: progress, Requirement: api.Requirement{StatCode: "kills", Operator: "gte", TargetValue: target}}
+	}
+	return api.Challenge{
+		ID:   "daily",
+		Name: "Daily",
+		Goals: []api.Goal{
+			goal("half", 5, 10),
+			goal("two-thirds", 2, 3),  // 66.7 rounds up
+			goal("over", 15, 10),      // Capped
+			goal("zero-target", 3, 0), // No division by zero
+		},
+	}
+}
+
+func TestJSONFormatter_FormatChallenge_ProgressPercent(t *testing.T) {
+	challenge := progressChallenge()
+	result, err := (&JSONFormatter{}).FormatChallenge(&challenge)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		ID    string `json:"challengeId"`
+		Goals []struct {
+			ID              string `json:"goalId"`
+			Progress        int32  `json:"progress"`
+			ProgressPercent *int   `json:"progressPercent"`
+			Requirement     struct {
+				TargetValue int32 `json:"targetValue"`
+			} `json:"requirement"`
+		} `json:"goals"`
+	}
+	if err := json.Unmarshal([]byte(result), &decoded); err != nil {
+		t.Fatalf("Failed to decode output: %v\n%s", err, result)
+	}
+
+	if decoded.ID != "daily" {
+		t.Errorf("Expected challenge fields kept, got ID %q", decoded.ID)
+	}
+
+	want := map[string]int{"half": 50, "two-thirds": 67, "over": 100, "zero-target": 0}
+	if len(decoded.Goals) != len(want) {
+		t.Fatalf("Expected %d goals, got %d", len(want), len(decoded.Goals))
+	}
+	for _, g := range decoded.Goals {
+		if g.ProgressPercent == nil {
+			t.Errorf("Goal %s: expected progressPercent field", g.ID)
+			continue
+		}
+		if *g.ProgressPercent != want[g.ID] {
+			t.Errorf("Goal %s: expected progressPercent %d, got %d", g.ID, want[g.ID], *g.ProgressPercent)
+		}
+		if g.Requirement.TargetValue == 0 && g.ID != "zero-target" {
+			t.Errorf("Goal %s: expected raw requirement kept", g.ID)
+		}
+	}
+
+	// The wire struct still decodes the output (derived field ignored)
+	var wire api.Challenge
+	if err := json.Unmarshal([]byte(result), &wire); err != nil || len(wire.Goals) != 4 || wire.Goals[0].Progress != 5 {
+		t.Errorf("Expected output to decode into api.Challenge, got %+v (err %v)", wire, err)
+	}
+}
+
+func TestJSONFormatter_FormatChallenges_ProgressPercent(t *testing.T) {
+	result, err := (&JSONFormatter{}).FormatChallenges([]api.Challenge{progressChallenge()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		Total      int `json:"total"`
+		Challenges []struct {
+			Goals []struct {
+				ProgressPercent int `json:"progressPercent"`
+			} `json:"goals"`
+		} `json:"challenges"`
+	}
+	if err := json.Unmarshal([]byte(result), &decoded); err != nil {
+		t.Fatalf("Failed to decode output: %v", err)
+	}
+
+	if decoded.Total != 1 || len(decoded.Challenges) != 1 {
+		t.Fatalf("Expected 1 challenge, got %+v", decoded)
+	}
+	if got := decoded.Challenges[0].Goals[1].ProgressPercent; got != 67 {
+		t.Errorf("Expected 67, got %d", got)
+	}
+}
+
+func TestRoundedProgressPct(t *testing.T) {
+	tests := []struct {
+		progress, target int32
+		want             int
+	}{
+		{progress: 0, target: 10, want: 0},
+		{progress: 1, target: 3, want: 33},
+		{progress: 2, target: 3, want: 67},
+		{progress: 199, target: 200, want: 100},
+		{progress: 15, target: 10, want: 100},
+		{progress: -1, target: 10, want: 0},
+		{progress: 5, target: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := roundedProgressPct(tt.progress, tt.target); got != tt.want {
+			t.Errorf("roundedProgressPct(%d, %d): expected %d, got %d", tt.progress, tt.target, tt.want, got)
+		}
+	}
+}
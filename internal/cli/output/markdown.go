@@ -0,0 +1,342 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// MarkdownFormatter formats output as GitHub-flavored markdown, for pasting test-run
+// results into PR descriptions and wikis. Lists render as tables; a single challenge
+// renders its goals as a task list.
+type MarkdownFormatter struct{}
+
+func init() {
+	RegisterFormatter("markdown", func() Formatter { return &MarkdownFormatter{} })
+}
+
+// markdownTable renders a GFM table; cells are escaped so they can't break the row
+func markdownTable(headers []string, rows [][]string) string {
+	var b strings.Builder
+
+	writeRow := func(cells []string) {
+		escaped := make([]string, len(cells))
+		for i, cell := range cells {
+			escaped[i] = markdownEscape(cell)
+		}
+		b.WriteString("| " + strings.Join(escaped, " | ") + " |\n")
+	}
+
+	writeRow(headers)
+	separators := make([]string, len(headers))
+	for i := range separators {
+		separators[i] = "---"
+	}
+	b.WriteString("|" + strings.Join(separators, "|") + "|\n")
+
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	return b.String()
+}
+
+// markdownEscape escapes table pipes and flattens newlines in a cell
+func markdownEscape(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// markdownFields renders label/value pairs as a two-column table
+func markdownFields(fields [][2]string) string {
+	rows := make([][]string, 0, len(fields))
+	for _, field := range fields {
+		rows = append(rows, []string{field[0], field[1]})
+	}
+	return markdownTable([]string{"Field", "Value"}, rows)
+}
+
+// FormatChallenges formats challenges as a markdown table
+func (f *MarkdownFormatter) FormatChallenges(challenges []api.Challenge) (string, error) {
+	rows := make([][]string, 0, len(challenges))
+	for _, c := range challenges {
+		rows = append(rows, []string{
+			"`" + c.ID + "`",
+			c.Name,
+			fmt.Sprintf("%d/%d", c.CompletedGoals(), len(c.Goals)),
+			c.Status(),
+		})
+	}
+
+	return markdownTable([]string{"ID", "Name", "Progress", "Status"}, rows), nil
+}
+
+// FormatChallenge formats a challenge as a heading with a goal task list
+// Completed and claimed goals are checked.
+func (f *MarkdownFormatter) FormatChallenge(challenge *api.Challenge) (string, error) {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("## %s (`%s`)\n\n", challenge.Name, challenge.ID))
+	if challenge.Description != "" {
+		b.WriteString(challenge.Description + "\n\n")
+	}
+
+	for _, g := range challenge.Goals {
+		check := " "
+		if g.Status == "completed" || g.Status == "claimed" {
+			check = "x"
+		}
+
+		b.WriteString(fmt.Sprintf("- [%s] **%s** (%d/%d, %s) - reward: %s",
+			check, g.Name, g.Progress, g.Requirement.TargetValue, g.Status, rewardSummary(g.Reward)))
+		if g.Locked {
+			b.WriteString(" - locked")
+		}
+		if g.IsClaimable() {
+			b.WriteString(" - **claimable**")
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String(), nil
+}
+
+// FormatEventResult formats an event result as a markdown table
+func (f *MarkdownFormatter) FormatEventResult(result *EventResult) (string, error) {
+	fields := [][2]string{
+		{"Event", result.Event},
+		{"User ID", result.UserID},
+	}
+	if result.StatCode != "" {
+		fields = append(fields, [2]string{"Stat", fmt.Sprintf("%s = %d", result.StatCode, result.Value)})
+	}
+	fields = append(fields,
+		[2]string{"Status", result.Status},
+		[2]string{"Duration", fmt.Sprintf("%dms", result.DurationMs)},
+	)
+	if result.Error != nil {
+		fields = append(fields, [2]string{"Error", result.Error.Error()})
+	}
+	if p := result.Progress; p != nil {
+		fields = append(fields, [2]string{"Progress", fmt.Sprintf("%s/%s %d -> %d (%+d)", p.ChallengeID, p.GoalID, p.Before, p.After, p.Delta)})
+	}
+
+	return markdownFields(fields), nil
+}
+
+// FormatClaimResult formats a claim result as a markdown table
+func (f *MarkdownFormatter) FormatClaimResult(result *ClaimResult) (string, error) {
+	fields := [][2]string{
+		{"Challenge", result.ChallengeID},
+		{"Goal", result.GoalID},
+		{"Status", result.Status},
+	}
+	if result.Reward != nil {
+		fields = append(fields, [2]string{"Reward", rewardSummary(*result.Reward)})
+	}
+	if result.Error != nil {
+		fields = append(fields, [2]string{"Error", result.Error.Error()})
+	}
+	if result.Explanation != "" {
+		fields = append(fields, [2]string{"Reason", result.Explanation})
+	}
+	if g := result.Grant; g != nil {
+		status := "verified"
+		if !g.Verified {
+			status = "missing"
+		}
+		fields = append(fields, [2]string{"Granted", fmt.Sprintf("%+d (%d -> %d, expected %+d) %s", g.Delta, g.Before, g.After, g.Expected, status)})
+	}
+
+	return markdownFields(fields), nil
+}
+
+// FormatEntitlement formats a single entitlement as a one-row markdown table
+func (f *MarkdownFormatter) FormatEntitlement(ent *ags.Entitlement) (string, error) {
+	return f.FormatEntitlements([]*ags.Entitlement{ent})
+}
+
+// FormatEntitlements formats entitlements as a markdown table
+func (f *MarkdownFormatter) FormatEntitlements(ents []*ags.Entitlement) (string, error) {
+	rows := make([][]string, 0, len(ents))
+	for _, ent := range ents {
+		rows = append(rows, []string{
+			"`" + ent.ItemID + "`",
+			ent.Status,
+			fmt.Sprintf("%d", ent.Quantity),
+			FormatTime(ent.GrantedAt),
+		})
+	}
+
+	return markdownTable([]string{"Item", "Status", "Quantity", "Granted At"}, rows), nil
+}
+
+// FormatWallet formats a single wallet as a one-row markdown table
+func (f *MarkdownFormatter) FormatWallet(wallet *ags.Wallet) (string, error) {
+	return f.FormatWallets([]*ags.Wallet{wallet})
+}
+
+// FormatWallets formats wallets as a markdown table
+func (f *MarkdownFormatter) FormatWallets(wallets []*ags.Wallet) (string, error) {
+	rows := make([][]string, 0, len(wallets))
+	for _, w := range wallets {
+		rows = append(rows, []string{w.CurrencyCode, fmt.Sprintf("%d", w.Balance), w.Status})
+	}
+
+	return markdownTable([]string{"Currency", "Balance", "Status"}, rows), nil
+}
+
+// FormatWalletTransactions formats wallet transaction history as a markdown table
+func (f *MarkdownFormatter) FormatWalletTransactions(txs []*ags.WalletTransaction) (string, error) {
+	rows := make([][]string, 0, len(txs))
+	for _, tx := range txs {
+		rows = append(rows, []string{
+			FormatTime(tx.CreatedAt),
+			tx.Action,
+			fmt.Sprintf("%d", tx.Amount),
+			fmt.Sprintf("%d", tx.BalanceAfter),
+			tx.Reason,
+		})
+	}
+
+	return markdownTable([]string{"Timestamp", "Action", "Amount", "Balance After", "Reason"}, rows), nil
+}
+
+// FormatNamespaces formats namespaces as a markdown table
+func (f *MarkdownFormatter) FormatNamespaces(namespaces []*ags.Namespace) (string, error) {
+	rows := make([][]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		rows = append(rows, []string{ns.Namespace, ns.DisplayName, ns.ParentNamespace, ns.Status})
+	}
+
+	return markdownTable([]string{"Namespace", "Display Name", "Parent", "Status"}, rows), nil
+}
+
+// FormatBulkResult formats a bulk operation as a markdown table with a summary line
+func (f *MarkdownFormatter) FormatBulkResult(result *BulkResult) (string, error) {
+	rows := make([][]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		errMsg := ""
+		if item.Error != nil {
+			errMsg = item.Error.Error()
+		}
+		rows = append(rows, []string{item.ID, item.Status, fmt.Sprintf("%dms", item.DurationMs), errMsg})
+	}
+
+	summary := fmt.Sprintf("\n**%s**: %d succeeded, %d failed, %d skipped (total %d)\n",
+		result.Operation, result.Succeeded, result.Failed, result.Skipped, result.Total)
+
+	return markdownTable([]string{"ID", "Status", "Duration", "Error"}, rows) + summary, nil
+}
+
+// FormatVerifyRewardResult formats a reward verification result as a markdown table
+func (f *MarkdownFormatter) FormatVerifyRewardResult(result *VerifyRewardResult) (string, error) {
+	status := "verified"
+	if !result.Verified {
+		status = "missing"
+	}
+
+	row := []string{
+		result.RewardType,
+		"`" + result.RewardID + "`",
+		fmt.Sprintf("%d", result.ExpectedQuantity),
+		fmt.Sprintf("%d", result.ActualQuantity),
+		status,
+	}
+	out := markdownTable([]string{"Type", "Reward", "Expected", "Actual", "Status"}, [][]string{row})
+
+	if result.Error != nil {
+		out += fmt.Sprintf("\n**Error:** %s\n", markdownEscape(result.Error.Error()))
+	}
+
+	return out, nil
+}
+
+// FormatVersion formats build information as a markdown table
+func (f *MarkdownFormatter) FormatVersion(info *VersionInfo) (string, error) {
+	return markdownFields([][2]string{
+		{"Version", info.Version},
+		{"Commit", info.Commit},
+		{"Build Date", info.BuildDate},
+		{"Go", info.GoVersion},
+		{"Platform", info.Platform},
+	}), nil
+}
+
+// FormatClaimHistory formats claimed goals as a markdown table
+func (f *MarkdownFormatter) FormatClaimHistory(entries []ClaimHistoryEntry) (string, error) {
+	rows := make([][]string, 0, len(entries))
+	for _, e := range entries {
+		rows = append(rows, []string{FormatTime(e.ClaimedAt), e.ChallengeName, e.GoalName, rewardSummary(e.Reward)})
+	}
+
+	return markdownTable([]string{"Claimed At", "Challenge", "Goal", "Reward"}, rows), nil
+}
+
+// FormatStatus formats the consolidated status as a markdown table
+func (f *MarkdownFormatter) FormatStatus(report *StatusReport) (string, error) {
+	sections := statusSections(report)
+	rows := make([][]string, 0, len(sections))
+	for _, section := range sections {
+		status := "ok"
+		if !section.OK {
+			status = "error"
+		}
+		rows = append(rows, []string{section.Name, status, section.Detail})
+	}
+
+	return markdownTable([]string{"Section", "Status", "Details"}, rows), nil
+}
+
+// FormatWhoAmI formats the token identity as a markdown table
+func (f *MarkdownFormatter) FormatWhoAmI(info *WhoAmIInfo) (string, error) {
+	fields := [][2]string{
+		{"Subject", whoAmISubject(info)},
+		{"Namespace", info.Namespace},
+		{"Auth Mode", info.AuthMode},
+		{"Expires", whoAmIExpiry(info)},
+	}
+	if info.Note != "" {
+		fields = append(fields, [2]string{"Note", info.Note})
+	}
+
+	return markdownFields(fields), nil
+}
+
+// FormatCompletionSummary formats the completion stats as markdown tables
+func (f *MarkdownFormatter) FormatCompletionSummary(summary *CompletionSummary) (string, error) {
+	rows := make([][]string, 0, len(summary.Challenges))
+	for _, c := range summary.Challenges {
+		rows = append(rows, []string{
+			c.Name,
+			fmt.Sprintf("%d/%d", c.Completed, c.Goals),
+			fmt.Sprintf("%d%%", c.CompletionPct),
+			fmt.Sprintf("%d", c.Claimable),
+		})
+	}
+
+	overall := markdownFields([][2]string{
+		{"Completion", fmt.Sprintf("%d/%d goals (%d%%)", summary.Completed, summary.Goals, summary.CompletionPct)},
+		{"Goals", goalStatusCounts(summary)},
+		{"Claimable", fmt.Sprintf("%d goal(s): %s", summary.Claimable, pendingRewards(summary))},
+	})
+
+	return markdownTable([]string{"Challenge", "Completed", "%", "Claimable"}, rows) + "\n" + overall, nil
+}
+
+// FormatGoalGraph formats the goal prerequisite graph as a markdown table of each goal's edges
+func (f *MarkdownFormatter) FormatGoalGraph(graph *GoalGraph) (string, error) {
+	rows := make([][]string, 0, len(graph.Goals))
+	for _, node := range graph.Goals {
+		rows = append(rows, []string{node.ID, node.Name, node.Status, goalGraphRequires(&node), joinOrDash(node.Unlocks)})
+	}
+
+	return markdownTable([]string{"Goal", "Name", "Status", "Requires", "Unlocks"}, rows), nil
+}
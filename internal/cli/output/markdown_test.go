@@ -0,0 +1,105 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// Regenerate the golden files with: go test ./internal/cli/output -run Markdown -update
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+// assertGolden compares got with testdata/markdown/<name>.golden.md
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", "markdown", name+".golden.md")
+
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("Failed to create golden dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("Failed to update golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read golden file (run with -update to create it): %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("Output differs from %s\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+}
+
+// markdownChallenge is a challenge with one goal in each status
+func markdownChallenge() api.Challenge {
+	goal := func(id, name, status string, progress, target int32) api.Goal {
+		return api.Goal{
+			ID:          id,
+			Name:        name,
+			Status:      status,
+			Progress:    progress,
+			Requirement: api.Requirement{StatCode: "kills", Operator: "gte", TargetValue: target},
+			Reward:      api.Reward{Type: "ITEM", RewardID: id + "-reward", Quantity: 1},
+		}
+	}
+
+	claimed := goal("first-win", "First Win", "claimed", 1, 1)
+	completed := goal("kill-10", "Kill 10", "completed", 10, 10)
+	inProgress := goal("kill-50", "Kill 50", "in_progress", 25, 50)
+	inProgress.Reward = api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 100}
+	locked := goal("kill-100", "Kill 100", "not_started", 0, 100)
+	locked.Locked = true
+
+	return api.Challenge{
+		ID:          "daily-quests",
+		Name:        "Daily Quests",
+		Description: "Complete daily objectives",
+		Goals:       []api.Goal{claimed, completed, inProgress, locked},
+	}
+}
+
+func TestMarkdownFormatter_Golden(t *testing.T) {
+	f := &MarkdownFormatter{}
+	challenge := markdownChallenge()
+	weekly := api.Challenge{ID: "weekly", Name: "Weekly | PvP", Goals: []api.Goal{{ID: "login", Status: "not_started"}}} // Pipe must be escaped
+	grantedAt := time.Date(2025, 1, 2, 15, 4, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		format func() (string, error)
+	}{
+		{"challenges", func() (string, error) { return f.FormatChallenges([]api.Challenge{challenge, weekly}) }},
+		{"challenge", func() (string, error) { return f.FormatChallenge(&challenge) }},
+		{"entitlements", func() (string, error) {
+			return f.FormatEntitlements([]*ags.Entitlement{
+				{EntitlementID: "ent-1", ItemID: "first-win-reward", Status: "ACTIVE", Quantity: 1, GrantedAt: grantedAt},
+				{EntitlementID: "ent-2", ItemID: "kill-10-reward", Status: "ACTIVE", Quantity: 3, GrantedAt: grantedAt},
+			})
+		}},
+		{"wallets", func() (string, error) {
+			return f.FormatWallets([]*ags.Wallet{{WalletID: "w-1", CurrencyCode: "GOLD", Balance: 250, Status: "ACTIVE"}})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.format()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			assertGolden(t, tt.name, got)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// NDJSONFormatter formats output as JSON lines (--format jsonl): lists emit one compact
+// object per element, single results one compact object. Objects match the JSON
+// formatter's, so `jq -c` filters work on both.
+type NDJSONFormatter struct {
+	json JSONFormatter
+}
+
+func init() {
+	RegisterFormatter("jsonl", func() Formatter { return &NDJSONFormatter{} })
+}
+
+// IsJSON reports whether format produces JSON (json or jsonl)
+// Commands that marshal results themselves use it with MarshalJSON.
+func IsJSON(format string) bool {
+	return format == "json" || format == "jsonl"
+}
+
+// MarshalJSON marshals v for a JSON format: compact for jsonl, indented otherwise
+func MarshalJSON(format string, v interface{}) ([]byte, error) {
+	if format == "jsonl" {
+		return json.Marshal(v)
+	}
+	return json.MarshalIndent(v, "", "  ")
+}
+
+// jsonLines marshals each item as one compact JSON line
+func jsonLines[T any](items []T) (string, error) {
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, string(data))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// compactJSON removes the indentation from a JSON formatter result
+func compactJSON(formatted string, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+
+	var b bytes.Buffer
+	if err := json.Compact(&b, []byte(formatted)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// FormatChallenges emits one line per challenge
+func (f *NDJSONFormatter) FormatChallenges(challenges []api.Challenge) (string, error) {
+	return jsonLines(newChallengeViews(challenges))
+}
+
+// FormatChallenge emits the challenge as one line
+func (f *NDJSONFormatter) FormatChallenge(challenge *api.Challenge) (string, error) {
+	return compactJSON(f.json.FormatChallenge(challenge))
+}
+
+// FormatEventResult emits the event result as one line
+func (f *NDJSONFormatter) FormatEventResult(result *EventResult) (string, error) {
+	return compactJSON(f.json.FormatEventResult(result))
+}
+
+// FormatClaimResult emits the claim result as one line
+func (f *NDJSONFormatter) FormatClaimResult(result *ClaimResult) (string, error) {
+	return compactJSON(f.json.FormatClaimResult(result))
+}
+
+// FormatEntitlement emits the entitlement as one line
+func (f *NDJSONFormatter) FormatEntitlement(ent *ags.Entitlement) (string, error) {
+	return compactJSON(f.json.FormatEntitlement(ent))
+}
+
+// FormatEntitlements emits one line per entitlement
+func (f *NDJSONFormatter) FormatEntitlements(ents []*ags.Entitlement) (string, error) {
+	return jsonLines(ents)
+}
+
+// FormatWallet emits the wallet as one line
+func (f *NDJSONFormatter) FormatWallet(wallet *ags.Wallet) (string, error) {
+	return compactJSON(f.json.FormatWallet(wallet))
+}
+
+// FormatWallets emits one line per wallet
+func (f *NDJSONFormatter) FormatWallets(wallets []*ags.Wallet) (string, error) {
+	return jsonLines(wallets)
+}
+
+// FormatWalletTransactions emits one line per transaction
+func (f *NDJSONFormatter) FormatWalletTransactions(txs []*ags.WalletTransaction) (string, error) {
+	items := make([]map[string]interface{}, 0, len(txs))
+	for _, tx := range txs {
+		items = append(items, walletTransactionView(tx))
+	}
+	return jsonLines(items)
+}
+
+// FormatNamespaces emits one line per namespace
+func (f *NDJSONFormatter) FormatNamespaces(namespaces []*ags.Namespace) (string, error) {
+	return jsonLines(namespaces)
+}
+
+// FormatBulkResult emits one line per item, each tagged with the operation
+func (f *NDJSONFormatter) FormatBulkResult(result *BulkResult) (string, error) {
+	items := make([]map[string]interface{}, 0, len(result.Items))
+	for _, item := range result.Items {
+		entry := bulkItemView(item)
+		entry["operation"] = result.Operation
+		items = append(items, entry)
+	}
+	return jsonLines(items)
+}
+
+// FormatVerifyRewardResult emits the verification result as one line
+func (f *NDJSONFormatter) FormatVerifyRewardResult(result *VerifyRewardResult) (string, error) {
+	return compactJSON(f.json.FormatVerifyRewardResult(result))
+}
+
+// FormatVersion emits the build information as one line
+func (f *NDJSONFormatter) FormatVersion(info *VersionInfo) (string, error) {
+	return compactJSON(f.json.FormatVersion(info))
+}
+
+// FormatClaimHistory emits one line per claim
+func (f *NDJSONFormatter) FormatClaimHistory(entries []ClaimHistoryEntry) (string, error) {
+	return jsonLines(entries)
+}
+
+// FormatStatus emits the status report as one line
+func (f *NDJSONFormatter) FormatStatus(report *StatusReport) (string, error) {
+	return compactJSON(f.json.FormatStatus(report))
+}
+
+// FormatWhoAmI emits the token identity as one line
+func (f *NDJSONFormatter) FormatWhoAmI(info *WhoAmIInfo) (string, error) {
+	return compactJSON(f.json.FormatWhoAmI(info))
+}
+
+// FormatCompletionSummary emits the completion stats as one line
+func (f *NDJSONFormatter) FormatCompletionSummary(summary *CompletionSummary) (string, error) {
+	return compactJSON(f.json.FormatCompletionSummary(summary))
+}
+
+// FormatGoalGraph emits the goal prerequisite graph as one line
+func (f *NDJSONFormatter) FormatGoalGraph(graph *GoalGraph) (string, error) {
+	return compactJSON(f.json.FormatGoalGraph(graph))
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+func TestNDJSONFormatter_FormatChallenges(t *testing.T) {
+	second := progressChallenge()
+	second.ID = "weekly"
+
+	result, err := (&NDJSONFormatter{}).FormatChallenges([]api.Challenge{progressChallenge(), second})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	lines := strings.Split(result, "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected one line per challenge, got %d:\n%s", len(lines), result)
+	}
+
+	for i, id := range []string{"daily", "weekly"} {
+		var decoded struct {
+			ID    string `json:"challengeId"`
+			Goals []struct {
+				ProgressPercent int `json:"progressPercent"`
+			} `json:"goals"`
+		}
+		if err := json.Unmarshal([]byte(lines[i]), &decoded); err != nil {
+			t.Fatalf("Line %d is not a JSON object: %v", i, err)
+		}
+		if decoded.ID != id || len(decoded.Goals) != 4 || decoded.Goals[1].ProgressPercent != 67 {
+			t.Errorf("Line %d: unexpected challenge %+v", i, decoded)
+		}
+	}
+}
+
+func TestNDJSONFormatter_SingleResultsAreCompact(t *testing.T) {
+	challenge := progressChallenge()
+	result, err := (&NDJSONFormatter{}).FormatChallenge(&challenge)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if strings.Contains(result, "\n") {
+		t.Errorf("Expected a single line, got:\n%s", result)
+	}
+	if !json.Valid([]byte(result)) {
+		t.Errorf("Expected valid JSON, got %s", result)
+	}
+}
+
+func TestNDJSONFormatter_FormatBulkResult(t *testing.T) {
+	result := &BulkResult{Operation: "claim-all", Items: []BulkItemResult{}}
+	result.Add(BulkItemResult{ID: "daily/kill-10", Status: "success"})
+	result.Add(BulkItemResult{ID: "daily/login", Status: "error", Error: errors.New("HTTP 409")})
+
+	formatted, err := (&NDJSONFormatter{}).FormatBulkResult(result)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	lines := strings.Split(formatted, "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected one line per item, got %d:\n%s", len(lines), formatted)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[1]), &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded["operation"] != "claim-all" || decoded["id"] != "daily/login" || decoded["error"] != "HTTP 409" {
+		t.Errorf("Unexpected item line: %v", decoded)
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	value := map[string]int{"a": 1}
+
+	compact, _ := MarshalJSON("jsonl", value)
+	if string(compact) != `{"a":1}` {
+		t.Errorf("Expected compact JSON for jsonl, got %s", compact)
+	}
+
+	indented, _ := MarshalJSON("json", value)
+	if !strings.Contains(string(indented), "\n  \"a\": 1") {
+		t.Errorf("Expected indented JSON for json, got %s", indented)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	formatterFactories   = map[string]func() Formatter{}
+	formatterFactoriesMu sync.RWMutex
+)
+
+// RegisterFormatter makes a formatter available to NewFormatter (and --format) under name
+// Formatters register themselves from an init function, as the built-in json, table,
+// and text formatters do. Like database/sql.Register, it panics if factory is nil or
+// name is empty or already registered.
+func RegisterFormatter(name string, factory func() Formatter) {
+	formatterFactoriesMu.Lock()
+	defer formatterFactoriesMu.Unlock()
+
+	if name == "" {
+		panic("output: RegisterFormatter with empty name")
+	}
+	if factory == nil {
+		panic("output: RegisterFormatter factory is nil for " + name)
+	}
+	if _, dup := formatterFactories[name]; dup {
+		panic("output: RegisterFormatter called twice for " + name)
+	}
+	formatterFactories[name] = factory
+}
+
+// FormatterNames returns the registered format names, sorted
+func FormatterNames() []string {
+	formatterFactoriesMu.RLock()
+	defer formatterFactoriesMu.RUnlock()
+
+	names := make([]string, 0, len(formatterFactories))
+	for name := range formatterFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewFormatter creates a formatter for the given format name
+// An output template (--template/--template-file) overrides the format.
+//
+// Returns:
+//   - error: Non-nil if no formatter is registered under format
+func NewFormatter(format string) (Formatter, error) {
+	if tmpl := currentTemplate(); tmpl != nil {
+		return NewTemplateFormatter(tmpl), nil
+	}
+
+	formatterFactoriesMu.RLock()
+	factory, ok := formatterFactories[format]
+	formatterFactoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown output format %q (available: %s)", format, strings.Join(FormatterNames(), ", "))
+	}
+
+	return factory(), nil
+}
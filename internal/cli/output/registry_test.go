@@ -0,0 +1,109 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// countingFormatter is a custom formatter that reports how many challenges it was given
+type countingFormatter struct {
+	TextFormatter
+}
+
+func (f *countingFormatter) FormatChallenges(challenges []api.Challenge) (string, error) {
+	return strings.Repeat("*", len(challenges)), nil
+}
+
+// registerTestFormatter registers a formatter and removes it when the test ends
+func registerTestFormatter(t *testing.T, name string, factory func() Formatter) {
+	t.Helper()
+	RegisterFormatter(name, factory)
+	t.Cleanup(func() {
+		formatterFactoriesMu.Lock()
+		defer formatterFactoriesMu.Unlock()
+		delete(formatterFactories, name)
+	})
+}
+
+func TestNewFormatter_BuiltIns(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected Formatter
+	}{
+		{"json", &JSONFormatter{}},
+		{"table", &TableFormatter{}},
+		{"text", &TextFormatter{}},
+	}
+
+	for _, tt := range tests {
+		formatter, err := NewFormatter(tt.format)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", tt.format, err)
+		}
+		if got, want := fmt.Sprintf("%T", formatter), fmt.Sprintf("%T", tt.expected); got != want {
+			t.Errorf("Expected %s for %s, got %s", want, tt.format, got)
+		}
+	}
+}
+
+func TestRegisterFormatter_Custom(t *testing.T) {
+	registerTestFormatter(t, "stars", func() Formatter { return &countingFormatter{} })
+
+	formatter, err := NewFormatter("stars")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got, err := formatter.FormatChallenges([]api.Challenge{{ID: "a"}, {ID: "b"}, {ID: "c"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != "***" {
+		t.Errorf("Expected '***', got %q", got)
+	}
+
+	names := strings.Join(FormatterNames(), ",")
+	if names != "json,jsonl,markdown,stars,table,text" {
+		t.Errorf("Expected sorted names including stars, got %q", names)
+	}
+}
+
+func TestNewFormatter_UnknownFormat(t *testing.T) {
+	formatter, err := NewFormatter("yaml")
+	if err == nil {
+		t.Fatalf("Expected error for unknown format, got %T", formatter)
+	}
+	if !strings.Contains(err.Error(), `"yaml"`) || !strings.Contains(err.Error(), "json, jsonl, markdown, table, text") {
+		t.Errorf("Expected error naming the format and the available ones, got %q", err.Error())
+	}
+}
+
+func TestRegisterFormatter_Panics(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		factory func() Formatter
+	}{
+		{"duplicate name", "json", func() Formatter { return &JSONFormatter{} }},
+		{"empty name", "", func() Formatter { return &JSONFormatter{} }},
+		{"nil factory", "nil-factory", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected panic")
+				}
+			}()
+			RegisterFormatter(tt.format, tt.factory)
+		})
+	}
+}
@@ -7,44 +7,37 @@ package output
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 )
 
 // TableFormatter formats output as a table
 type TableFormatter struct{}
 
+func init() {
+	RegisterFormatter("table", func() Formatter { return &TableFormatter{} })
+}
+
 // FormatChallenges formats challenges as a table
 func (f *TableFormatter) FormatChallenges(challenges []api.Challenge) (string, error) {
 	var b strings.Builder
 
-	// Header
-	b.WriteString(fmt.Sprintf("%-20s %-30s %-15s %-15s\n", "ID", "NAME", "PROGRESS", "STATUS"))
-	b.WriteString(strings.Repeat("-", 80) + "\n")
+	// Header (NAME shrinks to fit the output width)
+	nameWidth := fitColumn(30, 80)
+	b.WriteString(fmt.Sprintf("%-20s %-*s %-15s %-15s\n", "ID", nameWidth, "NAME", "PROGRESS", "STATUS"))
+	b.WriteString(rule(80) + "\n")
 
 	// Rows
 	for _, c := range challenges {
-		completed := 0
-		for _, g := range c.Goals {
-			if g.Status == "completed" || g.Status == "claimed" {
-				completed++
-			}
-		}
+		progress := fmt.Sprintf("%d/%d", c.CompletedGoals(), len(c.Goals))
+		name := truncate(c.Name, nameWidth)
+		status := c.Status()
 
-		progress := fmt.Sprintf("%d/%d", completed, len(c.Goals))
-		name := truncate(c.Name, 30)
-
-		// Calculate status based on goals
-		status := "not_started"
-		if completed == len(c.Goals) {
-			status = "completed"
-		} else if completed > 0 {
-			status = "in_progress"
-		}
-
-		b.WriteString(fmt.Sprintf("%-20s %-30s %-15s %-15s\n",
-			c.ID, name, progress, status))
+		b.WriteString(fmt.Sprintf("%-20s %-*s %-15s %s\n",
+			c.ID, nameWidth, name, progress, colorStatus(status, 15)))
 	}
 
 	return b.String(), nil
@@ -58,16 +51,31 @@ func (f *TableFormatter) FormatChallenge(challenge *api.Challenge) (string, erro
 	b.WriteString(fmt.Sprintf("ID: %s\n", challenge.ID))
 	b.WriteString(fmt.Sprintf("Description: %s\n\n", challenge.Description))
 
-	// Goals header
-	b.WriteString(fmt.Sprintf("%-30s %-15s %-15s\n", "GOAL", "PROGRESS", "STATUS"))
-	b.WriteString(strings.Repeat("-", 60) + "\n")
+	// PROGRESS widens to fit the progress bars
+	progresses := make([]string, len(challenge.Goals))
+	progressWidth := 15
+	for i, g := range challenge.Goals {
+		progresses[i] = goalProgress(g.Progress, g.Requirement.TargetValue)
+		if n := utf8.RuneCountInString(progresses[i]) + 1; n > progressWidth {
+			progressWidth = n
+		}
+	}
+	lineWidth := 45 + progressWidth
+
+	// Goals header (GOAL shrinks to fit the output width)
+	nameWidth := fitColumn(30, lineWidth)
+	b.WriteString(fmt.Sprintf("%-*s %-*s %-15s\n", nameWidth, "GOAL", progressWidth, "PROGRESS", "STATUS"))
+	b.WriteString(rule(lineWidth) + "\n")
 
 	// Goals
-	for _, g := range challenge.Goals {
-		progress := fmt.Sprintf("%d/%d", g.Progress, g.Requirement.TargetValue)
-		name := truncate(g.Name, 30)
-		b.WriteString(fmt.Sprintf("%-30s %-15s %-15s\n",
-			name, progress, g.Status))
+	for i, g := range challenge.Goals {
+		name := truncate(g.Name, nameWidth)
+		b.WriteString(fmt.Sprintf("%-*s %-*s %s",
+			nameWidth, name, progressWidth, progresses[i], colorStatus(g.Status, 15)))
+		if g.IsClaimable() {
+			b.WriteString(" " + glyph.Current().Claimable + " claimable")
+		}
+		b.WriteString("\n")
 	}
 
 	return b.String(), nil
@@ -89,6 +97,14 @@ func (f *TableFormatter) FormatEventResult(result *EventResult) (string, error)
 		b.WriteString(fmt.Sprintf("Error:    %v\n", result.Error))
 	}
 
+	if p := result.Progress; p != nil {
+		status := "CHANGED"
+		if !p.Changed {
+			status = "UNCHANGED"
+		}
+		b.WriteString(fmt.Sprintf("Progress: %s/%s %d -> %d (%+d) %s\n", p.ChallengeID, p.GoalID, p.Before, p.After, p.Delta, status))
+	}
+
 	return b.String(), nil
 }
 
@@ -108,10 +124,26 @@ func (f *TableFormatter) FormatClaimResult(result *ClaimResult) (string, error)
 		b.WriteString("\n")
 	}
 
+	if result.Requirement != nil {
+		b.WriteString(fmt.Sprintf("Requires:  %s\n", result.Requirement))
+	}
+
 	if result.Error != nil {
 		b.WriteString(fmt.Sprintf("Error:     %v\n", result.Error))
 	}
 
+	if result.Explanation != "" {
+		b.WriteString(fmt.Sprintf("Reason:    %s\n", result.Explanation))
+	}
+
+	if g := result.Grant; g != nil {
+		status := "VERIFIED"
+		if !g.Verified {
+			status = "MISSING"
+		}
+		b.WriteString(fmt.Sprintf("Granted:   %+d (%d -> %d, expected %+d) %s\n", g.Delta, g.Before, g.After, g.Expected, status))
+	}
+
 	return b.String(), nil
 }
 
@@ -126,18 +158,19 @@ func (f *TableFormatter) FormatEntitlement(ent *ags.Entitlement) (string, error)
 func (f *TableFormatter) FormatEntitlements(ents []*ags.Entitlement) (string, error) {
 	var b strings.Builder
 
-	// Header
-	b.WriteString(fmt.Sprintf("%-20s %-30s %-10s %-10s %-20s\n", "ENTITLEMENT_ID", "ITEM_ID", "STATUS", "QUANTITY", "GRANTED_AT"))
-	b.WriteString(strings.Repeat("-", 90) + "\n")
+	// Header (ITEM_ID shrinks to fit the output width)
+	itemWidth := fitColumn(30, 90)
+	b.WriteString(fmt.Sprintf("%-20s %-*s %-10s %-10s %-20s\n", "ENTITLEMENT_ID", itemWidth, "ITEM_ID", "STATUS", "QUANTITY", "GRANTED_AT"))
+	b.WriteString(rule(90) + "\n")
 
 	// Rows
 	for _, ent := range ents {
 		entID := truncate(ent.EntitlementID, 20)
-		itemID := truncate(ent.ItemID, 30)
+		itemID := truncate(ent.ItemID, itemWidth)
 		grantedAt := ent.GrantedAt.Format("2006-01-02 15:04")
 
-		b.WriteString(fmt.Sprintf("%-20s %-30s %-10s %-10d %-20s\n",
-			entID, itemID, ent.Status, ent.Quantity, grantedAt))
+		b.WriteString(fmt.Sprintf("%-20s %-*s %-10s %-10d %-20s\n",
+			entID, itemWidth, itemID, ent.Status, ent.Quantity, grantedAt))
 	}
 
 	b.WriteString(fmt.Sprintf("\nTotal: %d entitlements\n", len(ents)))
@@ -158,7 +191,7 @@ func (f *TableFormatter) FormatWallets(wallets []*ags.Wallet) (string, error) {
 
 	// Header
 	b.WriteString(fmt.Sprintf("%-20s %-15s %-15s %-10s\n", "WALLET_ID", "CURRENCY", "BALANCE", "STATUS"))
-	b.WriteString(strings.Repeat("-", 60) + "\n")
+	b.WriteString(rule(60) + "\n")
 
 	// Rows
 	for _, w := range wallets {
@@ -173,6 +206,137 @@ func (f *TableFormatter) FormatWallets(wallets []*ags.Wallet) (string, error) {
 	return b.String(), nil
 }
 
+// FormatWalletTransactions formats wallet transaction history as a table
+func (f *TableFormatter) FormatWalletTransactions(txs []*ags.WalletTransaction) (string, error) {
+	var b strings.Builder
+
+	// Header (REASON shrinks to fit the output width)
+	reasonWidth := fitColumn(30, 80)
+	b.WriteString(fmt.Sprintf("%-17s %-8s %-12s %-14s %s\n", "TIMESTAMP", "ACTION", "AMOUNT", "BALANCE_AFTER", "REASON"))
+	b.WriteString(rule(80) + "\n")
+
+	// Rows
+	for _, tx := range txs {
+		b.WriteString(fmt.Sprintf("%-17s %-8s %-12d %-14d %s\n",
+			tx.CreatedAt.Format("2006-01-02 15:04"), tx.Action, tx.Amount, tx.BalanceAfter, truncate(tx.Reason, reasonWidth)))
+	}
+
+	b.WriteString(fmt.Sprintf("\nTotal: %d transactions\n", len(txs)))
+
+	return b.String(), nil
+}
+
+// FormatNamespaces formats namespaces as a table
+func (f *TableFormatter) FormatNamespaces(namespaces []*ags.Namespace) (string, error) {
+	var b strings.Builder
+
+	// Header (DISPLAY_NAME shrinks to fit the output width)
+	displayWidth := fitColumn(30, 88)
+	b.WriteString(fmt.Sprintf("%-25s %-*s %-20s %-10s\n", "NAMESPACE", displayWidth, "DISPLAY_NAME", "PARENT", "STATUS"))
+	b.WriteString(rule(88) + "\n")
+
+	// Rows
+	for _, ns := range namespaces {
+		b.WriteString(fmt.Sprintf("%-25s %-*s %-20s %-10s\n",
+			truncate(ns.Namespace, 25), displayWidth, truncate(ns.DisplayName, displayWidth), truncate(ns.ParentNamespace, 20), ns.Status))
+	}
+
+	b.WriteString(fmt.Sprintf("\nTotal: %d namespaces\n", len(namespaces)))
+
+	return b.String(), nil
+}
+
+// FormatBulkResult formats a bulk operation summary as a table
+func (f *TableFormatter) FormatBulkResult(result *BulkResult) (string, error) {
+	var b strings.Builder
+
+	// Header (ID shrinks to fit the output width)
+	idWidth := fitColumn(40, 90)
+	b.WriteString(fmt.Sprintf("%-*s %-10s %-10s %s\n", idWidth, "ID", "STATUS", "DURATION", "ERROR"))
+	b.WriteString(rule(90) + "\n")
+
+	// Rows
+	for _, item := range result.Items {
+		errMsg := ""
+		if item.Error != nil {
+			errMsg = item.Error.Error()
+		}
+
+		b.WriteString(fmt.Sprintf("%-*s %-10s %-10s %s\n",
+			idWidth, truncate(item.ID, idWidth), item.Status, fmt.Sprintf("%dms", item.DurationMs), errMsg))
+	}
+
+	b.WriteString(fmt.Sprintf("\n%s: %d succeeded, %d failed, %d skipped (total %d)\n",
+		result.Operation, result.Succeeded, result.Failed, result.Skipped, result.Total))
+
+	return b.String(), nil
+}
+
+// FormatVerifyRewardResult formats a reward verification result as a table
+func (f *TableFormatter) FormatVerifyRewardResult(result *VerifyRewardResult) (string, error) {
+	var b strings.Builder
+
+	status := "VERIFIED"
+	if !result.Verified {
+		status = "MISSING"
+	}
+
+	// Header (REWARD_ID shrinks to fit the output width)
+	rewardWidth := fitColumn(25, 70)
+	b.WriteString(fmt.Sprintf("%-10s %-*s %-10s %-10s %-10s\n", "TYPE", rewardWidth, "REWARD_ID", "EXPECTED", "ACTUAL", "STATUS"))
+	b.WriteString(rule(70) + "\n")
+	b.WriteString(fmt.Sprintf("%-10s %-*s %-10d %-10d %-10s\n",
+		result.RewardType, rewardWidth, truncate(result.RewardID, rewardWidth), result.ExpectedQuantity, result.ActualQuantity, status))
+
+	if result.Error != nil {
+		b.WriteString(fmt.Sprintf("\nError: %v\n", result.Error))
+	}
+
+	return b.String(), nil
+}
+
+// FormatVersion formats build information as a table
+func (f *TableFormatter) FormatVersion(info *VersionInfo) (string, error) {
+	var b strings.Builder
+
+	// Header
+	b.WriteString(fmt.Sprintf("%-12s %-14s %-22s %-12s %-15s\n", "VERSION", "COMMIT", "BUILD_DATE", "GO", "PLATFORM"))
+	b.WriteString(rule(80) + "\n")
+	b.WriteString(fmt.Sprintf("%-12s %-14s %-22s %-12s %-15s\n",
+		truncate(info.Version, 12), truncate(info.Commit, 14), truncate(info.BuildDate, 22), info.GoVersion, info.Platform))
+
+	return b.String(), nil
+}
+
+// FormatClaimHistory formats claimed goals as a table
+func (f *TableFormatter) FormatClaimHistory(entries []ClaimHistoryEntry) (string, error) {
+	var b strings.Builder
+
+	// Header (GOAL shrinks to fit the output width)
+	goalWidth := fitColumn(25, 90)
+	b.WriteString(fmt.Sprintf("%-17s %-20s %-*s %s\n", "CLAIMED_AT", "CHALLENGE", goalWidth, "GOAL", "REWARD"))
+	b.WriteString(rule(90) + "\n")
+
+	// Rows
+	for _, e := range entries {
+		b.WriteString(fmt.Sprintf("%-17s %-20s %-*s %s\n",
+			FormatTime(e.ClaimedAt), truncate(e.ChallengeID, 20), goalWidth, truncate(e.GoalName, goalWidth), rewardSummary(e.Reward)))
+	}
+
+	b.WriteString(fmt.Sprintf("\nTotal: %d claims\n", len(entries)))
+
+	return b.String(), nil
+}
+
+// rewardSummary describes a reward as "ITEM winter_sword x3" (quantity omitted when 1)
+func rewardSummary(r api.Reward) string {
+	s := fmt.Sprintf("%s %s", r.Type, r.RewardID)
+	if r.Quantity > 1 {
+		s += fmt.Sprintf(" x%d", r.Quantity)
+	}
+	return s
+}
+
 // truncate truncates a string to maxLen characters
 func truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
@@ -180,3 +344,82 @@ func truncate(s string, maxLen int) string {
 	}
 	return s[:maxLen-3] + "..."
 }
+
+// FormatStatus formats the consolidated status as a table
+func (f *TableFormatter) FormatStatus(report *StatusReport) (string, error) {
+	var b strings.Builder
+
+	// Header
+	b.WriteString(fmt.Sprintf("%-12s %-8s %s\n", "SECTION", "STATUS", "DETAILS"))
+	b.WriteString(rule(80) + "\n")
+
+	// Rows
+	for _, section := range statusSections(report) {
+		status := "ok"
+		if !section.OK {
+			status = "error"
+		}
+		b.WriteString(fmt.Sprintf("%-12s %-8s %s\n", strings.ToLower(section.Name), status, section.Detail))
+	}
+
+	return b.String(), nil
+}
+
+// FormatWhoAmI formats the token identity as a table
+func (f *TableFormatter) FormatWhoAmI(info *WhoAmIInfo) (string, error) {
+	var b strings.Builder
+
+	// Header
+	b.WriteString(fmt.Sprintf("%-25s %-15s %-10s %s\n", "SUB", "NAMESPACE", "AUTH_MODE", "EXPIRES"))
+	b.WriteString(rule(80) + "\n")
+	b.WriteString(fmt.Sprintf("%-25s %-15s %-10s %s\n",
+		truncate(whoAmISubject(info), 25), truncate(info.Namespace, 15), info.AuthMode, whoAmIExpiry(info)))
+
+	if info.Note != "" {
+		b.WriteString("\nNote: " + info.Note + "\n")
+	}
+
+	return b.String(), nil
+}
+
+// FormatCompletionSummary formats the completion stats as a table with an overall footer
+func (f *TableFormatter) FormatCompletionSummary(summary *CompletionSummary) (string, error) {
+	var b strings.Builder
+
+	// Header (CHALLENGE shrinks to fit the output width)
+	nameWidth := fitColumn(30, 80)
+	b.WriteString(fmt.Sprintf("%-*s %-11s %-6s %s\n", nameWidth, "CHALLENGE", "COMPLETED", "PCT", "CLAIMABLE"))
+	b.WriteString(rule(80) + "\n")
+
+	// Rows
+	for _, c := range summary.Challenges {
+		b.WriteString(fmt.Sprintf("%-*s %-11s %-6s %d\n",
+			nameWidth, truncate(c.Name, nameWidth), fmt.Sprintf("%d/%d", c.Completed, c.Goals), fmt.Sprintf("%d%%", c.CompletionPct), c.Claimable))
+	}
+
+	b.WriteString(rule(80) + "\n")
+	b.WriteString(fmt.Sprintf("%-*s %-11s %-6s %d\n",
+		nameWidth, "TOTAL", fmt.Sprintf("%d/%d", summary.Completed, summary.Goals), fmt.Sprintf("%d%%", summary.CompletionPct), summary.Claimable))
+
+	b.WriteString(fmt.Sprintf("\nGoals: %s\n", goalStatusCounts(summary)))
+	b.WriteString(fmt.Sprintf("Pending rewards: %s\n", pendingRewards(summary)))
+
+	return b.String(), nil
+}
+
+// FormatGoalGraph formats the goal prerequisite graph as a table of each goal's edges
+func (f *TableFormatter) FormatGoalGraph(graph *GoalGraph) (string, error) {
+	var b strings.Builder
+
+	// Header (GOAL shrinks to fit the output width)
+	nameWidth := fitColumn(25, 100)
+	b.WriteString(fmt.Sprintf("%-*s %-12s %-30s %s\n", nameWidth, "GOAL", "STATUS", "REQUIRES", "UNLOCKS"))
+	b.WriteString(rule(100) + "\n")
+
+	for _, node := range graph.Goals {
+		b.WriteString(fmt.Sprintf("%-*s %-12s %-30s %s\n",
+			nameWidth, truncate(node.ID, nameWidth), node.Status, truncate(goalGraphRequires(&node), 30), joinOrDash(node.Unlocks)))
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+)
+
+// TemplateFormatter renders every result through a user-supplied Go template
+// The template receives the same value the formatter method is given (e.g. []api.Challenge
+// for FormatChallenges), so `{{range .}}{{.Name}}{{end}}` lists challenge names.
+type TemplateFormatter struct {
+	tmpl *template.Template
+}
+
+// NewTemplateFormatter creates a formatter that executes tmpl
+func NewTemplateFormatter(tmpl *template.Template) *TemplateFormatter {
+	return &TemplateFormatter{tmpl: tmpl}
+}
+
+// TemplateFormat is the --format value that selects the --template/--template-file output
+// It is not a registered formatter: the template flags replace any format.
+const TemplateFormat = "template"
+
+// TemplateFuncs returns the helper functions available to output templates
+//
+//	statusIcon "completed"                         -> ✓ (glyph for a goal status)
+//	progressBar .Progress .Requirement.TargetValue -> [######--------------]
+//	progressPct .Progress .Requirement.TargetValue -> 0-100
+//	formatTime .ClaimedAt                          -> 2006-01-02 15:04 (time.Time or RFC3339 string)
+//	completedCount .Goals                          -> number of completed or claimed goals
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"statusIcon":     StatusIcon,
+		"progressBar":    templateProgressBar,
+		"progressPct":    ProgressPct,
+		"formatTime":     FormatTime,
+		"completedCount": completedCount,
+	}
+}
+
+// completedCount counts the completed or claimed goals, like Challenge.CompletedGoals
+func completedCount(goals []api.Goal) int {
+	c := api.Challenge{Goals: goals}
+	return c.CompletedGoals()
+}
+
+// templateProgressBar renders a default-width progress bar with the active glyph set
+func templateProgressBar(current, target int32) string {
+	g := glyph.Current()
+	return ProgressBar(int(current), int(target), DefaultProgressBarWidth, g.ProgressFill, g.ProgressEmpty)
+}
+
+// ParseTemplate parses an inline output template with the helper functions registered
+func ParseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(TemplateFuncs()).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// cachedTemplate is a parsed template file and the modification time it was parsed at
+type cachedTemplate struct {
+	tmpl    *template.Template
+	modTime time.Time
+}
+
+var (
+	templateCache   = map[string]cachedTemplate{}
+	templateCacheMu sync.Mutex
+)
+
+// LoadTemplateFile parses a template file with the helper functions registered
+// Parsed templates are cached by path and re-parsed only when the file changes.
+func LoadTemplateFile(path string) (*template.Template, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %s: %w", path, err)
+	}
+
+	templateCacheMu.Lock()
+	defer templateCacheMu.Unlock()
+
+	if cached, ok := templateCache[path]; ok && cached.modTime.Equal(info.ModTime()) {
+		return cached.tmpl, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %s: %w", path, err)
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Funcs(TemplateFuncs()).Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", path, err)
+	}
+
+	templateCache[path] = cachedTemplate{tmpl: tmpl, modTime: info.ModTime()}
+	return tmpl, nil
+}
+
+var (
+	activeTemplate   *template.Template
+	activeTemplateMu sync.RWMutex
+)
+
+// UseTemplate makes NewFormatter render through tmpl regardless of format (nil restores formats)
+func UseTemplate(tmpl *template.Template) {
+	activeTemplateMu.Lock()
+	defer activeTemplateMu.Unlock()
+	activeTemplate = tmpl
+}
+
+// currentTemplate returns the template set by UseTemplate, if any
+func currentTemplate() *template.Template {
+	activeTemplateMu.RLock()
+	defer activeTemplateMu.RUnlock()
+	return activeTemplate
+}
+
+// execute renders data through the template
+func (f *TemplateFormatter) execute(data interface{}) (string, error) {
+	var b strings.Builder
+	if err := f.tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// FormatChallenges renders a list of challenges
+func (f *TemplateFormatter) FormatChallenges(challenges []api.Challenge) (string, error) {
+	return f.execute(challenges)
+}
+
+// FormatChallenge renders a single challenge
+func (f *TemplateFormatter) FormatChallenge(challenge *api.Challenge) (string, error) {
+	return f.execute(challenge)
+}
+
+// FormatEventResult renders an event trigger result
+func (f *TemplateFormatter) FormatEventResult(result *EventResult) (string, error) {
+	return f.execute(result)
+}
+
+// FormatClaimResult renders a claim reward result
+func (f *TemplateFormatter) FormatClaimResult(result *ClaimResult) (string, error) {
+	return f.execute(result)
+}
+
+// FormatEntitlement renders a single entitlement
+func (f *TemplateFormatter) FormatEntitlement(ent *ags.Entitlement) (string, error) {
+	return f.execute(ent)
+}
+
+// FormatEntitlements renders a list of entitlements
+func (f *TemplateFormatter) FormatEntitlements(ents []*ags.Entitlement) (string, error) {
+	return f.execute(ents)
+}
+
+// FormatWallet renders a single wallet
+func (f *TemplateFormatter) FormatWallet(wallet *ags.Wallet) (string, error) {
+	return f.execute(wallet)
+}
+
+// FormatWallets renders a list of wallets
+func (f *TemplateFormatter) FormatWallets(wallets []*ags.Wallet) (string, error) {
+	return f.execute(wallets)
+}
+
+// FormatWalletTransactions renders wallet transaction history
+func (f *TemplateFormatter) FormatWalletTransactions(txs []*ags.WalletTransaction) (string, error) {
+	return f.execute(txs)
+}
+
+// FormatNamespaces renders a list of namespaces
+func (f *TemplateFormatter) FormatNamespaces(namespaces []*ags.Namespace) (string, error) {
+	return f.execute(namespaces)
+}
+
+// FormatBulkResult renders the summary of a multi-item operation
+func (f *TemplateFormatter) FormatBulkResult(result *BulkResult) (string, error) {
+	return f.execute(result)
+}
+
+// FormatVerifyRewardResult renders a reward verification result
+func (f *TemplateFormatter) FormatVerifyRewardResult(result *VerifyRewardResult) (string, error) {
+	return f.execute(result)
+}
+
+// FormatVersion renders build information
+func (f *TemplateFormatter) FormatVersion(info *VersionInfo) (string, error) {
+	return f.execute(info)
+}
+
+// FormatClaimHistory renders claimed goals
+func (f *TemplateFormatter) FormatClaimHistory(entries []ClaimHistoryEntry) (string, error) {
+	return f.execute(entries)
+}
+
+// FormatStatus renders the consolidated status
+func (f *TemplateFormatter) FormatStatus(report *StatusReport) (string, error) {
+	return f.execute(report)
+}
+
+// FormatWhoAmI renders the token identity
+func (f *TemplateFormatter) FormatWhoAmI(info *WhoAmIInfo) (string, error) {
+	return f.execute(info)
+}
+
+// FormatCompletionSummary renders the completion stats
+func (f *TemplateFormatter) FormatCompletionSummary(summary *CompletionSummary) (string, error) {
+	return f.execute(summary)
+}
+
+// FormatGoalGraph renders the goal prerequisite graph
+func (f *TemplateFormatter) FormatGoalGraph(graph *GoalGraph) (string, error) {
+	return f.execute(graph)
+}
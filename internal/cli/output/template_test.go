@@ -0,0 +1,153 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+)
+
+const reportTemplate = `{{range .}}# {{.Name}}
+{{range .Goals}}{{statusIcon .Status}} {{.Name}} {{progressPct .Progress .Requirement.TargetValue}}%{{if .ClaimedAt}} claimed {{formatTime .ClaimedAt}}{{end}}
+{{end}}{{end}}`
+
+func writeTemplateFile(t *testing.T, text string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "report.tmpl")
+	if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	return path
+}
+
+func TestTemplateFormatter_ChallengeReport(t *testing.T) {
+	glyph.Use(glyph.ASCII)
+	defer glyph.Use(glyph.Detect())
+
+	tmpl, err := LoadTemplateFile(writeTemplateFile(t, reportTemplate))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	challenges := []api.Challenge{
+		{
+			ID:   "daily-quests",
+			Name: "Daily Quests",
+			Goals: []api.Goal{
+				{Name: "Kill 10", Status: "in_progress", Progress: 4, Requirement: api.Requirement{TargetValue: 10}},
+				{Name: "Win 3", Status: "claimed", Progress: 5, Requirement: api.Requirement{TargetValue: 3}, ClaimedAt: "2025-01-02T15:04:05Z"},
+				{Name: "Login", Status: "not_started", Requirement: api.Requirement{TargetValue: 1}},
+			},
+		},
+	}
+
+	got, err := NewTemplateFormatter(tmpl).FormatChallenges(challenges)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := `# Daily Quests
+* Kill 10 40%
+$ Win 3 100% claimed 2025-01-02 15:04
+o Login 0%
+`
+	if got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestLoadTemplateFile_Cache(t *testing.T) {
+	path := writeTemplateFile(t, "v1")
+
+	first, err := LoadTemplateFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := LoadTemplateFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first != second {
+		t.Error("Expected cached template to be reused")
+	}
+
+	// Editing the file invalidates the cache
+	if err := os.WriteFile(path, []byte("v2"), 0o644); err != nil {
+		t.Fatalf("Failed to rewrite template: %v", err)
+	}
+	later := time.Now().Add(time.Second)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("Failed to touch template: %v", err)
+	}
+
+	third, err := LoadTemplateFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got, _ := NewTemplateFormatter(third).FormatVersion(&VersionInfo{})
+	if got != "v2" {
+		t.Errorf("Expected re-parsed template output 'v2', got %q", got)
+	}
+}
+
+func TestLoadTemplateFile_Errors(t *testing.T) {
+	if _, err := LoadTemplateFile(filepath.Join(t.TempDir(), "missing.tmpl")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+	if _, err := LoadTemplateFile(writeTemplateFile(t, "{{.Name")); err == nil {
+		t.Error("Expected error for invalid template")
+	}
+	if _, err := LoadTemplateFile(writeTemplateFile(t, "{{unknownFunc .}}")); err == nil {
+		t.Error("Expected error for unknown function")
+	}
+}
+
+func TestNewFormatter_UsesTemplate(t *testing.T) {
+	tmpl, err := ParseTemplate("{{len .}} challenges")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	UseTemplate(tmpl)
+	defer UseTemplate(nil)
+
+	formatter, err := NewFormatter("table")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got, err := formatter.FormatChallenges([]api.Challenge{{ID: "a"}, {ID: "b"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != "2 challenges" {
+		t.Errorf("Expected '2 challenges', got %q", got)
+	}
+}
+
+func TestTemplateFuncs_CompletedCount(t *testing.T) {
+	tmpl, err := ParseTemplate(`{{range .}}{{.Name}}: {{completedCount .Goals}}/{{len .Goals}}
+{{end}}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	challenges := []api.Challenge{
+		{Name: "Daily", Goals: []api.Goal{{Status: "completed"}, {Status: "claimed"}, {Status: "in_progress"}}},
+		{Name: "Weekly"},
+	}
+
+	got, err := NewTemplateFormatter(tmpl).FormatChallenges(challenges)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := "Daily: 2/3\nWeekly: 0/0\n"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
@@ -10,11 +10,16 @@ import (
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 )
 
 // TextFormatter formats output as human-readable text
 type TextFormatter struct{}
 
+func init() {
+	RegisterFormatter("text", func() Formatter { return &TextFormatter{} })
+}
+
 // FormatChallenges formats challenges as text
 func (f *TextFormatter) FormatChallenges(challenges []api.Challenge) (string, error) {
 	var b strings.Builder
@@ -22,23 +27,11 @@ func (f *TextFormatter) FormatChallenges(challenges []api.Challenge) (string, er
 	b.WriteString(fmt.Sprintf("Found %d challenge(s)\n\n", len(challenges)))
 
 	for i, c := range challenges {
-		completed := 0
-		for _, g := range c.Goals {
-			if g.Status == "completed" || g.Status == "claimed" {
-				completed++
-			}
-		}
-
-		// Calculate status based on goals
-		status := "not_started"
-		if completed == len(c.Goals) {
-			status = "completed"
-		} else if completed > 0 {
-			status = "in_progress"
-		}
+		completed := c.CompletedGoals()
+		status := c.Status()
 
 		b.WriteString(fmt.Sprintf("%d. %s (%s)\n", i+1, c.Name, c.ID))
-		b.WriteString(fmt.Sprintf("   %s\n", c.Description))
+		b.WriteString(wrapText(c.Description, "   ") + "\n")
 		b.WriteString(fmt.Sprintf("   Progress: %d/%d goals | Status: %s\n", completed, len(c.Goals), status))
 		if i < len(challenges)-1 {
 			b.WriteString("\n")
@@ -59,12 +52,28 @@ func (f *TextFormatter) FormatChallenge(challenge *api.Challenge) (string, error
 	b.WriteString("Goals:\n")
 	for _, g := range challenge.Goals {
 		status := strings.ToUpper(g.Status)
-		progress := fmt.Sprintf("(%d/%d)", g.Progress, g.Requirement.TargetValue)
+		progress := goalProgress(g.Progress, g.Requirement.TargetValue)
+		if goalProgressBarWidth() <= 0 {
+			progress = "(" + progress + ")"
+		}
 
-		b.WriteString(fmt.Sprintf("  [%s] %s %s\n", status, g.Name, progress))
+		b.WriteString(fmt.Sprintf("  [%s] %s %s", status, g.Name, progress))
+		if g.IsClaimable() {
+			b.WriteString(fmt.Sprintf("  %s CLAIMABLE", glyph.Current().Claimable))
+		}
+		b.WriteString("\n")
 
 		if g.Description != "" {
-			b.WriteString(fmt.Sprintf("    %s\n", g.Description))
+			b.WriteString(wrapText(g.Description, "    ") + "\n")
+		}
+
+		if g.Locked {
+			blocking := challenge.BlockingGoalNames(&g)
+			if len(blocking) > 0 {
+				b.WriteString(fmt.Sprintf("    %s locked by: %s\n", glyph.Current().Locked, strings.Join(blocking, ", ")))
+			} else {
+				b.WriteString(fmt.Sprintf("    %s locked by prerequisites\n", glyph.Current().Locked))
+			}
 		}
 
 		// Reward is a struct, not a pointer
@@ -82,10 +91,10 @@ func (f *TextFormatter) FormatChallenge(challenge *api.Challenge) (string, error
 // FormatEventResult formats an event result as text
 func (f *TextFormatter) FormatEventResult(result *EventResult) (string, error) {
 	if result.Error != nil {
-		return fmt.Sprintf("✗ Event failed: %v\n", result.Error), nil
+		return fmt.Sprintf("%s Event failed: %v\n", glyph.Current().Failure, result.Error), nil
 	}
 
-	msg := fmt.Sprintf("✓ Event triggered successfully (%dms)\n", result.DurationMs)
+	msg := fmt.Sprintf("%s Event triggered successfully (%dms)\n", glyph.Current().Success, result.DurationMs)
 	msg += fmt.Sprintf("  Event: %s\n", result.Event)
 	msg += fmt.Sprintf("  User: %s\n", result.UserID)
 
@@ -93,16 +102,28 @@ func (f *TextFormatter) FormatEventResult(result *EventResult) (string, error) {
 		msg += fmt.Sprintf("  Stat: %s = %d\n", result.StatCode, result.Value)
 	}
 
+	if p := result.Progress; p != nil {
+		mark := glyph.Current().Success
+		if !p.Changed {
+			mark = glyph.Current().Failure
+		}
+		msg += fmt.Sprintf("  Progress: %s/%s %d -> %d (%+d) %s\n", p.ChallengeID, p.GoalID, p.Before, p.After, p.Delta, mark)
+	}
+
 	return msg, nil
 }
 
 // FormatClaimResult formats a claim result as text
 func (f *TextFormatter) FormatClaimResult(result *ClaimResult) (string, error) {
 	if result.Error != nil {
-		return fmt.Sprintf("✗ Claim failed: %v\n", result.Error), nil
+		msg := fmt.Sprintf("%s Claim failed: %v\n", glyph.Current().Failure, result.Error)
+		if result.Explanation != "" {
+			msg += fmt.Sprintf("  Reason: %s\n", result.Explanation)
+		}
+		return msg, nil
 	}
 
-	msg := "✓ Reward claimed successfully\n"
+	msg := glyph.Current().Success + " Reward claimed successfully\n"
 	msg += fmt.Sprintf("  Challenge: %s\n", result.ChallengeID)
 	msg += fmt.Sprintf("  Goal: %s\n", result.GoalID)
 
@@ -114,12 +135,25 @@ func (f *TextFormatter) FormatClaimResult(result *ClaimResult) (string, error) {
 		msg += "\n"
 	}
 
+	if result.Requirement != nil {
+		msg += fmt.Sprintf("  Requirement: %s\n", result.Requirement)
+	}
+
+	if g := result.Grant; g != nil {
+		mark := glyph.Current().Success
+		if !g.Verified {
+			mark = glyph.Current().Failure
+		}
+		msg += fmt.Sprintf("  Granted: %+d %s (%d -> %d, expected %+d) %s\n",
+			g.Delta, g.RewardID, g.Before, g.After, g.Expected, mark)
+	}
+
 	return msg, nil
 }
 
 // FormatEntitlement formats a single entitlement as text
 func (f *TextFormatter) FormatEntitlement(ent *ags.Entitlement) (string, error) {
-	msg := "✓ Entitlement found\n"
+	msg := glyph.Current().Success + " Entitlement found\n"
 	msg += fmt.Sprintf("  Item ID: %s\n", ent.ItemID)
 	msg += fmt.Sprintf("  Status: %s\n", ent.Status)
 	msg += fmt.Sprintf("  Quantity: %d\n", ent.Quantity)
@@ -147,7 +181,7 @@ func (f *TextFormatter) FormatEntitlements(ents []*ags.Entitlement) (string, err
 
 // FormatWallet formats a single wallet as text
 func (f *TextFormatter) FormatWallet(wallet *ags.Wallet) (string, error) {
-	msg := "✓ Wallet found\n"
+	msg := glyph.Current().Success + " Wallet found\n"
 	msg += fmt.Sprintf("  Currency: %s\n", wallet.CurrencyCode)
 	msg += fmt.Sprintf("  Balance: %d\n", wallet.Balance)
 	msg += fmt.Sprintf("  Status: %s\n", wallet.Status)
@@ -166,3 +200,155 @@ func (f *TextFormatter) FormatWallets(wallets []*ags.Wallet) (string, error) {
 	}
 	return msg, nil
 }
+
+// FormatWalletTransactions formats wallet transaction history as text
+func (f *TextFormatter) FormatWalletTransactions(txs []*ags.WalletTransaction) (string, error) {
+	if len(txs) == 0 {
+		return "No transactions found\n", nil
+	}
+
+	msg := fmt.Sprintf("Found %d transaction(s):\n\n", len(txs))
+	for i, tx := range txs {
+		msg += fmt.Sprintf("%d. %s %+d %s (balance after: %d)\n",
+			i+1, tx.CreatedAt.Format("2006-01-02 15:04"), tx.Amount, tx.CurrencyCode, tx.BalanceAfter)
+		if tx.Reason != "" {
+			msg += fmt.Sprintf("   Reason: %s\n", tx.Reason)
+		}
+	}
+	return msg, nil
+}
+
+// FormatNamespaces formats namespaces as text
+func (f *TextFormatter) FormatNamespaces(namespaces []*ags.Namespace) (string, error) {
+	if len(namespaces) == 0 {
+		return "No namespaces found\n", nil
+	}
+
+	msg := fmt.Sprintf("Found %d namespace(s):\n\n", len(namespaces))
+	for i, ns := range namespaces {
+		msg += fmt.Sprintf("%d. %s - %s (%s)\n", i+1, ns.Namespace, ns.DisplayName, ns.Status)
+	}
+	return msg, nil
+}
+
+// FormatBulkResult formats a bulk operation summary as text
+func (f *TextFormatter) FormatBulkResult(result *BulkResult) (string, error) {
+	msg := fmt.Sprintf("%s: %d succeeded, %d failed, %d skipped (total %d)\n",
+		result.Operation, result.Succeeded, result.Failed, result.Skipped, result.Total)
+
+	for _, item := range result.Items {
+		switch item.Status {
+		case "success":
+			msg += fmt.Sprintf("  %s %s (%dms)\n", glyph.Current().Success, item.ID, item.DurationMs)
+		case "skipped":
+			msg += fmt.Sprintf("  - %s (skipped)\n", item.ID)
+		default:
+			msg += fmt.Sprintf("  %s %s: %v\n", glyph.Current().Failure, item.ID, item.Error)
+		}
+	}
+
+	return msg, nil
+}
+
+// FormatVerifyRewardResult formats a reward verification result as text
+func (f *TextFormatter) FormatVerifyRewardResult(result *VerifyRewardResult) (string, error) {
+	msg := glyph.Current().Success + " Reward verified\n"
+	if !result.Verified {
+		msg = glyph.Current().Failure + " Reward not verified\n"
+	}
+
+	msg += fmt.Sprintf("  Goal: %s/%s\n", result.ChallengeID, result.GoalID)
+	msg += fmt.Sprintf("  Reward: %s %s\n", result.RewardType, result.RewardID)
+	msg += fmt.Sprintf("  Expected: %d\n", result.ExpectedQuantity)
+	msg += fmt.Sprintf("  Actual: %d\n", result.ActualQuantity)
+	if result.Error != nil {
+		msg += fmt.Sprintf("  Error: %v\n", result.Error)
+	}
+	return msg, nil
+}
+
+// FormatVersion formats build information as text
+func (f *TextFormatter) FormatVersion(info *VersionInfo) (string, error) {
+	msg := fmt.Sprintf("challenge-demo %s\n", info.Version)
+	msg += fmt.Sprintf("  Commit: %s\n", info.Commit)
+	msg += fmt.Sprintf("  Built: %s\n", info.BuildDate)
+	msg += fmt.Sprintf("  Go: %s (%s)\n", info.GoVersion, info.Platform)
+	return msg, nil
+}
+
+// FormatClaimHistory formats claimed goals as text
+func (f *TextFormatter) FormatClaimHistory(entries []ClaimHistoryEntry) (string, error) {
+	if len(entries) == 0 {
+		return "No claimed goals\n", nil
+	}
+
+	msg := fmt.Sprintf("Found %d claim(s):\n\n", len(entries))
+	for i, e := range entries {
+		claimedAt := FormatTime(e.ClaimedAt)
+		if claimedAt == "" {
+			claimedAt = "unknown time"
+		}
+		msg += fmt.Sprintf("%d. %s %s - %s (%s)\n", i+1, claimedAt, e.GoalName, e.ChallengeName, e.ChallengeID)
+		msg += fmt.Sprintf("   Reward: %s\n", rewardSummary(e.Reward))
+	}
+	return msg, nil
+}
+
+// FormatStatus formats the consolidated status as text
+func (f *TextFormatter) FormatStatus(report *StatusReport) (string, error) {
+	g := glyph.Current()
+	msg := ""
+	for _, section := range statusSections(report) {
+		mark := g.Success
+		if !section.OK {
+			mark = g.Failure
+		}
+		msg += fmt.Sprintf("%-11s %s %s\n", section.Name+":", mark, section.Detail)
+	}
+	return msg, nil
+}
+
+// FormatWhoAmI formats the token identity as text
+func (f *TextFormatter) FormatWhoAmI(info *WhoAmIInfo) (string, error) {
+	msg := fmt.Sprintf("User: %s\n", whoAmISubject(info))
+	msg += fmt.Sprintf("  Namespace: %s\n", info.Namespace)
+	msg += fmt.Sprintf("  Auth mode: %s\n", info.AuthMode)
+	if info.ClientID != "" {
+		msg += fmt.Sprintf("  Client ID: %s\n", info.ClientID)
+	}
+	msg += fmt.Sprintf("  Expires: %s\n", whoAmIExpiry(info))
+	if info.Note != "" {
+		msg += fmt.Sprintf("  Note: %s\n", info.Note)
+	}
+	return msg, nil
+}
+
+// FormatCompletionSummary formats the completion stats as a compact dashboard
+func (f *TextFormatter) FormatCompletionSummary(summary *CompletionSummary) (string, error) {
+	if len(summary.Challenges) == 0 {
+		return "No challenges\n", nil
+	}
+
+	msg := fmt.Sprintf("Overall: %d/%d goals completed (%d%%)\n", summary.Completed, summary.Goals, summary.CompletionPct)
+	msg += fmt.Sprintf("  Goals: %s\n", goalStatusCounts(summary))
+	msg += fmt.Sprintf("  Claimable: %d goal(s), rewards: %s\n\n", summary.Claimable, pendingRewards(summary))
+
+	for _, c := range summary.Challenges {
+		bar := ProgressBar(c.Completed, c.Goals, 10, glyph.Current().ProgressFill, glyph.Current().ProgressEmpty)
+		msg += fmt.Sprintf("%s %3d%% %s (%d/%d", bar, c.CompletionPct, c.Name, c.Completed, c.Goals)
+		if c.Claimable > 0 {
+			msg += fmt.Sprintf(", %d claimable", c.Claimable)
+		}
+		msg += ")\n"
+	}
+	return msg, nil
+}
+
+// FormatGoalGraph formats the goal prerequisite graph as an indented tree
+func (f *TextFormatter) FormatGoalGraph(graph *GoalGraph) (string, error) {
+	msg := fmt.Sprintf("Challenge: %s (%s)\n\n", graph.Name, graph.ChallengeID)
+	if len(graph.Goals) == 0 {
+		return msg + "No goals\n", nil
+	}
+	return msg + GoalGraphTree(graph), nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"math"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// JSON view models: the api wire structs plus derived fields, so the structs
+// used to decode backend responses stay unchanged.
+
+// challengeView is a challenge as emitted by the JSON formatter
+type challengeView struct {
+	api.Challenge
+	Goals []goalView `json:"goals"` // Shadows Challenge.Goals
+}
+
+// goalView is a goal as emitted by the JSON formatter
+type goalView struct {
+	api.Goal
+	ProgressPercent int `json:"progressPercent"` // Progress as a rounded 0-100 percentage of the target
+}
+
+// newChallengeView wraps a challenge with derived goal fields
+func newChallengeView(c *api.Challenge) challengeView {
+	goals := make([]goalView, len(c.Goals))
+	for i, g := range c.Goals {
+		goals[i] = goalView{
+			Goal:            g,
+			ProgressPercent: roundedProgressPct(g.Progress, g.Requirement.TargetValue),
+		}
+	}
+	return challengeView{Challenge: *c, Goals: goals}
+}
+
+// newChallengeViews wraps a list of challenges
+func newChallengeViews(challenges []api.Challenge) []challengeView {
+	views := make([]challengeView, len(challenges))
+	for i := range challenges {
+		views[i] = newChallengeView(&challenges[i])
+	}
+	return views
+}
+
+// roundedProgressPct returns progress as a rounded percentage of target, capped to 0-100
+// Unlike ProgressPct (which floors, so display never shows 100% early), this rounds
+// to the nearest whole percent. A zero target reports 0.
+func roundedProgressPct(progress, target int32) int {
+	if target <= 0 {
+		return 0
+	}
+	pct := int(math.Round(float64(progress) * 100 / float64(target)))
+	if pct > 100 {
+		return 100
+	}
+	if pct < 0 {
+		return 0
+	}
+	return pct
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// minColumnWidth is the narrowest a shrunk table column gets (room for "x...")
+const minColumnWidth = 8
+
+var (
+	forcedWidth   int
+	forcedWidthMu sync.RWMutex
+)
+
+// detectWidth returns the terminal width of stdout, or 0 when stdout is not a terminal
+var detectWidth = func() int {
+	width, _, err := term.GetSize(os.Stdout.Fd())
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// SetOutputWidth forces the maximum line width of the text and table formatters
+// (0 restores auto-detection from the terminal)
+func SetOutputWidth(width int) {
+	forcedWidthMu.Lock()
+	defer forcedWidthMu.Unlock()
+	forcedWidth = width
+}
+
+// OutputWidth returns the maximum line width for formatted output
+// The width set by SetOutputWidth wins; otherwise the terminal width is detected.
+// 0 means unknown (e.g. piped output), in which case tables keep their natural width.
+func OutputWidth() int {
+	forcedWidthMu.RLock()
+	width := forcedWidth
+	forcedWidthMu.RUnlock()
+
+	if width > 0 {
+		return width
+	}
+	return detectWidth()
+}
+
+// fitColumn shrinks a flexible column so a table of lineWidth fits the output width
+func fitColumn(column, lineWidth int) int {
+	width := OutputWidth()
+	if width <= 0 || width >= lineWidth {
+		return column
+	}
+
+	column -= lineWidth - width
+	if column < minColumnWidth {
+		return minColumnWidth
+	}
+	return column
+}
+
+// rule returns a "-" separator of lineWidth, capped at the output width
+func rule(lineWidth int) string {
+	if width := OutputWidth(); width > 0 && width < lineWidth {
+		lineWidth = width
+	}
+	return strings.Repeat("-", lineWidth)
+}
+
+// wrapText word-wraps s to the output width, prefixing every line with indent
+// Text is returned on a single line when the width is unknown.
+func wrapText(s, indent string) string {
+	width := OutputWidth() - len(indent)
+	if width <= 0 || len(s) <= width {
+		return indent + s
+	}
+
+	var b strings.Builder
+	lineLen := 0
+	for _, word := range strings.Fields(s) {
+		switch {
+		case lineLen == 0:
+			b.WriteString(indent)
+		case lineLen+1+len(word) > width:
+			b.WriteString("\n" + indent)
+			lineLen = 0
+		default:
+			b.WriteString(" ")
+			lineLen++
+		}
+		b.WriteString(word)
+		lineLen += len(word)
+	}
+	return b.String()
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// withDetectedWidth stubs terminal width detection for the duration of a test
+func withDetectedWidth(t *testing.T, width int) {
+	t.Helper()
+	original := detectWidth
+	detectWidth = func() int { return width }
+	t.Cleanup(func() {
+		detectWidth = original
+		SetOutputWidth(0)
+	})
+}
+
+// maxLineWidth returns the length of the longest line
+func maxLineWidth(s string) int {
+	longest := 0
+	for _, line := range strings.Split(s, "\n") {
+		if len(line) > longest {
+			longest = len(line)
+		}
+	}
+	return longest
+}
+
+func widthTestChallenges() []api.Challenge {
+	return []api.Challenge{
+		{
+			ID:          "daily",
+			Name:        "An unusually long challenge name that needs truncating",
+			Description: "Complete every daily quest before the reset to earn the weekly chest and a bonus",
+			Goals:       []api.Goal{{ID: "g1", Status: "completed"}},
+		},
+	}
+}
+
+func TestOutputWidth(t *testing.T) {
+	withDetectedWidth(t, 120)
+
+	if got := OutputWidth(); got != 120 {
+		t.Errorf("Expected detected width 120, got %d", got)
+	}
+
+	SetOutputWidth(50)
+	if got := OutputWidth(); got != 50 {
+		t.Errorf("Expected forced width 50, got %d", got)
+	}
+
+	SetOutputWidth(0)
+	if got := OutputWidth(); got != 120 {
+		t.Errorf("Expected fallback to detected width 120, got %d", got)
+	}
+}
+
+func TestTableFormatter_RespectsOutputWidth(t *testing.T) {
+	withDetectedWidth(t, 0)
+
+	// Unknown width keeps the natural layout
+	natural, _ := (&TableFormatter{}).FormatChallenges(widthTestChallenges())
+	if !strings.Contains(natural, strings.Repeat("-", 80)) {
+		t.Errorf("Expected natural 80-wide rule, got:\n%s", natural)
+	}
+
+	SetOutputWidth(60)
+	forced, _ := (&TableFormatter{}).FormatChallenges(widthTestChallenges())
+	if got := maxLineWidth(forced); got > 63 {
+		// Rows are 3 wider than the rule (trailing STATUS padding)
+		t.Errorf("Expected lines to fit width 60, longest is %d:\n%s", got, forced)
+	}
+	if strings.Contains(forced, strings.Repeat("-", 61)) {
+		t.Errorf("Expected rule capped at 60, got:\n%s", forced)
+	}
+	if !strings.Contains(forced, "An unus... ") {
+		t.Errorf("Expected name truncated to the shrunk column, got:\n%s", forced)
+	}
+}
+
+func TestTableFormatter_FallsBackToDetectedWidth(t *testing.T) {
+	withDetectedWidth(t, 70)
+
+	result, _ := (&TableFormatter{}).FormatChallenges(widthTestChallenges())
+	if !strings.Contains(result, strings.Repeat("-", 70)+"\n") || strings.Contains(result, strings.Repeat("-", 71)) {
+		t.Errorf("Expected rule sized to the detected width 70, got:\n%s", result)
+	}
+}
+
+func TestTextFormatter_WrapsAtOutputWidth(t *testing.T) {
+	withDetectedWidth(t, 0)
+
+	unwrapped, _ := (&TextFormatter{}).FormatChallenges(widthTestChallenges())
+	if !strings.Contains(unwrapped, "   Complete every daily quest before the reset to earn the weekly chest and a bonus\n") {
+		t.Errorf("Expected description on one line without a width, got:\n%s", unwrapped)
+	}
+
+	SetOutputWidth(40)
+	wrapped, _ := (&TextFormatter{}).FormatChallenges(widthTestChallenges())
+	for _, line := range strings.Split(wrapped, "\n") {
+		if strings.HasPrefix(line, "   ") && !strings.Contains(line, "Progress:") && len(line) > 40 {
+			t.Errorf("Expected description lines within 40 columns, got %q", line)
+		}
+	}
+	if !strings.Contains(wrapped, "   Complete every daily quest before the\n   reset") {
+		t.Errorf("Expected description wrapped with indent, got:\n%s", wrapped)
+	}
+}
+
+func TestFitColumn(t *testing.T) {
+	withDetectedWidth(t, 0)
+
+	tests := []struct {
+		width int
+		want  int
+	}{
+		{width: 0, want: 30},   // Unknown: natural width
+		{width: 120, want: 30}, // Wider than the table: natural width
+		{width: 70, want: 20},  // Shrunk by the overflow
+		{width: 20, want: minColumnWidth},
+	}
+
+	for _, tt := range tests {
+		SetOutputWidth(tt.width)
+		if got := fitColumn(30, 80); got != tt.want {
+			t.Errorf("Width %d: expected column %d, got %d", tt.width, tt.want, got)
+		}
+	}
+}
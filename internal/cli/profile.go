@@ -0,0 +1,114 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+)
+
+// Profile modes accepted by --profile
+const (
+	ProfileCPU  = "cpu"
+	ProfileHeap = "heap"
+	ProfileBoth = "both"
+)
+
+// Profiler captures CPU and/or heap pprof profiles around a command run
+// Profiles are flushed on Stop. It installs no signal handler: Ctrl+C cancels the command's
+// context (CommandContext), and the run still returns through finishRun, which stops it.
+type Profiler struct {
+	mode    string
+	dir     string
+	cpuFile *os.File
+	files   []string
+
+	stopOnce sync.Once
+	stopErr  error
+}
+
+// StartProfiler starts profiling in the given mode, writing files to dir
+func StartProfiler(mode, dir string) (*Profiler, error) {
+	switch mode {
+	case ProfileCPU, ProfileHeap, ProfileBoth:
+	default:
+		return nil, fmt.Errorf("invalid profile mode %q (expected cpu, heap, or both)", mode)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create profile directory %s: %w", dir, err)
+	}
+
+	p := &Profiler{
+		mode: mode,
+		dir:  dir,
+	}
+
+	if mode == ProfileCPU || mode == ProfileBoth {
+		path := filepath.Join(dir, "cpu.pprof")
+		file, err := os.Create(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CPU profile %s: %w", path, err)
+		}
+
+		if err := pprof.StartCPUProfile(file); err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("failed to start CPU profile: %w", err)
+		}
+
+		p.cpuFile = file
+		p.files = append(p.files, path)
+	}
+
+	return p, nil
+}
+
+// Stop stops profiling and writes the heap profile (safe to call more than once)
+func (p *Profiler) Stop() error {
+	p.stopOnce.Do(func() {
+		if p.cpuFile != nil {
+			pprof.StopCPUProfile()
+			if err := p.cpuFile.Close(); err != nil {
+				p.stopErr = fmt.Errorf("failed to close CPU profile: %w", err)
+				return
+			}
+		}
+
+		if p.mode == ProfileHeap || p.mode == ProfileBoth {
+			p.stopErr = p.writeHeapProfile()
+		}
+	})
+
+	return p.stopErr
+}
+
+// Files returns the profile files written (or being written)
+func (p *Profiler) Files() []string {
+	return p.files
+}
+
+// writeHeapProfile writes an up-to-date heap profile
+func (p *Profiler) writeHeapProfile() error {
+	path := filepath.Join(p.dir, "heap.pprof")
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create heap profile %s: %w", path, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	runtime.GC() // Get up-to-date allocation statistics
+	if err := pprof.WriteHeapProfile(file); err != nil {
+		return fmt.Errorf("failed to write heap profile: %w", err)
+	}
+
+	p.files = append(p.files, path)
+	return nil
+}
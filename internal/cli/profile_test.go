@@ -0,0 +1,59 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestProfiler_WritesProfiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "profiles")
+
+	profiler, err := StartProfiler(ProfileBoth, dir)
+	if err != nil {
+		t.Fatalf("Failed to start profiler: %v", err)
+	}
+
+	// Short CPU-bound workload standing in for a bench run
+	sum := sha256.Sum256([]byte("seed"))
+	deadline := time.Now().Add(100 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		sum = sha256.Sum256(sum[:])
+	}
+
+	if err := profiler.Stop(); err != nil {
+		t.Fatalf("Failed to stop profiler: %v", err)
+	}
+
+	// Stop is idempotent
+	if err := profiler.Stop(); err != nil {
+		t.Fatalf("Expected second Stop to succeed, got %v", err)
+	}
+
+	for _, name := range []string{"cpu.pprof", "heap.pprof"} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("Expected %s to exist: %v", name, err)
+			continue
+		}
+		if info.Size() == 0 {
+			t.Errorf("Expected %s to be non-empty", name)
+		}
+	}
+
+	if len(profiler.Files()) != 2 {
+		t.Errorf("Expected 2 profile files, got %d", len(profiler.Files()))
+	}
+}
+
+func TestStartProfiler_InvalidMode(t *testing.T) {
+	if _, err := StartProfiler("mutex", t.TempDir()); err == nil {
+		t.Error("Expected error for invalid profile mode")
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import "strings"
+
+// RedactedValue replaces sensitive values in logs and audit entries
+const RedactedValue = "[REDACTED]"
+
+// sensitiveFlagKeywords mark flag names whose values must never be logged
+var sensitiveFlagKeywords = []string{"password", "secret", "token"}
+
+// IsSensitiveFlag returns true if a flag's value should be redacted (e.g., --password, --client-secret)
+func IsSensitiveFlag(name string) bool {
+	name = strings.ToLower(name)
+	for _, keyword := range sensitiveFlagKeywords {
+		if strings.Contains(name, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactFlag returns the value to log for a flag, redacting sensitive non-empty values
+func RedactFlag(name, value string) string {
+	if value != "" && IsSensitiveFlag(name) {
+		return RedactedValue
+	}
+	return value
+}
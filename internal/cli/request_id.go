@@ -0,0 +1,60 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/spf13/pflag"
+)
+
+// RequestIDFlag is the global flag pinning the X-Request-ID of every Challenge Service request
+const RequestIDFlag = "request-id"
+
+// requestIDPattern matches IDs that are safe to send as a header value and paste into a ticket
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// ValidateRequestIDFlag checks --request-id is a short header-safe token
+//
+// Returns:
+//   - error: Usage error if the ID has characters other than letters, digits, and ._:- or is too long
+func ValidateRequestIDFlag(flags *pflag.FlagSet) error {
+	requestID, err := flags.GetString(RequestIDFlag)
+	if err != nil || requestID == "" {
+		return nil // Flag not defined on this command tree, or not set
+	}
+
+	if !requestIDPattern.MatchString(requestID) {
+		return UsageErrorf("--%s %q must be 1-128 letters, digits, or ._:- characters", RequestIDFlag, requestID)
+	}
+	return nil
+}
+
+// applyRequestID pins the container's Challenge Service requests to --request-id, if set
+func applyRequestID(flags *pflag.FlagSet, container *app.Container) {
+	requestID, _ := flags.GetString(RequestIDFlag)
+	if requestID == "" {
+		return
+	}
+
+	if client, ok := container.APIClient.(*api.HTTPAPIClient); ok {
+		client.SetRequestID(requestID)
+	}
+}
+
+// PrintRequestID writes the request ID behind a failed command to w for support tickets
+// Nothing is written if err has no request ID or its message already shows it.
+func PrintRequestID(w io.Writer, err error) {
+	requestID := api.RequestID(err)
+	if requestID == "" || strings.Contains(err.Error(), requestID) {
+		return
+	}
+	fmt.Fprintf(w, "Request ID: %s\n", requestID)
+}
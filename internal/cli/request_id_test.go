@@ -0,0 +1,67 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/spf13/pflag"
+)
+
+func TestValidateRequestIDFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+	}{
+		{name: "not set", args: nil},
+		{name: "ticket ID", args: []string{"--request-id=SUP-1234.retry_2"}},
+		{name: "space", args: []string{"--request-id=two words"}, wantErr: true},
+		{name: "header injection", args: []string{"--request-id=a\r\nX-Evil: 1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("challenge-demo", pflag.ContinueOnError)
+			flags.String(RequestIDFlag, "", "")
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			err := ValidateRequestIDFlag(flags)
+			var usageErr *UsageError
+			if tt.wantErr != errors.As(err, &usageErr) {
+				t.Errorf("Expected usage error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPrintRequestID(t *testing.T) {
+	apiErr := &api.APIError{Code: 409, Body: "conflict", RequestID: "req-1"}
+
+	// Shown in the message already
+	var out bytes.Buffer
+	PrintRequestID(&out, fmt.Errorf("claim failed: %w", apiErr))
+	if out.Len() != 0 {
+		t.Errorf("Expected nothing when the message shows the ID, got %q", out.String())
+	}
+
+	// Hidden behind an explanation
+	PrintRequestID(&out, &ExplainedError{Msg: "claim failed: goal already claimed", Err: apiErr})
+	if out.String() != "Request ID: req-1\n" {
+		t.Errorf("Expected the request ID line, got %q", out.String())
+	}
+
+	out.Reset()
+	PrintRequestID(&out, errors.New("no request"))
+	if out.Len() != 0 {
+		t.Errorf("Expected nothing without a request ID, got %q", out.String())
+	}
+}
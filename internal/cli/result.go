@@ -0,0 +1,62 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Global flags controlling where command results go
+const (
+	OutputFlag = "output" // Write results to this file instead of stdout
+	QuietFlag  = "quiet"  // Suppress results entirely
+)
+
+// PrintResult prints a command's formatted result, ending it with a newline
+// With --output set, the result is written to that file instead (creating parent
+// directories and truncating it), and a one-line confirmation goes to stderr.
+// With --quiet, nothing is printed (an --output file is still written).
+func PrintResult(cmd *cobra.Command, result string) error {
+	if !strings.HasSuffix(result, "\n") {
+		result += "\n"
+	}
+
+	quiet := IsQuiet(cmd)
+	path, _ := cmd.Flags().GetString(OutputFlag)
+	if path == "" {
+		if quiet {
+			return nil
+		}
+		_, err := fmt.Fprint(cmd.OutOrStdout(), result)
+		return err
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create output directory %s: %w", dir, err)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(result), 0o644); err != nil {
+		return fmt.Errorf("failed to write output to %s: %w", path, err)
+	}
+
+	if !quiet {
+		fmt.Fprintf(cmd.ErrOrStderr(), "wrote %d bytes to %s\n", len(result), path)
+	}
+	return nil
+}
+
+// IsQuiet reports whether --quiet was given
+// Commands that stream output (watch) check it directly.
+func IsQuiet(cmd *cobra.Command) bool {
+	quiet, _ := cmd.Flags().GetBool(QuietFlag)
+	return quiet
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// newResultCommand builds a command with the --output flag and captured stdout/stderr
+func newResultCommand(t *testing.T, outputPath string) (*cobra.Command, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+	cmd := &cobra.Command{Use: "list-challenges"}
+	cmd.Flags().StringP(OutputFlag, "o", "", "")
+	cmd.Flags().BoolP(QuietFlag, "q", false, "")
+	if outputPath != "" {
+		if err := cmd.Flags().Set(OutputFlag, outputPath); err != nil {
+			t.Fatalf("Failed to set --output: %v", err)
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	return cmd, &stdout, &stderr
+}
+
+func TestPrintResult_Stdout(t *testing.T) {
+	tests := []struct {
+		name   string
+		result string
+		want   string
+	}{
+		{name: "adds trailing newline", result: `{"ok": true}`, want: "{\"ok\": true}\n"},
+		{name: "keeps existing newline", result: "done\n", want: "done\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, stdout, stderr := newResultCommand(t, "")
+
+			if err := PrintResult(cmd, tt.result); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if stdout.String() != tt.want {
+				t.Errorf("Expected stdout %q, got %q", tt.want, stdout.String())
+			}
+			if stderr.Len() != 0 {
+				t.Errorf("Expected no stderr, got %q", stderr.String())
+			}
+		})
+	}
+}
+
+func TestPrintResult_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runs", "2025-01-01", "challenges.json")
+	cmd, stdout, stderr := newResultCommand(t, path)
+
+	// Existing content is truncated
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("stale content that is longer than the result"), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	if err := PrintResult(cmd, `{"ok": true}`); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	if string(data) != "{\"ok\": true}\n" {
+		t.Errorf("Expected file content %q, got %q", "{\"ok\": true}\n", string(data))
+	}
+
+	if stdout.Len() != 0 {
+		t.Errorf("Expected nothing on stdout, got %q", stdout.String())
+	}
+	if want := "wrote 13 bytes to " + path; !strings.Contains(stderr.String(), want) {
+		t.Errorf("Expected stderr to contain %q, got %q", want, stderr.String())
+	}
+}
+
+func TestPrintResult_CreatesParentDirs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a", "b", "out.txt")
+	cmd, _, _ := newResultCommand(t, path)
+
+	if err := PrintResult(cmd, "hello"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Expected output file to exist, got %v", err)
+	}
+}
+
+func TestPrintResult_WriteError(t *testing.T) {
+	dir := t.TempDir()
+	cmd, _, _ := newResultCommand(t, dir) // A directory cannot be written as a file
+
+	if err := PrintResult(cmd, "hello"); err == nil {
+		t.Error("Expected error writing to a directory")
+	}
+}
+
+func TestPrintResult_Quiet(t *testing.T) {
+	cmd, stdout, stderr := newResultCommand(t, "")
+	_ = cmd.Flags().Set(QuietFlag, "true")
+
+	if err := PrintResult(cmd, "hello"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if stdout.Len() != 0 || stderr.Len() != 0 {
+		t.Errorf("Expected no output, got stdout %q, stderr %q", stdout.String(), stderr.String())
+	}
+
+	// --output is still written, without the confirmation
+	path := filepath.Join(t.TempDir(), "out.txt")
+	cmd, _, stderr = newResultCommand(t, path)
+	_ = cmd.Flags().Set(QuietFlag, "true")
+
+	if err := PrintResult(cmd, "hello"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Expected output file to be written, got %v", err)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("Expected no confirmation, got %q", stderr.String())
+	}
+}
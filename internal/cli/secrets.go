@@ -0,0 +1,114 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Environment variables supplying secrets without exposing them on the command line
+const (
+	EnvPassword     = "CHALLENGE_PASSWORD"
+	EnvClientSecret = "CHALLENGE_CLIENT_SECRET"
+	EnvAccessToken  = "CHALLENGE_ACCESS_TOKEN"
+)
+
+// secretSource describes the alternative ways to supply a secret flag
+type secretSource struct {
+	flag      string // e.g. password
+	stdinFlag string // e.g. password-stdin (empty if the secret can't be read from stdin)
+	env       string // e.g. CHALLENGE_PASSWORD
+}
+
+// secretSources lists the secret flags that can be read from stdin or the environment
+var secretSources = []secretSource{
+	{flag: "password", stdinFlag: "password-stdin", env: EnvPassword},
+	{flag: "client-secret", stdinFlag: "client-secret-stdin", env: EnvClientSecret},
+	{flag: "access-token", env: EnvAccessToken},
+}
+
+// ResolveSecrets fills --password, --client-secret, and --access-token from stdin or the environment
+//
+// Precedence: --<flag>-stdin, then the --<flag> flag, then the environment variable,
+// then the config file. A flag given together with its environment variable wins, with
+// a warning written to warn. Secret values are never written anywhere.
+//
+// Returns:
+//   - error: Non-nil if a flag is combined with its -stdin variant, both -stdin flags
+//     are set (stdin can only be read once), or stdin holds no value
+func ResolveSecrets(flags *pflag.FlagSet, stdin io.Reader, getenv func(string) string, warn io.Writer) error {
+	var fromStdin *secretSource
+	for i, src := range secretSources {
+		if !flagBool(flags, src.stdinFlag) {
+			continue
+		}
+		if fromStdin != nil {
+			return fmt.Errorf("--%s and --%s cannot both read from stdin", fromStdin.stdinFlag, src.stdinFlag)
+		}
+		if flags.Changed(src.flag) {
+			return fmt.Errorf("--%s and --%s are mutually exclusive", src.flag, src.stdinFlag)
+		}
+		fromStdin = &secretSources[i]
+	}
+
+	for _, src := range secretSources {
+		flag := flags.Lookup(src.flag)
+		if flag == nil {
+			continue
+		}
+
+		if fromStdin != nil && fromStdin.flag == src.flag {
+			value, err := readSecretLine(stdin)
+			if err != nil {
+				return fmt.Errorf("--%s: %w", src.stdinFlag, err)
+			}
+			if err := flag.Value.Set(value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value := getenv(src.env)
+		if value == "" {
+			continue
+		}
+		if flag.Changed {
+			fmt.Fprintf(warn, "Warning: both --%s and %s are set; using --%s\n", src.flag, src.env, src.flag)
+			continue
+		}
+		if err := flag.Value.Set(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readSecretLine reads the first line of r without its line ending
+func readSecretLine(r io.Reader) (string, error) {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read stdin: %w", err)
+	}
+
+	line = strings.TrimRight(line, "\r\n")
+	if line == "" {
+		return "", fmt.Errorf("no value on stdin")
+	}
+
+	return line, nil
+}
+
+// flagBool returns the value of a bool flag, or false if it is not defined
+func flagBool(flags *pflag.FlagSet, name string) bool {
+	value, err := flags.GetBool(name)
+	return err == nil && value
+}
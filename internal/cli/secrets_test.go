@@ -0,0 +1,180 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+// newSecretFlags defines the secret flags like main.go and parses args
+func newSecretFlags(t *testing.T, args ...string) *pflag.FlagSet {
+	t.Helper()
+	flags := pflag.NewFlagSet("challenge-demo", pflag.ContinueOnError)
+	flags.String("password", "", "")
+	flags.Bool("password-stdin", false, "")
+	flags.String("client-secret", "", "")
+	flags.Bool("client-secret-stdin", false, "")
+	flags.String("access-token", "", "")
+	if err := flags.Parse(args); err != nil {
+		t.Fatalf("Failed to parse flags: %v", err)
+	}
+	return flags
+}
+
+func TestResolveSecrets(t *testing.T) {
+	tests := []struct {
+		name             string
+		args             []string
+		stdin            string
+		env              map[string]string
+		wantPassword     string
+		wantClientSecret string
+		wantAccessToken  string
+		wantWarning      string
+		wantErr          string
+	}{
+		{
+			name:         "flag only",
+			args:         []string{"--password", "from-flag"},
+			wantPassword: "from-flag",
+		},
+		{
+			name:             "env only",
+			env:              map[string]string{EnvPassword: "from-env", EnvClientSecret: "secret-env"},
+			wantPassword:     "from-env",
+			wantClientSecret: "secret-env",
+		},
+		{
+			name:         "flag wins over env with warning",
+			args:         []string{"--password", "from-flag"},
+			env:          map[string]string{EnvPassword: "from-env"},
+			wantPassword: "from-flag",
+			wantWarning:  "both --password and CHALLENGE_PASSWORD are set",
+		},
+		{
+			name:            "access token from env",
+			env:             map[string]string{EnvAccessToken: "token-env"},
+			wantAccessToken: "token-env",
+		},
+		{
+			name:            "access token flag wins over env with warning",
+			args:            []string{"--access-token", "token-flag"},
+			env:             map[string]string{EnvAccessToken: "token-env"},
+			wantAccessToken: "token-flag",
+			wantWarning:     "both --access-token and CHALLENGE_ACCESS_TOKEN are set",
+		},
+		{
+			name:         "password from stdin",
+			args:         []string{"--password-stdin"},
+			stdin:        "from-stdin\n",
+			env:          map[string]string{EnvPassword: "from-env"},
+			wantPassword: "from-stdin",
+		},
+		{
+			name:             "client secret from stdin without newline",
+			args:             []string{"--client-secret-stdin"},
+			stdin:            "secret-stdin",
+			wantClientSecret: "secret-stdin",
+		},
+		{
+			name:             "CRLF is trimmed",
+			args:             []string{"--client-secret-stdin"},
+			stdin:            "secret-stdin\r\nignored\n",
+			wantClientSecret: "secret-stdin",
+		},
+		{
+			name:    "flag and stdin are exclusive",
+			args:    []string{"--password", "x", "--password-stdin"},
+			stdin:   "y\n",
+			wantErr: "mutually exclusive",
+		},
+		{
+			name:    "both stdin flags",
+			args:    []string{"--password-stdin", "--client-secret-stdin"},
+			stdin:   "y\n",
+			wantErr: "cannot both read from stdin",
+		},
+		{
+			name:    "empty stdin",
+			args:    []string{"--password-stdin"},
+			wantErr: "no value on stdin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := newSecretFlags(t, tt.args...)
+			var warn bytes.Buffer
+			getenv := func(key string) string { return tt.env[key] }
+
+			err := ResolveSecrets(flags, strings.NewReader(tt.stdin), getenv, &warn)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			password, _ := flags.GetString("password")
+			clientSecret, _ := flags.GetString("client-secret")
+			accessToken, _ := flags.GetString("access-token")
+			if password != tt.wantPassword {
+				t.Errorf("Expected password %q, got %q", tt.wantPassword, password)
+			}
+			if clientSecret != tt.wantClientSecret {
+				t.Errorf("Expected client secret %q, got %q", tt.wantClientSecret, clientSecret)
+			}
+			if accessToken != tt.wantAccessToken {
+				t.Errorf("Expected access token %q, got %q", tt.wantAccessToken, accessToken)
+			}
+
+			if tt.wantWarning == "" && warn.Len() > 0 {
+				t.Errorf("Expected no warning, got %q", warn.String())
+			}
+			if !strings.Contains(warn.String(), tt.wantWarning) {
+				t.Errorf("Expected warning containing %q, got %q", tt.wantWarning, warn.String())
+			}
+			for _, value := range tt.env {
+				if strings.Contains(warn.String(), value) {
+					t.Errorf("Expected warning not to contain secret %q, got %q", value, warn.String())
+				}
+			}
+		})
+	}
+}
+
+func TestResolveSecrets_EnvOverridesConfig(t *testing.T) {
+	flags := newSecretFlags(t)
+	if err := ApplyConfig(flags, map[string]string{"password": "from-config"}); err != nil {
+		t.Fatalf("Unexpected config error: %v", err)
+	}
+
+	getenv := func(key string) string {
+		if key == EnvPassword {
+			return "from-env"
+		}
+		return ""
+	}
+
+	var warn bytes.Buffer
+	if err := ResolveSecrets(flags, strings.NewReader(""), getenv, &warn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	password, _ := flags.GetString("password")
+	if password != "from-env" {
+		t.Errorf("Expected env to override config, got %q", password)
+	}
+	if warn.Len() > 0 {
+		t.Errorf("Expected no warning, got %q", warn.String())
+	}
+}
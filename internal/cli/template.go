@@ -0,0 +1,49 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"text/template"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/pflag"
+)
+
+// Global flags selecting an output template
+const (
+	TemplateFlag     = "template"
+	TemplateFileFlag = "template-file"
+)
+
+// ApplyTemplateFlags parses --template or --template-file and makes it the output format
+// Does nothing if neither flag is set.
+//
+// Returns:
+//   - error: Usage error if both flags are set, or the template cannot be read or parsed
+func ApplyTemplateFlags(flags *pflag.FlagSet) error {
+	text, _ := flags.GetString(TemplateFlag)
+	path, _ := flags.GetString(TemplateFileFlag)
+
+	var (
+		tmpl *template.Template
+		err  error
+	)
+	switch {
+	case text != "" && path != "":
+		return UsageErrorf("--%s and --%s are mutually exclusive", TemplateFlag, TemplateFileFlag)
+	case text != "":
+		tmpl, err = output.ParseTemplate(text)
+	case path != "":
+		tmpl, err = output.LoadTemplateFile(path)
+	default:
+		return nil
+	}
+	if err != nil {
+		return &UsageError{Err: err}
+	}
+
+	output.UseTemplate(tmpl)
+	return nil
+}
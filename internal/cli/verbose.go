@@ -0,0 +1,168 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// VerboseFlag is the global flag that dumps each API call's request and response to stderr
+const VerboseFlag = "verbose"
+
+// VerboseAPIClient wraps an APIClient and writes the request and response debug info
+// of every call to w (method, URL, status, timing, and redacted headers)
+type VerboseAPIClient struct {
+	api.APIClient
+	w io.Writer
+
+	mu           sync.Mutex
+	lastResponse *api.ResponseDebugInfo // Last dumped response, to detect calls that got none
+}
+
+// NewVerboseAPIClient wraps client, dumping debug info to w after each call
+func NewVerboseAPIClient(client api.APIClient, w io.Writer) *VerboseAPIClient {
+	return &VerboseAPIClient{APIClient: client, w: w}
+}
+
+// ListChallenges lists challenges and dumps the call
+func (c *VerboseAPIClient) ListChallenges(ctx context.Context) ([]api.Challenge, error) {
+	defer c.dump()
+	return c.APIClient.ListChallenges(ctx)
+}
+
+// ListChallengesWithFilter lists challenges and dumps the call
+func (c *VerboseAPIClient) ListChallengesWithFilter(ctx context.Context, activeOnly bool) ([]api.Challenge, error) {
+	defer c.dump()
+	return c.APIClient.ListChallengesWithFilter(ctx, activeOnly)
+}
+
+// GetChallenge gets a challenge and dumps the call
+func (c *VerboseAPIClient) GetChallenge(ctx context.Context, challengeID string) (*api.Challenge, error) {
+	defer c.dump()
+	return c.APIClient.GetChallenge(ctx, challengeID)
+}
+
+// ClaimReward claims a reward and dumps the call
+func (c *VerboseAPIClient) ClaimReward(ctx context.Context, challengeID, goalID string) (*api.ClaimResult, error) {
+	defer c.dump()
+	return c.APIClient.ClaimReward(ctx, challengeID, goalID)
+}
+
+// GetClaimHistory gets the claim history and dumps the call
+func (c *VerboseAPIClient) GetClaimHistory(ctx context.Context, challengeID string) ([]api.ClaimRecord, error) {
+	defer c.dump()
+	return c.APIClient.GetClaimHistory(ctx, challengeID)
+}
+
+// BatchClaimRewards claims several rewards and dumps the call
+func (c *VerboseAPIClient) BatchClaimRewards(ctx context.Context, challengeID string, goalIDs []string) (*api.BatchClaimResponse, error) {
+	defer c.dump()
+	return c.APIClient.BatchClaimRewards(ctx, challengeID, goalIDs)
+}
+
+// InitializePlayer initializes the player and dumps the call
+func (c *VerboseAPIClient) InitializePlayer(ctx context.Context) (*api.InitializeResponse, error) {
+	defer c.dump()
+	return c.APIClient.InitializePlayer(ctx)
+}
+
+// PreviewInitializePlayer previews initialization and dumps the call
+func (c *VerboseAPIClient) PreviewInitializePlayer(ctx context.Context) (*api.InitializeResponse, error) {
+	defer c.dump()
+	return c.APIClient.PreviewInitializePlayer(ctx)
+}
+
+// SetGoalActive sets a goal's active state and dumps the call
+func (c *VerboseAPIClient) SetGoalActive(ctx context.Context, challengeID, goalID string, isActive bool) (*api.SetGoalActiveResponse, error) {
+	defer c.dump()
+	return c.APIClient.SetGoalActive(ctx, challengeID, goalID, isActive)
+}
+
+// BatchSelectGoals selects goals and dumps the call
+func (c *VerboseAPIClient) BatchSelectGoals(ctx context.Context, challengeID string, req *api.BatchSelectRequest) (*api.BatchSelectResponse, error) {
+	defer c.dump()
+	return c.APIClient.BatchSelectGoals(ctx, challengeID, req)
+}
+
+// RandomSelectGoals selects random goals and dumps the call
+func (c *VerboseAPIClient) RandomSelectGoals(ctx context.Context, challengeID string, req *api.RandomSelectRequest) (*api.RandomSelectResponse, error) {
+	defer c.dump()
+	return c.APIClient.RandomSelectGoals(ctx, challengeID, req)
+}
+
+// GetRotationStatus gets the rotation status and dumps the call
+func (c *VerboseAPIClient) GetRotationStatus(ctx context.Context, challengeID string) (*api.RotationStatusResponse, error) {
+	defer c.dump()
+	return c.APIClient.GetRotationStatus(ctx, challengeID)
+}
+
+// dump writes the last request and response, if the wrapped client recorded any
+// Nothing is written for clients without HTTP traffic (e.g. the mock backend).
+func (c *VerboseAPIClient) dump() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	req := c.APIClient.GetLastRequest()
+	if req == nil {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "> %s %s\n", req.Method, req.URL)
+	writeHeaders(&b, "> ", req.Headers)
+
+	resp := c.APIClient.GetLastResponse()
+	if resp == nil || resp == c.lastResponse {
+		// The call failed before a response was recorded
+		b.WriteString("< (no response)\n")
+	} else {
+		c.lastResponse = resp
+		fmt.Fprintf(&b, "< %d (%dms", resp.StatusCode, resp.Duration.Milliseconds())
+		if resp.Attempts > 1 {
+			fmt.Fprintf(&b, ", %d attempts, %dms total", resp.Attempts, resp.TotalDuration().Milliseconds())
+		}
+		b.WriteString(")\n")
+		writeHeaders(&b, "< ", resp.Headers)
+	}
+	b.WriteString("\n")
+
+	_, _ = io.WriteString(c.w, b.String())
+}
+
+// writeHeaders writes headers sorted by name, one per line, with sensitive values redacted
+func writeHeaders(b *strings.Builder, prefix string, headers map[string]string) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(b, "%s%s: %s\n", prefix, name, RedactHeader(name, headers[name]))
+	}
+}
+
+// RedactHeader returns the value to print for an HTTP header
+// Authorization keeps its scheme (e.g. "Bearer [REDACTED]"); other headers are redacted
+// by the same keywords as sensitive flags.
+func RedactHeader(name, value string) string {
+	if value == "" {
+		return value
+	}
+	if strings.EqualFold(name, "Authorization") {
+		if scheme, _, ok := strings.Cut(value, " "); ok {
+			return scheme + " " + RedactedValue
+		}
+		return RedactedValue
+	}
+	return RedactFlag(name, value)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// debugAPIClient is a mock client that reports fixed request/response debug info
+type debugAPIClient struct {
+	*api.MockAPIClient
+	request  *api.RequestDebugInfo
+	response *api.ResponseDebugInfo
+}
+
+func (c *debugAPIClient) GetLastRequest() *api.RequestDebugInfo {
+	return c.request
+}
+
+func (c *debugAPIClient) GetLastResponse() *api.ResponseDebugInfo {
+	return c.response
+}
+
+func TestVerboseAPIClient_Dump(t *testing.T) {
+	inner := &debugAPIClient{
+		MockAPIClient: api.NewMockAPIClient(nil),
+		request: &api.RequestDebugInfo{
+			Method:  "GET",
+			URL:     "http://localhost:8000/v1/challenges",
+			Headers: map[string]string{"Authorization": "Bearer abc.def.ghi", "Content-Type": "application/json"},
+		},
+		response: &api.ResponseDebugInfo{
+			StatusCode:       200,
+			Headers:          map[string]string{"Content-Type": "application/json"},
+			Duration:         12 * time.Millisecond,
+			Attempts:         2,
+			AttemptDurations: []time.Duration{30 * time.Millisecond, 12 * time.Millisecond},
+		},
+	}
+
+	var buf bytes.Buffer
+	client := NewVerboseAPIClient(inner, &buf)
+	if _, err := client.ListChallenges(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	dumped := buf.String()
+	for _, want := range []string{
+		"> GET http://localhost:8000/v1/challenges\n",
+		"> Authorization: Bearer [REDACTED]\n",
+		"> Content-Type: application/json\n",
+		"< 200 (12ms, 2 attempts, 42ms total)\n",
+		"< Content-Type: application/json\n",
+	} {
+		if !strings.Contains(dumped, want) {
+			t.Errorf("Expected dump to contain %q, got:\n%s", want, dumped)
+		}
+	}
+	if strings.Contains(dumped, "abc.def.ghi") {
+		t.Errorf("Expected the bearer token to be redacted, got:\n%s", dumped)
+	}
+
+	// A failed call that recorded no new response must not repeat the previous one
+	buf.Reset()
+	inner.Error = errors.New("connection refused")
+	_, _ = client.GetChallenge(context.Background(), "daily")
+	if !strings.Contains(buf.String(), "< (no response)") {
+		t.Errorf("Expected no response to be reported, got:\n%s", buf.String())
+	}
+}
+
+func TestVerboseAPIClient_NoDebugInfo(t *testing.T) {
+	var buf bytes.Buffer
+	client := NewVerboseAPIClient(api.NewMockAPIClient(nil), &buf)
+	_, _ = client.ListChallenges(context.Background())
+
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing dumped for a client without HTTP traffic, got:\n%s", buf.String())
+	}
+}
+
+func TestRedactHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"Authorization", "Bearer abc", "Bearer " + RedactedValue},
+		{"authorization", "abc", RedactedValue},
+		{"X-Api-Token", "abc", RedactedValue},
+		{"Content-Type", "application/json", "application/json"},
+		{"Authorization", "", ""},
+	}
+
+	for _, tt := range tests {
+		if got := RedactHeader(tt.name, tt.value); got != tt.expected {
+			t.Errorf("RedactHeader(%q, %q): expected %q, got %q", tt.name, tt.value, tt.expected, got)
+		}
+	}
+}
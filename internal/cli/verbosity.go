@@ -0,0 +1,115 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/spf13/pflag"
+)
+
+// VerbosityFlag is the global counting flag (-v, -vv, -vvv) raising the log level
+const VerbosityFlag = "verbosity"
+
+// Verbosity levels selected by repeating -v
+const (
+	VerbosityWarn  = 0 // Warnings and errors only (default)
+	VerbosityInfo  = 1 // Setup and progress messages
+	VerbosityDebug = 2 // Debug messages and per-request timing
+	VerbosityTrace = 3 // Full request and response bodies
+)
+
+// LogLevel maps a verbosity count to a log level (counts above VerbosityTrace are clamped)
+func LogLevel(verbosity int) slog.Level {
+	switch {
+	case verbosity <= VerbosityWarn:
+		return slog.LevelWarn
+	case verbosity == VerbosityInfo:
+		return slog.LevelInfo
+	case verbosity == VerbosityDebug:
+		return slog.LevelDebug
+	default:
+		return api.LevelTrace
+	}
+}
+
+// LogLevelFlag is the global flag naming the log level; it overrides -v when given
+const LogLevelFlag = "log-level"
+
+// LogLevelNames lists the accepted --log-level values, most detailed first
+var LogLevelNames = []string{"trace", "debug", "info", "warn", "error"}
+
+// ParseLogLevel maps a --log-level name to a log level
+func ParseLogLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(name) {
+	case "trace":
+		return api.LevelTrace, nil
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, UsageErrorf("invalid --%s %q (must be %s)", LogLevelFlag, name, strings.Join(LogLevelNames, "|"))
+}
+
+// NewLogger creates a text logger writing to w at the level for verbosity
+func NewLogger(w io.Writer, verbosity int) *slog.Logger {
+	return NewLevelLogger(w, LogLevel(verbosity))
+}
+
+// NewLevelLogger creates a text logger writing to w, dropping records below level
+func NewLevelLogger(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// Name the custom trace level instead of printing DEBUG-4
+			if a.Key == slog.LevelKey && len(groups) == 0 && a.Value.Any() == api.LevelTrace {
+				a.Value = slog.StringValue("TRACE")
+			}
+			return a
+		},
+	}))
+}
+
+// logLevelFromFlags returns the level chosen by --log-level (flag or config), or by -v when it is not set
+func logLevelFromFlags(flags *pflag.FlagSet) (slog.Level, error) {
+	if FlagSet(flags, LogLevelFlag) {
+		level, _ := flags.GetString(LogLevelFlag)
+		return ParseLogLevel(level)
+	}
+	verbosity, _ := flags.GetCount(VerbosityFlag)
+	return LogLevel(verbosity), nil
+}
+
+// ApplyLogFlags installs the default logger for --log-level (or --verbosity) and --log-file
+// Logs go to w unless --log-file is set. The container and API client log through
+// slog.Default(), so this must run before the container is created.
+func ApplyLogFlags(flags *pflag.FlagSet, w io.Writer) error {
+	level, err := logLevelFromFlags(flags)
+	if err != nil {
+		return err
+	}
+
+	if path, _ := flags.GetString(LogFileFlag); path != "" {
+		// Left open until the process exits; every record is written straight through
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to open --%s: %w", LogFileFlag, err)
+		}
+		w = file
+	}
+
+	slog.SetDefault(NewLevelLogger(w, level))
+	return nil
+}
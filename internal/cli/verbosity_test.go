@@ -0,0 +1,188 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/spf13/pflag"
+)
+
+func TestLogLevel(t *testing.T) {
+	tests := []struct {
+		verbosity int
+		expected  slog.Level
+	}{
+		{0, slog.LevelWarn},
+		{1, slog.LevelInfo},
+		{2, slog.LevelDebug},
+		{3, api.LevelTrace},
+		{5, api.LevelTrace},
+	}
+
+	for _, tt := range tests {
+		if got := LogLevel(tt.verbosity); got != tt.expected {
+			t.Errorf("Verbosity %d: expected %v, got %v", tt.verbosity, tt.expected, got)
+		}
+	}
+}
+
+func TestVerbosity_APIClientLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"challenges":[{"challengeId":"c1","name":"Challenge 1"}]}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		verbosity  int
+		wantInfo   bool
+		wantTiming bool
+		wantBodies bool
+	}{
+		{verbosity: 0},
+		{verbosity: 1, wantInfo: true},
+		{verbosity: 2, wantInfo: true, wantTiming: true},
+		{verbosity: 3, wantInfo: true, wantTiming: true, wantBodies: true},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		logger := NewLogger(&buf, tt.verbosity)
+		logger.Info("setup done")
+
+		client := api.NewHTTPAPIClient(server.URL, auth.NewMockAuthProvider("test-user", "demo"))
+		client.SetLogger(logger)
+		challenges, err := client.ListChallenges(context.Background())
+		if err != nil {
+			t.Fatalf("Verbosity %d: unexpected error: %v", tt.verbosity, err)
+		}
+
+		// Dumping the body must leave it readable for the client
+		if len(challenges) != 1 || challenges[0].ID != "c1" {
+			t.Errorf("Verbosity %d: expected challenge c1, got %+v", tt.verbosity, challenges)
+		}
+
+		logged := buf.String()
+		checks := []struct {
+			what string
+			want bool
+			got  bool
+		}{
+			{"info message", tt.wantInfo, strings.Contains(logged, "setup done")},
+			{"request timing", tt.wantTiming, strings.Contains(logged, `msg="HTTP response"`) && strings.Contains(logged, "duration_ms=")},
+			{"response body", tt.wantBodies, strings.Contains(logged, "level=TRACE") && strings.Contains(logged, "challengeId")},
+		}
+		for _, c := range checks {
+			if c.got != c.want {
+				t.Errorf("Verbosity %d: expected %s logged=%v, got:\n%s", tt.verbosity, c.what, c.want, logged)
+			}
+		}
+	}
+}
+
+func TestApplyLogFlags(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		config    map[string]string
+		wantDebug bool
+		wantWarn  bool
+		wantErr   bool
+	}{
+		{name: "default", args: nil, wantWarn: true},
+		{name: "log level from config", config: map[string]string{LogLevelFlag: "debug"}, wantDebug: true, wantWarn: true},
+		{name: "log level flag overrides config", args: []string{"--log-level=error"}, config: map[string]string{LogLevelFlag: "debug"}},
+		{name: "verbosity", args: []string{"-vv"}, wantDebug: true, wantWarn: true},
+		{name: "log level", args: []string{"--log-level=debug"}, wantDebug: true, wantWarn: true},
+		{name: "log level overrides verbosity", args: []string{"-vv", "--log-level=error"}},
+		{name: "invalid log level", args: []string{"--log-level=loud"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := slog.Default()
+			t.Cleanup(func() { slog.SetDefault(previous) })
+
+			flags := newLogFlags()
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err := ApplyConfig(flags, tt.config); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var buf bytes.Buffer
+			err := ApplyLogFlags(flags, &buf)
+			if tt.wantErr {
+				var usageErr *UsageError
+				if !errors.As(err, &usageErr) {
+					t.Errorf("Expected usage error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			slog.Debug("debug message")
+			slog.Warn("warn message")
+			if got := strings.Contains(buf.String(), "debug message"); got != tt.wantDebug {
+				t.Errorf("Expected debug logged=%v, got %q", tt.wantDebug, buf.String())
+			}
+			if got := strings.Contains(buf.String(), "warn message"); got != tt.wantWarn {
+				t.Errorf("Expected warn logged=%v, got %q", tt.wantWarn, buf.String())
+			}
+		})
+	}
+}
+
+func TestApplyLogFlags_LogFile(t *testing.T) {
+	previous := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	path := filepath.Join(t.TempDir(), "demo.log")
+	flags := newLogFlags()
+	if err := flags.Parse([]string{"--log-file=" + path}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var stderr bytes.Buffer
+	if err := ApplyLogFlags(flags, &stderr); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	slog.Warn("to the file")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	if !strings.Contains(string(data), "to the file") {
+		t.Errorf("Expected the warning in the log file, got %q", data)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("Expected nothing on stderr, got %q", stderr.String())
+	}
+}
+
+// newLogFlags defines the logging flags as the root command does
+func newLogFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.CountP(VerbosityFlag, "v", "")
+	flags.String(LogLevelFlag, "", "")
+	flags.String(LogFileFlag, "", "")
+	return flags
+}
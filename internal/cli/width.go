@@ -0,0 +1,61 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/pflag"
+)
+
+// OutputWidthFlag is the global flag forcing the output line width
+const OutputWidthFlag = "output-width"
+
+// ApplyOutputWidthFlag forces the text/table formatter width from --output-width
+// Does nothing if neither the flag nor the config file sets it, leaving the width auto-detected
+// from the terminal.
+//
+// Returns:
+//   - error: Usage error if the width is not a positive integer
+func ApplyOutputWidthFlag(flags *pflag.FlagSet) error {
+	if !FlagSet(flags, OutputWidthFlag) {
+		return nil
+	}
+
+	width, err := flags.GetInt(OutputWidthFlag)
+	if err != nil {
+		return &UsageError{Err: err}
+	}
+	if width <= 0 {
+		return UsageErrorf("--%s must be a positive integer, got %d", OutputWidthFlag, width)
+	}
+
+	output.SetOutputWidth(width)
+	return nil
+}
+
+// ProgressBarWidthFlag is the global flag setting the goal progress bar width
+const ProgressBarWidthFlag = "progress-bar-width"
+
+// ApplyProgressBarWidthFlag sets the goal progress bar width from --progress-bar-width
+// Does nothing if neither the flag nor the config file sets it; 0 turns the bars off.
+//
+// Returns:
+//   - error: Usage error if the width is negative
+func ApplyProgressBarWidthFlag(flags *pflag.FlagSet) error {
+	if !FlagSet(flags, ProgressBarWidthFlag) {
+		return nil
+	}
+
+	width, err := flags.GetInt(ProgressBarWidthFlag)
+	if err != nil {
+		return &UsageError{Err: err}
+	}
+	if width < 0 {
+		return UsageErrorf("--%s must not be negative, got %d", ProgressBarWidthFlag, width)
+	}
+
+	output.SetGoalProgressWidth(width)
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/spf13/pflag"
+)
+
+func TestApplyOutputWidthFlag(t *testing.T) {
+	t.Cleanup(func() { output.SetOutputWidth(0) })
+
+	tests := []struct {
+		name      string
+		args      []string
+		config    map[string]string
+		wantErr   bool
+		wantWidth int
+	}{
+		{name: "not set", args: nil, wantWidth: 0},
+		{name: "from config", config: map[string]string{OutputWidthFlag: "64"}, wantWidth: 64},
+		{name: "zero from config", config: map[string]string{OutputWidthFlag: "0"}, wantErr: true},
+		{name: "positive", args: []string{"--output-width=72"}, wantWidth: 72},
+		{name: "zero", args: []string{"--output-width=0"}, wantErr: true},
+		{name: "negative", args: []string{"--output-width=-5"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output.SetOutputWidth(0)
+			flags := pflag.NewFlagSet("challenge-demo", pflag.ContinueOnError)
+			flags.Int(OutputWidthFlag, 0, "")
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+			if err := ApplyConfig(flags, tt.config); err != nil {
+				t.Fatalf("Failed to apply config: %v", err)
+			}
+
+			err := ApplyOutputWidthFlag(flags)
+			if tt.wantErr {
+				var usageErr *UsageError
+				if !errors.As(err, &usageErr) {
+					t.Errorf("Expected usage error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.wantWidth > 0 && output.OutputWidth() != tt.wantWidth {
+				t.Errorf("Expected output width %d, got %d", tt.wantWidth, output.OutputWidth())
+			}
+		})
+	}
+}
+
+func TestApplyProgressBarWidthFlag(t *testing.T) {
+	t.Cleanup(func() { output.SetGoalProgressWidth(output.DefaultGoalProgressWidth) })
+
+	tests := []struct {
+		name    string
+		args    []string
+		config  map[string]string
+		wantErr bool
+	}{
+		{name: "not set", args: nil},
+		{name: "negative from config", config: map[string]string{ProgressBarWidthFlag: "-1"}, wantErr: true},
+		{name: "positive", args: []string{"--progress-bar-width=20"}},
+		{name: "zero turns bars off", args: []string{"--progress-bar-width=0"}},
+		{name: "negative", args: []string{"--progress-bar-width=-1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("challenge-demo", pflag.ContinueOnError)
+			flags.Int(ProgressBarWidthFlag, output.DefaultGoalProgressWidth, "")
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+			if err := ApplyConfig(flags, tt.config); err != nil {
+				t.Fatalf("Failed to apply config: %v", err)
+			}
+
+			err := ApplyProgressBarWidthFlag(flags)
+			if tt.wantErr {
+				var usageErr *UsageError
+				if !errors.As(err, &usageErr) {
+					t.Errorf("Expected usage error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -6,15 +6,18 @@ package events
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"os"
 	"time"
 
 	accountpb "extend-challenge-event-handler/pkg/pb/accelbyte-asyncapi/iam/account/v1"
 	statpb "extend-challenge-event-handler/pkg/pb/accelbyte-asyncapi/social/statistic/v1"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
 )
 
 // LocalEventTrigger triggers events by calling the event handler's gRPC services directly.
@@ -30,27 +33,100 @@ type LocalEventTrigger struct {
 	eventHandlerAddr string
 }
 
+// DefaultDialTimeout bounds the blocking connect to the event handler
+const DefaultDialTimeout = 5 * time.Second
+
+// triggerConfig holds the connection settings set by TriggerOptions
+type triggerConfig struct {
+	tls         bool
+	caFile      string // PEM CA bundle; empty uses the system roots
+	serverName  string // Overrides the host name checked against the certificate
+	dialTimeout time.Duration
+}
+
+// TriggerOption configures the connection of a LocalEventTrigger
+type TriggerOption func(*triggerConfig)
+
+// WithTLS connects over TLS instead of plaintext
+// caFile optionally names a PEM CA bundle to trust instead of the system roots, and
+// serverName optionally overrides the host name verified against the server certificate.
+func WithTLS(caFile, serverName string) TriggerOption {
+	return func(c *triggerConfig) {
+		c.tls = true
+		c.caFile = caFile
+		c.serverName = serverName
+	}
+}
+
+// WithDialTimeout sets how long to wait for the connection (non-positive values keep the default)
+func WithDialTimeout(timeout time.Duration) TriggerOption {
+	return func(c *triggerConfig) {
+		if timeout > 0 {
+			c.dialTimeout = timeout
+		}
+	}
+}
+
+// transportCredentials returns plaintext credentials, or TLS credentials if enabled
+func (c *triggerConfig) transportCredentials() (credentials.TransportCredentials, error) {
+	if !c.tls {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: c.serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if c.caFile != "" {
+		pemBytes, err := os.ReadFile(c.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pemBytes) {
+			return nil, fmt.Errorf("no PEM certificates found in CA file %s", c.caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
 // NewLocalEventTrigger creates a new LocalEventTrigger that connects to the event handler.
 //
+// The connection is plaintext with a DefaultDialTimeout unless configured otherwise.
+//
 // Parameters:
 //   - eventHandlerAddr: Event handler gRPC address (e.g., "localhost:6565")
+//   - opts: Optional connection settings (WithTLS, WithDialTimeout)
 //
 // Returns:
 //   - *LocalEventTrigger: Ready-to-use event trigger
-//   - error: Non-nil if connection to event handler failed
-func NewLocalEventTrigger(eventHandlerAddr string) (*LocalEventTrigger, error) {
+//   - error: Non-nil if the TLS settings are invalid or connection to event handler failed
+func NewLocalEventTrigger(eventHandlerAddr string, opts ...TriggerOption) (*LocalEventTrigger, error) {
 	if eventHandlerAddr == "" {
 		return nil, fmt.Errorf("event handler address cannot be empty")
 	}
 
+	cfg := &triggerConfig{dialTimeout: DefaultDialTimeout}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	creds, err := cfg.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to event handler with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.dialTimeout)
 	defer cancel()
 
 	conn, err := grpc.DialContext(
 		ctx,
 		eventHandlerAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithBlock(),
 	)
 	if err != nil {
@@ -80,7 +156,7 @@ func NewLocalEventTrigger(eventHandlerAddr string) (*LocalEventTrigger, error) {
 //   - namespace: AccelByte namespace
 //
 // Returns:
-//   - error: Non-nil if event trigger failed
+//   - error: Validation error, or a *TriggerError carrying the gRPC status code if the RPC failed
 func (t *LocalEventTrigger) TriggerLogin(ctx context.Context, userID, namespace string) error {
 	if userID == "" {
 		return fmt.Errorf("userID cannot be empty")
@@ -100,9 +176,8 @@ func (t *LocalEventTrigger) TriggerLogin(ctx context.Context, userID, namespace
 	// Call OnMessage RPC
 	_, err := t.loginClient.OnMessage(ctx, msg)
 	if err != nil {
-		// Extract gRPC error details
-		st := status.Convert(err)
-		return fmt.Errorf("trigger login event failed: %s: %w", st.Message(), err)
+		// Keep the gRPC status code for callers
+		return newTriggerError("login", err)
 	}
 
 	return nil
@@ -122,7 +197,7 @@ func (t *LocalEventTrigger) TriggerLogin(ctx context.Context, userID, namespace
 //   - inc: Increment value for this update (used for baseline calculation in relative progress mode)
 //
 // Returns:
-//   - error: Non-nil if event trigger failed
+//   - error: Validation error, or a *TriggerError carrying the gRPC status code if the RPC failed
 func (t *LocalEventTrigger) TriggerStatUpdate(ctx context.Context, userID, namespace, statCode string, value, inc int) error {
 	if userID == "" {
 		return fmt.Errorf("userID cannot be empty")
@@ -152,9 +227,8 @@ func (t *LocalEventTrigger) TriggerStatUpdate(ctx context.Context, userID, names
 	// Call OnMessage RPC
 	_, err := t.statClient.OnMessage(ctx, msg)
 	if err != nil {
-		// Extract gRPC error details
-		st := status.Convert(err)
-		return fmt.Errorf("trigger stat update event failed: %s: %w", st.Message(), err)
+		// Keep the gRPC status code for callers
+		return newTriggerError("stat update", err)
 	}
 
 	return nil
@@ -0,0 +1,203 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package events
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func writeTestCA(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewTLSServer(nil)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(path, pemBytes, 0o600); err != nil {
+		t.Fatalf("Failed to write CA file: %v", err)
+	}
+	return path
+}
+
+func TestTriggerConfig_TransportCredentials(t *testing.T) {
+	caFile := writeTestCA(t)
+	notPEM := filepath.Join(t.TempDir(), "not-pem.txt")
+	if err := os.WriteFile(notPEM, []byte("hello"), 0o600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		opts         []TriggerOption
+		wantProtocol string
+		wantErr      string
+	}{
+		{name: "insecure by default", wantProtocol: "insecure"},
+		{name: "tls with system roots", opts: []TriggerOption{WithTLS("", "")}, wantProtocol: "tls"},
+		{name: "tls with CA and server name", opts: []TriggerOption{WithTLS(caFile, "events.internal")}, wantProtocol: "tls"},
+		{name: "missing CA file", opts: []TriggerOption{WithTLS(filepath.Join(t.TempDir(), "missing.pem"), "")}, wantErr: "failed to read CA file"},
+		{name: "CA file without certificates", opts: []TriggerOption{WithTLS(notPEM, "")}, wantErr: "no PEM certificates"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &triggerConfig{dialTimeout: DefaultDialTimeout}
+			for _, opt := range tt.opts {
+				opt(cfg)
+			}
+
+			creds, err := cfg.transportCredentials()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got := creds.Info().SecurityProtocol; got != tt.wantProtocol {
+				t.Errorf("Expected protocol %s, got %s", tt.wantProtocol, got)
+			}
+		})
+	}
+}
+
+func TestWithDialTimeout(t *testing.T) {
+	tests := []struct {
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		{timeout: 250 * time.Millisecond, expected: 250 * time.Millisecond},
+		{timeout: 0, expected: DefaultDialTimeout},
+		{timeout: -time.Second, expected: DefaultDialTimeout},
+	}
+
+	for _, tt := range tests {
+		cfg := &triggerConfig{dialTimeout: DefaultDialTimeout}
+		WithDialTimeout(tt.timeout)(cfg)
+		if cfg.dialTimeout != tt.expected {
+			t.Errorf("WithDialTimeout(%v): expected %v, got %v", tt.timeout, tt.expected, cfg.dialTimeout)
+		}
+	}
+}
+
+func TestNewLocalEventTrigger_DialTimeout(t *testing.T) {
+	// Reserve a port and close it so nothing is listening
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	addr := listener.Addr().String()
+	_ = listener.Close()
+
+	start := time.Now()
+	_, err = NewLocalEventTrigger(addr, WithDialTimeout(200*time.Millisecond))
+	if err == nil {
+		t.Fatal("Expected connection error")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected dial to give up after the configured timeout, took %v", elapsed)
+	}
+}
+
+func TestNewLocalEventTrigger_InvalidCA(t *testing.T) {
+	_, err := NewLocalEventTrigger("localhost:6565", WithTLS(filepath.Join(t.TempDir(), "missing.pem"), ""))
+	if err == nil || !strings.Contains(err.Error(), "failed to read CA file") {
+		t.Errorf("Expected CA file error before dialing, got %v", err)
+	}
+}
+
+func startStatusServer(t *testing.T, code *atomic.Uint32) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+
+	server := grpc.NewServer(grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+		c := codes.Code(code.Load())
+		return status.Error(c, "event handler says "+c.String())
+	}))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestLocalEventTrigger_StatusCodes(t *testing.T) {
+	var code atomic.Uint32
+	trigger, err := NewLocalEventTrigger(startStatusServer(t, &code), WithDialTimeout(2*time.Second))
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer func() { _ = trigger.Close() }()
+	ctx := context.Background()
+
+	events := []struct {
+		name    string
+		event   string
+		trigger func() error
+	}{
+		{"login", "login", func() error { return trigger.TriggerLogin(ctx, "user", "demo") }},
+		{"stat update", "stat update", func() error { return trigger.TriggerStatUpdate(ctx, "user", "demo", "kills", 5, 1) }},
+	}
+	tests := []struct {
+		code      codes.Code
+		retryable bool
+	}{
+		{codes.Unavailable, true},
+		{codes.InvalidArgument, false},
+		{codes.Internal, false},
+		{codes.DeadlineExceeded, true},
+	}
+
+	for _, ev := range events {
+		for _, tt := range tests {
+			t.Run(ev.name+"/"+tt.code.String(), func(t *testing.T) {
+				code.Store(uint32(tt.code))
+
+				err := ev.trigger()
+				var triggerErr *TriggerError
+				if !errors.As(err, &triggerErr) {
+					t.Fatalf("Expected TriggerError, got %T: %v", err, err)
+				}
+				if triggerErr.Code != tt.code || triggerErr.Event != ev.event {
+					t.Errorf("Expected %s event code %s, got %s event code %s", ev.event, tt.code, triggerErr.Event, triggerErr.Code)
+				}
+				if triggerErr.Retryable() != tt.retryable {
+					t.Errorf("Expected retryable %v for %s", tt.retryable, tt.code)
+				}
+				if status.Code(err) != tt.code {
+					t.Errorf("Expected the gRPC status reachable through the error, got %s", status.Code(err))
+				}
+				want := "trigger " + ev.event + " event failed: event handler says " + tt.code.String()
+				if !strings.HasPrefix(err.Error(), want) {
+					t.Errorf("Expected message starting %q, got %q", want, err.Error())
+				}
+			})
+		}
+	}
+
+	// Validation errors never reach the RPC, so they carry no code
+	var triggerErr *TriggerError
+	if err := trigger.TriggerLogin(ctx, "", "demo"); err == nil || errors.As(err, &triggerErr) {
+		t.Errorf("Expected a plain validation error, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// StatTracker keeps a running local total per stat so increments can be sent as absolute values.
+//
+// The AGS StatItemUpdated event carries an absolute LatestValue, so "add 5 kills" must be
+// translated into "kills is now N+5" before it is sent. The tracker does NOT query the
+// Statistic service; it only knows about values sent through it (or loaded from its file).
+// Totals start at 0 for stats it has not seen.
+//
+// Thread Safety: This implementation is safe for concurrent use.
+type StatTracker struct {
+	path   string         // Optional persistence file ("" = in-memory only)
+	totals map[string]int // Keyed by namespace/userID/statCode
+	mu     sync.Mutex     // Protects totals
+}
+
+// NewStatTracker creates a new StatTracker.
+//
+// Parameters:
+//   - path: JSON file used to persist totals across runs ("" for in-memory only)
+//
+// Returns:
+//   - *StatTracker: Tracker loaded with any previously saved totals
+//   - error: Non-nil if the file exists but cannot be read or parsed
+func NewStatTracker(path string) (*StatTracker, error) {
+	t := &StatTracker{
+		path:   path,
+		totals: make(map[string]int),
+	}
+
+	if path == "" {
+		return t, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stat totals from %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(data, &t.totals); err != nil {
+		return nil, fmt.Errorf("failed to parse stat totals from %s: %w", path, err)
+	}
+
+	return t, nil
+}
+
+// Current returns the tracked total for a stat (0 if never sent).
+func (t *StatTracker) Current(userID, namespace, statCode string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.totals[statKey(userID, namespace, statCode)]
+}
+
+// Increment adds delta to the tracked total and returns the new absolute value.
+//
+// The total is only updated if validation passes, so a rejected increment never
+// changes tracked state.
+//
+// Returns:
+//   - int: New absolute value to send as LatestValue
+//   - error: Non-nil if delta is zero or the resulting total would be negative
+func (t *StatTracker) Increment(userID, namespace, statCode string, delta int) (int, error) {
+	if delta == 0 {
+		return 0, fmt.Errorf("increment cannot be zero")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := statKey(userID, namespace, statCode)
+	total := t.totals[key] + delta
+	if total < 0 {
+		return 0, fmt.Errorf("increment %d would make %s negative (current total: %d)", delta, statCode, t.totals[key])
+	}
+
+	t.totals[key] = total
+	return total, nil
+}
+
+// Set records an absolute value so later increments continue from it.
+func (t *StatTracker) Set(userID, namespace, statCode string, value int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.totals[statKey(userID, namespace, statCode)] = value
+}
+
+// Save persists totals to the tracker's file (no-op for in-memory trackers).
+//
+// Returns:
+//   - error: Non-nil if the file could not be written
+func (t *StatTracker) Save() error {
+	if t.path == "" {
+		return nil
+	}
+
+	t.mu.Lock()
+	data, err := json.MarshalIndent(t.totals, "", "  ")
+	t.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode stat totals: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", t.path, err)
+	}
+
+	if err := os.WriteFile(t.path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write stat totals to %s: %w", t.path, err)
+	}
+
+	return nil
+}
+
+// DefaultStatTotalsPath returns the default file used to persist CLI stat totals.
+//
+// Returns:
+//   - string: Path under the user cache directory, or "" if it cannot be determined
+func DefaultStatTotalsPath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "challenge-demo", "stat-totals.json")
+}
+
+// statKey builds the map key for a user's stat.
+func statKey(userID, namespace, statCode string) string {
+	return namespace + "/" + userID + "/" + statCode
+}
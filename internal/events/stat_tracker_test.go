@@ -0,0 +1,91 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package events
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestStatTracker_Increment(t *testing.T) {
+	tracker, err := NewStatTracker("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	total, err := tracker.Increment("user-1", "demo", "kills", 5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if total != 5 {
+		t.Errorf("Expected total 5, got %d", total)
+	}
+
+	total, err = tracker.Increment("user-1", "demo", "kills", 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if total != 8 {
+		t.Errorf("Expected total 8, got %d", total)
+	}
+
+	// Totals are tracked per user
+	if current := tracker.Current("user-2", "demo", "kills"); current != 0 {
+		t.Errorf("Expected 0 for another user, got %d", current)
+	}
+}
+
+func TestStatTracker_Increment_Validation(t *testing.T) {
+	tracker, _ := NewStatTracker("")
+	tracker.Set("user-1", "demo", "kills", 2)
+
+	if _, err := tracker.Increment("user-1", "demo", "kills", 0); err == nil {
+		t.Error("Expected error for zero increment")
+	}
+
+	if _, err := tracker.Increment("user-1", "demo", "kills", -3); err == nil {
+		t.Error("Expected error when increment would make total negative")
+	}
+
+	// Rejected increments must not change the total
+	if current := tracker.Current("user-1", "demo", "kills"); current != 2 {
+		t.Errorf("Expected total to stay 2, got %d", current)
+	}
+
+	// Negative increment within bounds is allowed
+	total, err := tracker.Increment("user-1", "demo", "kills", -2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if total != 0 {
+		t.Errorf("Expected total 0, got %d", total)
+	}
+}
+
+func TestStatTracker_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "totals.json")
+
+	tracker, err := NewStatTracker(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := tracker.Increment("user-1", "demo", "kills", 7); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := tracker.Save(); err != nil {
+		t.Fatalf("Unexpected error saving: %v", err)
+	}
+
+	reloaded, err := NewStatTracker(path)
+	if err != nil {
+		t.Fatalf("Unexpected error reloading: %v", err)
+	}
+
+	if current := reloaded.Current("user-1", "demo", "kills"); current != 7 {
+		t.Errorf("Expected reloaded total 7, got %d", current)
+	}
+}
@@ -4,7 +4,46 @@
 
 package events
 
-import "context"
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TriggerError is an event whose RPC to the event handler failed, with the gRPC status code
+// The code tells an unreachable handler (Unavailable) from a rejected event (InvalidArgument).
+type TriggerError struct {
+	Event string     // Event name, e.g. "login" or "stat update"
+	Code  codes.Code // gRPC status code of the failed RPC
+	Err   error      // RPC error
+}
+
+// newTriggerError wraps the error of a failed event RPC with its gRPC status code
+func newTriggerError(event string, err error) *TriggerError {
+	return &TriggerError{Event: event, Code: status.Code(err), Err: err}
+}
+
+// Error implements the error interface
+func (e *TriggerError) Error() string {
+	return fmt.Sprintf("trigger %s event failed: %s: %v", e.Event, status.Convert(e.Err).Message(), e.Err)
+}
+
+// Unwrap returns the RPC error
+func (e *TriggerError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable reports whether the code suggests the same event may succeed if sent again
+// (handler unreachable, overloaded, or too slow) rather than being rejected as sent.
+func (e *TriggerError) Retryable() bool {
+	switch e.Code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
 
 // EventTrigger handles triggering gameplay events for testing challenge progress.
 //
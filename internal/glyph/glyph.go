@@ -0,0 +1,140 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Package glyph provides the symbol table used by the TUI and text output,
+// with an ASCII fallback for terminals and CI logs without Unicode support.
+package glyph
+
+import (
+	"os"
+	"strings"
+	"sync"
+)
+
+// Set is a table of display glyphs
+type Set struct {
+	Success    string // Successful operation / valid state
+	Failure    string // Failed operation / invalid state
+	Done       string // Completed admin operation banner
+	Warning    string // Warning prefix
+	Pending    string // Operation in progress
+	Cursor     string // Selected list row
+	Active     string // Selected option
+	NotStarted string // Goal not started
+	InProgress string // Goal in progress
+	Claimed    string // Goal claimed
+	Claimable  string // Goal completed, reward not yet claimed
+	Locked     string // Goal locked by prerequisites
+	Refresh    string // Auto-refresh indicator
+	UpDown     string // Vertical navigation keys
+	LeftRight  string // Horizontal navigation keys
+	Rule       string // Horizontal separator segment
+	TreeBranch string // Tree child with siblings below it
+	TreeLast   string // Last tree child
+	TreePipe   string // Indent under a child with siblings below it
+
+	ProgressFill  rune // Completed portion of a progress bar
+	ProgressEmpty rune // Remaining portion of a progress bar
+}
+
+var (
+	// Unicode is the default glyph set
+	Unicode = Set{
+		Success:       "✓",
+		Failure:       "✗",
+		Done:          "✅",
+		Warning:       "⚠",
+		Pending:       "⏳",
+		Cursor:        "►",
+		Active:        "▶",
+		NotStarted:    "○",
+		InProgress:    "●",
+		Claimed:       "⚡",
+		Claimable:     "★",
+		Locked:        "🔒",
+		Refresh:       "⟳",
+		UpDown:        "↑↓",
+		LeftRight:     "←→",
+		Rule:          "─",
+		TreeBranch:    "├── ",
+		TreeLast:      "└── ",
+		TreePipe:      "│   ",
+		ProgressFill:  '█',
+		ProgressEmpty: '░',
+	}
+
+	// ASCII is the glyph set for terminals without Unicode support
+	ASCII = Set{
+		Success:       "+",
+		Failure:       "x",
+		Done:          "[OK]",
+		Warning:       "!",
+		Pending:       "...",
+		Cursor:        ">",
+		Active:        ">",
+		NotStarted:    "o",
+		InProgress:    "*",
+		Claimed:       "$",
+		Claimable:     "(!)",
+		Locked:        "[locked]",
+		Refresh:       "~",
+		UpDown:        "Up/Down",
+		LeftRight:     "Left/Right",
+		Rule:          "-",
+		TreeBranch:    "|-- ",
+		TreeLast:      "`-- ",
+		TreePipe:      "|   ",
+		ProgressFill:  '#',
+		ProgressEmpty: '-',
+	}
+)
+
+var (
+	current = Detect()
+	mu      sync.RWMutex
+)
+
+// Current returns the active glyph set
+func Current() Set {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// Use sets the active glyph set (e.g., from the --ascii flag)
+func Use(set Set) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = set
+}
+
+// IsASCII returns true if the ASCII glyph set is active
+func IsASCII() bool {
+	return Current() == ASCII
+}
+
+// Detect returns the Unicode set, or ASCII if the locale is not UTF-8
+func Detect() Set {
+	if LocaleSupportsUTF8(os.Getenv) {
+		return Unicode
+	}
+	return ASCII
+}
+
+// LocaleSupportsUTF8 checks the effective locale (LC_ALL > LC_CTYPE > LANG)
+// An unset locale is assumed to support UTF-8, as most modern terminals do.
+func LocaleSupportsUTF8(getenv func(string) string) bool {
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if value := getenv(key); value != "" {
+			value = strings.ToLower(value)
+			return strings.Contains(value, "utf-8") || strings.Contains(value, "utf8")
+		}
+	}
+	return true
+}
+
+// Rule returns a horizontal separator of the given width
+func Rule(width int) string {
+	return strings.Repeat(Current().Rule, width)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package glyph
+
+import (
+	"reflect"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestASCIISetHasNoMultiByteGlyphs(t *testing.T) {
+	v := reflect.ValueOf(ASCII)
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		field := v.Field(i)
+
+		var value string
+		switch field.Kind() {
+		case reflect.String:
+			value = field.String()
+		case reflect.Int32:
+			value = string(rune(field.Int()))
+		}
+
+		if value == "" {
+			t.Errorf("Expected ASCII glyph %s to be set", name)
+		}
+		if utf8.RuneCountInString(value) != len(value) {
+			t.Errorf("Expected ASCII glyph %s to be single-byte, got '%s'", name, value)
+		}
+	}
+}
+
+func TestUse(t *testing.T) {
+	defer Use(Current())
+
+	Use(ASCII)
+	if !IsASCII() {
+		t.Error("Expected ASCII set to be active")
+	}
+	if Rule(3) != "---" {
+		t.Errorf("Expected ASCII rule '---', got '%s'", Rule(3))
+	}
+
+	Use(Unicode)
+	if IsASCII() {
+		t.Error("Expected Unicode set to be active")
+	}
+}
+
+func TestLocaleSupportsUTF8(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{name: "unset locale", env: map[string]string{}, expected: true},
+		{name: "utf-8 lang", env: map[string]string{"LANG": "en_US.UTF-8"}, expected: true},
+		{name: "utf8 lowercase", env: map[string]string{"LANG": "C.utf8"}, expected: true},
+		{name: "posix locale", env: map[string]string{"LANG": "C"}, expected: false},
+		{name: "LC_ALL overrides LANG", env: map[string]string{"LC_ALL": "POSIX", "LANG": "en_US.UTF-8"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string { return tt.env[key] }
+			if got := LocaleSupportsUTF8(getenv); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
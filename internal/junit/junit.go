@@ -0,0 +1,125 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Package junit writes JUnit XML test reports for CI systems.
+package junit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Suite is a named collection of test cases
+type Suite struct {
+	Name  string
+	Cases []Case
+}
+
+// Case is a single check within a suite
+// At most one of Failure, Error, or Skipped should be set; none means passed.
+type Case struct {
+	Name      string
+	ClassName string
+	Duration  time.Duration
+	Failure   string // Check ran and did not hold
+	Error     string // Check could not run
+	Skipped   string // Check was not run
+}
+
+// xmlSuite is the root element of a JUnit XML report
+type xmlSuite struct {
+	XMLName  xml.Name  `xml:"testsuite"`
+	Name     string    `xml:"name,attr"`
+	Tests    int       `xml:"tests,attr"`
+	Failures int       `xml:"failures,attr"`
+	Errors   int       `xml:"errors,attr"`
+	Skipped  int       `xml:"skipped,attr"`
+	Time     string    `xml:"time,attr"`
+	Cases    []xmlCase `xml:"testcase"`
+}
+
+// xmlCase is a testcase element
+type xmlCase struct {
+	Name      string      `xml:"name,attr"`
+	ClassName string      `xml:"classname,attr"`
+	Time      string      `xml:"time,attr"`
+	Failure   *xmlMessage `xml:"failure,omitempty"`
+	Error     *xmlMessage `xml:"error,omitempty"`
+	Skipped   *xmlMessage `xml:"skipped,omitempty"`
+}
+
+// xmlMessage is a failure, error, or skipped element
+type xmlMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// Write writes the suite as JUnit XML
+//
+// Returns:
+//   - error: Non-nil if the report could not be encoded or written
+func (s *Suite) Write(w io.Writer) error {
+	out := xmlSuite{Name: s.Name, Tests: len(s.Cases), Cases: []xmlCase{}}
+
+	var total time.Duration
+	for _, c := range s.Cases {
+		total += c.Duration
+
+		tc := xmlCase{Name: c.Name, ClassName: c.ClassName, Time: seconds(c.Duration)}
+		if tc.ClassName == "" {
+			tc.ClassName = s.Name
+		}
+
+		switch {
+		case c.Skipped != "":
+			tc.Skipped = &xmlMessage{Message: c.Skipped}
+			out.Skipped++
+		case c.Failure != "":
+			tc.Failure = &xmlMessage{Message: c.Failure, Text: c.Failure}
+			out.Failures++
+		case c.Error != "":
+			tc.Error = &xmlMessage{Message: c.Error, Text: c.Error}
+			out.Errors++
+		}
+
+		out.Cases = append(out.Cases, tc)
+	}
+	out.Time = seconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(out); err != nil {
+		return fmt.Errorf("failed to encode JUnit report: %w", err)
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteFile writes the suite as JUnit XML to path, replacing any existing file
+func (s *Suite) WriteFile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	if err := s.Write(file); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return file.Close()
+}
+
+// seconds formats a duration as the seconds value JUnit expects
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package junit
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSuite_Write(t *testing.T) {
+	suite := &Suite{
+		Name: "verify-reward",
+		Cases: []Case{
+			{Name: "ITEM winter_sword", Duration: 1500 * time.Millisecond},
+			{Name: "WALLET GEMS", Failure: "expected 100, got 25"},
+			{Name: "WALLET COINS", Error: "wallet not found"},
+			{Name: "ITEM bronze_shield", ClassName: "custom", Skipped: "not run"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := suite.Write(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var parsed xmlSuite
+	if err := xml.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("Expected valid XML, got %v:\n%s", err, buf.String())
+	}
+
+	if parsed.Name != "verify-reward" || parsed.Tests != 4 || parsed.Failures != 1 || parsed.Errors != 1 || parsed.Skipped != 1 {
+		t.Errorf("Expected 4 tests, 1 failure, 1 error, 1 skipped, got %+v", parsed)
+	}
+	if parsed.Time != "1.500" {
+		t.Errorf("Expected total time 1.500, got %s", parsed.Time)
+	}
+
+	if len(parsed.Cases) != 4 {
+		t.Fatalf("Expected 4 test cases, got %d", len(parsed.Cases))
+	}
+
+	passed := parsed.Cases[0]
+	if passed.Failure != nil || passed.Error != nil || passed.Skipped != nil {
+		t.Errorf("Expected first case to pass, got %+v", passed)
+	}
+	if passed.ClassName != "verify-reward" {
+		t.Errorf("Expected class name to default to suite name, got %s", passed.ClassName)
+	}
+
+	if parsed.Cases[1].Failure == nil || parsed.Cases[1].Failure.Message != "expected 100, got 25" {
+		t.Errorf("Expected failure element with message, got %+v", parsed.Cases[1].Failure)
+	}
+	if parsed.Cases[2].Error == nil || parsed.Cases[2].Error.Message != "wallet not found" {
+		t.Errorf("Expected error element with message, got %+v", parsed.Cases[2].Error)
+	}
+	if parsed.Cases[3].Skipped == nil || parsed.Cases[3].ClassName != "custom" {
+		t.Errorf("Expected skipped element with custom class name, got %+v", parsed.Cases[3])
+	}
+}
+
+func TestSuite_WriteFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.xml")
+	suite := &Suite{Name: "empty"}
+
+	if err := suite.WriteFile(path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	if !bytes.HasPrefix(data, []byte(xml.Header)) {
+		t.Errorf("Expected XML header, got %s", data)
+	}
+}
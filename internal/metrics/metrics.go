@@ -0,0 +1,174 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Package metrics records Challenge Service request metrics and serves them in the
+// Prometheus text exposition format.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metric names exposed by a Registry
+const (
+	RequestsMetric = "challenge_demo_api_requests_total"
+	ErrorsMetric   = "challenge_demo_api_request_errors_total"
+	LatencyMetric  = "challenge_demo_api_request_duration_seconds"
+)
+
+// DefaultBuckets are the latency histogram upper bounds, in seconds
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// routeKey identifies a request route
+type routeKey struct {
+	method string
+	route  string
+}
+
+// requestKey identifies a route and its response status ("none" if no response was received)
+type requestKey struct {
+	routeKey
+	code string
+}
+
+// histogram counts observations per bucket (not cumulative; summed on output)
+type histogram struct {
+	counts []uint64 // One per bucket, plus +Inf
+	sum    float64
+	count  uint64
+}
+
+// Registry accumulates request counts, errors, and latencies per route
+// It implements api.RequestObserver and http.Handler, and is safe for concurrent use.
+type Registry struct {
+	buckets []float64
+
+	mu       sync.Mutex
+	requests map[requestKey]uint64
+	errors   map[routeKey]uint64
+	latency  map[routeKey]*histogram
+}
+
+// NewRegistry creates an empty registry using DefaultBuckets
+func NewRegistry() *Registry {
+	return &Registry{
+		buckets:  DefaultBuckets,
+		requests: make(map[requestKey]uint64),
+		errors:   make(map[routeKey]uint64),
+		latency:  make(map[routeKey]*histogram),
+	}
+}
+
+// ObserveRequest records one HTTP attempt
+// Attempts without a response or with a 5xx status count as errors; 4xx are the caller's fault.
+func (r *Registry) ObserveRequest(method, route string, statusCode int, duration time.Duration, err error) {
+	key := routeKey{method: method, route: route}
+	code := "none"
+	if statusCode != 0 {
+		code = strconv.Itoa(statusCode)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests[requestKey{routeKey: key, code: code}]++
+	if err != nil || statusCode == 0 || statusCode >= 500 {
+		r.errors[key]++
+	}
+
+	h, ok := r.latency[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(r.buckets)+1)}
+		r.latency[key] = h
+	}
+	seconds := duration.Seconds()
+	h.counts[sort.SearchFloat64s(r.buckets, seconds)]++
+	h.sum += seconds
+	h.count++
+}
+
+// WriteTo writes every metric in the Prometheus text exposition format
+// Series are sorted by labels so the output is stable.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# HELP %s Challenge Service HTTP attempts, including retries.\n", RequestsMetric)
+	fmt.Fprintf(&b, "# TYPE %s counter\n", RequestsMetric)
+	requestKeys := make([]requestKey, 0, len(r.requests))
+	for key := range r.requests {
+		requestKeys = append(requestKeys, key)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		if requestKeys[i].routeKey != requestKeys[j].routeKey {
+			return routeLess(requestKeys[i].routeKey, requestKeys[j].routeKey)
+		}
+		return requestKeys[i].code < requestKeys[j].code
+	})
+	for _, key := range requestKeys {
+		fmt.Fprintf(&b, "%s{%s,code=%q} %d\n", RequestsMetric, routeLabels(key.routeKey), key.code, r.requests[key])
+	}
+
+	fmt.Fprintf(&b, "# HELP %s Challenge Service HTTP attempts that failed without a response or with a 5xx status.\n", ErrorsMetric)
+	fmt.Fprintf(&b, "# TYPE %s counter\n", ErrorsMetric)
+	for _, key := range sortedRoutes(r.errors) {
+		fmt.Fprintf(&b, "%s{%s} %d\n", ErrorsMetric, routeLabels(key), r.errors[key])
+	}
+
+	fmt.Fprintf(&b, "# HELP %s Challenge Service HTTP attempt latency.\n", LatencyMetric)
+	fmt.Fprintf(&b, "# TYPE %s histogram\n", LatencyMetric)
+	for _, key := range sortedRoutes(r.latency) {
+		h := r.latency[key]
+		labels := routeLabels(key)
+		var cumulative uint64
+		for i, bound := range r.buckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(&b, "%s_bucket{%s,le=%q} %d\n", LatencyMetric, labels, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(&b, "%s_bucket{%s,le=\"+Inf\"} %d\n", LatencyMetric, labels, h.count)
+		fmt.Fprintf(&b, "%s_sum{%s} %s\n", LatencyMetric, labels, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "%s_count{%s} %d\n", LatencyMetric, labels, h.count)
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// ServeHTTP serves the metrics to a Prometheus scrape
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = r.WriteTo(w)
+}
+
+// routeLabels renders the method and route labels of a series
+func routeLabels(key routeKey) string {
+	return fmt.Sprintf("method=%q,route=%q", key.method, key.route)
+}
+
+// routeLess orders routes by route, then method
+func routeLess(a, b routeKey) bool {
+	if a.route != b.route {
+		return a.route < b.route
+	}
+	return a.method < b.method
+}
+
+// sortedRoutes returns the keys of m in routeLess order
+func sortedRoutes[V any](m map[routeKey]V) []routeKey {
+	keys := make([]routeKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return routeLess(keys[i], keys[j]) })
+	return keys
+}
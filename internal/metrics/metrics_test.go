@@ -0,0 +1,81 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegistry_WriteTo(t *testing.T) {
+	registry := NewRegistry()
+	registry.ObserveRequest("GET", "/v1/challenges", 200, 20*time.Millisecond, nil)
+	registry.ObserveRequest("GET", "/v1/challenges", 503, 300*time.Millisecond, nil)
+	registry.ObserveRequest("GET", "/v1/challenges", 0, 2*time.Second, errors.New("connection refused"))
+	registry.ObserveRequest("POST", "/v1/challenges/{challenge_id}/goals/{goal_id}/claim", 400, 5*time.Millisecond, nil)
+
+	var b strings.Builder
+	if _, err := registry.WriteTo(&b); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"# TYPE challenge_demo_api_requests_total counter",
+		`challenge_demo_api_requests_total{method="GET",route="/v1/challenges",code="200"} 1`,
+		`challenge_demo_api_requests_total{method="GET",route="/v1/challenges",code="503"} 1`,
+		`challenge_demo_api_requests_total{method="GET",route="/v1/challenges",code="none"} 1`,
+		// Transport failures and 5xx count as errors, 4xx do not
+		`challenge_demo_api_request_errors_total{method="GET",route="/v1/challenges"} 2`,
+		"# TYPE challenge_demo_api_request_duration_seconds histogram",
+		`challenge_demo_api_request_duration_seconds_bucket{method="GET",route="/v1/challenges",le="0.025"} 1`,
+		`challenge_demo_api_request_duration_seconds_bucket{method="GET",route="/v1/challenges",le="0.5"} 2`,
+		`challenge_demo_api_request_duration_seconds_bucket{method="GET",route="/v1/challenges",le="+Inf"} 3`,
+		`challenge_demo_api_request_duration_seconds_sum{method="GET",route="/v1/challenges"} 2.32`,
+		`challenge_demo_api_request_duration_seconds_count{method="GET",route="/v1/challenges"} 3`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, `errors_total{method="POST"`) {
+		t.Errorf("Expected no errors for the 400 response, got:\n%s", out)
+	}
+}
+
+func TestServe(t *testing.T) {
+	registry := NewRegistry()
+	registry.ObserveRequest("GET", "/v1/challenges", 200, time.Millisecond, nil)
+
+	server, err := Serve("127.0.0.1:0", registry)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer server.Close(context.Background())
+
+	resp, err := http.Get("http://" + server.Addr() + Path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/plain") {
+		t.Errorf("Expected a 200 text/plain response, got %d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	if !strings.Contains(string(body), RequestsMetric) {
+		t.Errorf("Expected the request counter, got:\n%s", body)
+	}
+
+	// The port is taken while the server runs
+	if _, err := Serve(server.Addr(), registry); err == nil {
+		t.Error("Expected an error listening on a port in use")
+	}
+}
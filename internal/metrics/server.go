@@ -0,0 +1,58 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Path is where Server serves the metrics
+const Path = "/metrics"
+
+// Server serves a Registry over HTTP for Prometheus to scrape
+type Server struct {
+	srv      *http.Server
+	listener net.Listener
+}
+
+// Serve starts serving registry at Path on addr (e.g. ":9100") in the background
+// It listens before returning, so a port already in use is reported here.
+func Serve(addr string, registry *Registry) (*Server, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for metrics on %s: %w", addr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, registry)
+	s := &Server{
+		srv:      &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second},
+		listener: listener,
+	}
+
+	go func() {
+		if err := s.srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Warn("Metrics server stopped", "address", addr, "error", err)
+		}
+	}()
+
+	return s, nil
+}
+
+// Addr returns the address the server listens on
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Close stops the server, letting an in-flight scrape finish within ctx
+func (s *Server) Close(ctx context.Context) error {
+	return s.srv.Shutdown(ctx)
+}
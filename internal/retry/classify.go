@@ -0,0 +1,135 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Package retry classifies errors as transient (worth retrying) or permanent, and
+// polls for eventually-consistent state.
+// The same policy is shared by the Challenge API client and the AGS reward verifier.
+package retry
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"strings"
+	"syscall"
+
+	"github.com/go-openapi/runtime"
+)
+
+// StatusCoder is implemented by errors that carry an HTTP status code
+type StatusCoder interface {
+	StatusCode() int
+}
+
+// sdkStatusSuffixes maps AccelByte SDK typed response names (e.g. GetUserEntitlementByItemIDNotFound)
+// to their HTTP status codes. The generated types expose no code accessor, only the name.
+var sdkStatusSuffixes = []struct {
+	suffix string
+	code   int
+}{
+	{"BadRequest", 400},
+	{"Unauthorized", 401},
+	{"Forbidden", 403},
+	{"NotFound", 404},
+	{"Conflict", 409},
+	{"UnprocessableEntity", 422},
+	{"TooManyRequests", 429},
+	{"InternalServerError", 500},
+	{"BadGateway", 502},
+	{"ServiceUnavailable", 503},
+	{"GatewayTimeout", 504},
+}
+
+// IsRetryable reports whether err is transient and the operation should be retried
+//
+// Typed errors are inspected first (context deadline, net.Error timeouts, connection
+// failures, status-coded errors); message matching is only a last resort for errors
+// that carry no type information, such as SDK errors for undocumented status codes.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// Cancellation is deliberate, never retry it
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	if code, ok := StatusCode(err); ok {
+		return IsRetryableStatus(code)
+	}
+
+	return matchesRetryableMessage(err.Error())
+}
+
+// IsRetryableStatus reports whether an HTTP status code indicates a transient failure
+func IsRetryableStatus(code int) bool {
+	return code == 429 || code >= 500
+}
+
+// StatusCode extracts the HTTP status code carried by err, if any
+func StatusCode(err error) (int, bool) {
+	var coder StatusCoder
+	if errors.As(err, &coder) {
+		return coder.StatusCode(), true
+	}
+
+	var apiErr *runtime.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code, true
+	}
+
+	// SDK typed responses, matched by type name
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		t := reflect.TypeOf(e)
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		for _, s := range sdkStatusSuffixes {
+			if strings.HasSuffix(t.Name(), s.suffix) {
+				return s.code, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// matchesRetryableMessage is the string-matching fallback (case-insensitive)
+func matchesRetryableMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+
+	for _, pattern := range []string{
+		"timeout", "deadline exceeded", // Timeouts
+		"connection refused", "no such host", // Connection errors
+		"500", "502", "503", "504", // 5xx errors
+		"429", "rate limit", // Rate limiting
+	} {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
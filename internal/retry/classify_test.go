@@ -0,0 +1,93 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+)
+
+// GetUserEntitlementByItemIDServiceUnavailable mimics an SDK typed response error
+type GetUserEntitlementByItemIDServiceUnavailable struct{}
+
+func (o *GetUserEntitlementByItemIDServiceUnavailable) Error() string {
+	return "[GET /platform/admin/namespaces/{namespace}/users/{userId}/entitlements/byItemId][] getUserEntitlementByItemIdServiceUnavailable"
+}
+
+// GetUserEntitlementByItemIDNotFound mimics an SDK typed response error
+type GetUserEntitlementByItemIDNotFound struct{}
+
+func (o *GetUserEntitlementByItemIDNotFound) Error() string {
+	return "[GET /platform/admin/namespaces/{namespace}/users/{userId}/entitlements/byItemId][404] getUserEntitlementByItemIdNotFound"
+}
+
+// statusErr is a StatusCoder error like api.APIError
+type statusErr struct{ code int }
+
+func (e statusErr) Error() string   { return fmt.Sprintf("HTTP %d", e.code) }
+func (e statusErr) StatusCode() int { return e.code }
+
+// timeoutErr is a net.Error reporting a timeout
+type timeoutErr struct{}
+
+func (timeoutErr) Error() string   { return "i/o wait exceeded" }
+func (timeoutErr) Timeout() bool   { return true }
+func (timeoutErr) Temporary() bool { return true }
+
+func TestIsRetryable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	canceledCtx, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "real context deadline", err: ctx.Err(), expected: true},
+		{name: "wrapped context deadline", err: fmt.Errorf("get entitlement failed: %w", ctx.Err()), expected: true},
+		{name: "context canceled", err: canceledCtx.Err(), expected: false},
+		{name: "net.Error timeout without keyword", err: &net.OpError{Op: "read", Net: "tcp", Err: timeoutErr{}}, expected: true},
+		{name: "connection refused errno", err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, expected: true},
+		{name: "dns error", err: &net.DNSError{Err: "no such host", Name: "platform.invalid"}, expected: true},
+		{name: "SDK 503 typed response", err: &GetUserEntitlementByItemIDServiceUnavailable{}, expected: true},
+		{name: "wrapped SDK 503 typed response", err: fmt.Errorf("get entitlement failed: %w", &GetUserEntitlementByItemIDServiceUnavailable{}), expected: true},
+		{name: "SDK 404 typed response", err: &GetUserEntitlementByItemIDNotFound{}, expected: false},
+		{name: "openapi 502 APIError", err: &runtime.APIError{OperationName: "queryUserEntitlements", Code: 502}, expected: true},
+		{name: "status coder 429", err: statusErr{code: 429}, expected: true},
+		{name: "status coder 400 wins over message", err: statusErr{code: 400}, expected: false},
+		{name: "untyped mixed-case fallback", err: errors.New("Requested GET ... returns an error 503: Service Unavailable"), expected: true},
+		{name: "untyped permanent", err: errors.New("invalid item id"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.expected {
+				t.Errorf("Expected IsRetryable=%v for '%v', got %v", tt.expected, tt.err, got)
+			}
+		})
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	if code, ok := StatusCode(&GetUserEntitlementByItemIDNotFound{}); !ok || code != 404 {
+		t.Errorf("Expected 404 from SDK typed response, got %d (ok=%v)", code, ok)
+	}
+
+	if _, ok := StatusCode(errors.New("plain")); ok {
+		t.Error("Expected no status code for plain error")
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrPollTimeout is returned by Poll when the condition never held before the timeout
+var ErrPollTimeout = errors.New("timed out")
+
+// Poll calls check every interval until it reports done, the timeout elapses, or ctx is cancelled
+//
+// Used for eventually-consistent state (progress updated by the event handler,
+// rewards granted in AGS Platform). Errors from check are treated as "not yet":
+// the last one is wrapped into the timeout error.
+//
+// Returns:
+//   - error: nil once check reports done; ErrPollTimeout (wrapping the last check
+//     error, if any) on timeout; ctx.Err() if the context is cancelled
+func Poll(ctx context.Context, interval, timeout time.Duration, check func(ctx context.Context) (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		done, err := check(ctx)
+		if err == nil && done {
+			return nil
+		}
+
+		if !time.Now().Add(interval).Before(deadline) {
+			if err != nil {
+				return fmt.Errorf("%w after %s: %w", ErrPollTimeout, timeout, err)
+			}
+			return fmt.Errorf("%w after %s", ErrPollTimeout, timeout)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
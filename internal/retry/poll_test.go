@@ -0,0 +1,73 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPoll(t *testing.T) {
+	lookupErr := errors.New("entitlement not found")
+
+	tests := []struct {
+		name      string
+		doneAfter int   // Call on which check reports done (0 = never)
+		checkErr  error // Returned by every call before doneAfter
+		wantErr   error
+		wantMsg   string
+	}{
+		{name: "done immediately", doneAfter: 1},
+		{name: "done after retries", doneAfter: 3},
+		{name: "errors until done", doneAfter: 3, checkErr: lookupErr},
+		{name: "timeout", wantErr: ErrPollTimeout},
+		{name: "timeout wraps last error", checkErr: lookupErr, wantErr: lookupErr, wantMsg: "timed out"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := Poll(context.Background(), time.Millisecond, 20*time.Millisecond, func(ctx context.Context) (bool, error) {
+				calls++
+				if tt.doneAfter > 0 && calls >= tt.doneAfter {
+					return true, nil
+				}
+				return false, tt.checkErr
+			})
+
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if calls != tt.doneAfter {
+					t.Errorf("Expected %d calls, got %d", tt.doneAfter, calls)
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantMsg != "" && (err == nil || !strings.Contains(err.Error(), tt.wantMsg)) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantMsg, err)
+			}
+		})
+	}
+}
+
+func TestPoll_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := Poll(ctx, time.Second, time.Minute, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
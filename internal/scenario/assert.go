@@ -0,0 +1,218 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package scenario
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// ErrAssertionFailed is wrapped by assert step errors when live state does not match
+var ErrAssertionFailed = errors.New("assertion failed")
+
+// Assertion subjects
+const (
+	SubjectGoal   = "goal"
+	SubjectWallet = "wallet"
+	SubjectItem   = "item"
+)
+
+// Assertion is a parsed assert expression
+//
+// Supported forms:
+//
+//	goal [<challenge>/]<goal> status <op> <status>
+//	goal [<challenge>/]<goal> progress <op> <number>
+//	wallet <currency> <op> <number>   (wallet balance)
+//	item <item-id> <op> <number>      (entitlement quantity)
+//
+// Operators: == != >= <= > <. Ordering operators on status use
+// not_started < in_progress < completed < claimed.
+type Assertion struct {
+	Subject   string // goal, wallet, or item
+	Challenge string // Goal only; empty searches every challenge
+	Target    string // Goal ID, currency code, or item ID
+	Field     string // Goal only: status or progress
+	Op        string
+	Value     string
+}
+
+// ParseAssertion parses an assert expression such as "wallet GOLD >= 100"
+func ParseAssertion(expr string) (*Assertion, error) {
+	fields := strings.Fields(expr)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("assert expression is empty")
+	}
+
+	a := &Assertion{Subject: fields[0]}
+	switch a.Subject {
+	case SubjectGoal:
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("expected 'goal <id> status|progress <op> <value>', got %q", expr)
+		}
+		a.Target, a.Field, a.Op, a.Value = fields[1], fields[2], fields[3], fields[4]
+		if challenge, goal, ok := strings.Cut(a.Target, "/"); ok {
+			a.Challenge, a.Target = challenge, goal
+		}
+
+		switch a.Field {
+		case "status":
+			if _, ok := statusRank[a.Value]; !ok {
+				return nil, fmt.Errorf("unknown status %q", a.Value)
+			}
+		case "progress":
+			if _, err := strconv.ParseInt(a.Value, 10, 64); err != nil {
+				return nil, fmt.Errorf("progress value must be a number, got %q", a.Value)
+			}
+		default:
+			return nil, fmt.Errorf("unknown goal field %q (expected status or progress)", a.Field)
+		}
+
+	case SubjectWallet, SubjectItem:
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("expected '%s <id> <op> <value>', got %q", a.Subject, expr)
+		}
+		a.Target, a.Op, a.Value = fields[1], fields[2], fields[3]
+		if _, err := strconv.ParseInt(a.Value, 10, 64); err != nil {
+			return nil, fmt.Errorf("%s value must be a number, got %q", a.Subject, a.Value)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown assert subject %q (expected goal, wallet, or item)", a.Subject)
+	}
+
+	if a.Target == "" {
+		return nil, fmt.Errorf("assert target is empty in %q", expr)
+	}
+	if !validOp(a.Op) {
+		return nil, fmt.Errorf("unknown operator %q (expected ==, !=, >=, <=, >, or <)", a.Op)
+	}
+
+	return a, nil
+}
+
+// String returns the assertion in expression form
+func (a *Assertion) String() string {
+	target := a.Target
+	if a.Challenge != "" {
+		target = a.Challenge + "/" + a.Target
+	}
+	if a.Subject == SubjectGoal {
+		return strings.Join([]string{a.Subject, target, a.Field, a.Op, a.Value}, " ")
+	}
+	return strings.Join([]string{a.Subject, target, a.Op, a.Value}, " ")
+}
+
+// Evaluate checks the assertion against live state
+//
+// Returns:
+//   - error: Wraps ErrAssertionFailed if the state does not match, or the
+//     lookup error if the state could not be read
+func (a *Assertion) Evaluate(ctx context.Context, deps Deps) error {
+	var actual string
+	var ok bool
+
+	switch a.Subject {
+	case SubjectGoal:
+		goal, err := findGoal(ctx, deps.APIClient, a.Challenge, a.Target)
+		if err != nil {
+			return err
+		}
+		if a.Field == "status" {
+			actual = goal.Status
+			ok = compareStatus(a.Op, goal.Status, a.Value)
+		} else {
+			expected, _ := strconv.ParseInt(a.Value, 10, 64)
+			actual = strconv.FormatInt(int64(goal.Progress), 10)
+			ok = compareInt(a.Op, int64(goal.Progress), expected)
+		}
+
+	case SubjectWallet, SubjectItem:
+		rewardType := "WALLET"
+		if a.Subject == SubjectItem {
+			rewardType = "ITEM"
+		}
+		// A missing entitlement or wallet counts as 0, as in verify-reward
+		quantity, lookupErr := ags.GrantedQuantity(ctx, deps.RewardVerifier, rewardType, a.Target)
+		expected, _ := strconv.ParseInt(a.Value, 10, 64)
+		actual = strconv.FormatInt(quantity, 10)
+		if lookupErr != nil {
+			actual += fmt.Sprintf(" (%v)", lookupErr)
+		}
+		ok = compareInt(a.Op, quantity, expected)
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: %s (actual: %s)", ErrAssertionFailed, a, actual)
+	}
+	return nil
+}
+
+// findGoal fetches a goal, searching every challenge when challengeID is empty
+func findGoal(ctx context.Context, apiClient api.APIClient, challengeID, goalID string) (*api.Goal, error) {
+	if challengeID != "" {
+		return getGoal(ctx, apiClient, challengeID, goalID)
+	}
+
+	challenges, err := apiClient.ListChallenges(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list challenges: %w", err)
+	}
+
+	for _, c := range challenges {
+		for i := range c.Goals {
+			if c.Goals[i].ID == goalID {
+				return &c.Goals[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("goal %s not found in any challenge", goalID)
+}
+
+// validOp reports whether op is a supported comparison operator
+func validOp(op string) bool {
+	switch op {
+	case "==", "!=", ">=", "<=", ">", "<":
+		return true
+	}
+	return false
+}
+
+// compareInt applies a comparison operator to two numbers
+func compareInt(op string, actual, expected int64) bool {
+	switch op {
+	case "==":
+		return actual == expected
+	case "!=":
+		return actual != expected
+	case ">=":
+		return actual >= expected
+	case "<=":
+		return actual <= expected
+	case ">":
+		return actual > expected
+	case "<":
+		return actual < expected
+	}
+	return false
+}
+
+// compareStatus compares goal statuses, using statusRank for ordering operators
+func compareStatus(op, actual, expected string) bool {
+	switch op {
+	case "==":
+		return actual == expected
+	case "!=":
+		return actual != expected
+	}
+	return compareInt(op, int64(statusRank[actual]), int64(statusRank[expected]))
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package scenario
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+)
+
+func TestParseAssertion(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "goal status", expr: "goal daily/kill-10 status == completed"},
+		{name: "goal without challenge", expr: "goal kill-10 progress >= 5"},
+		{name: "wallet balance", expr: "wallet GOLD >= 100"},
+		{name: "item quantity", expr: "item winter_sword > 0"},
+		{name: "empty", expr: "", wantErr: true},
+		{name: "unknown subject", expr: "stat kills >= 3", wantErr: true},
+		{name: "unknown operator", expr: "wallet GOLD => 100", wantErr: true},
+		{name: "unknown status", expr: "goal kill-10 status == done", wantErr: true},
+		{name: "non-numeric wallet value", expr: "wallet GOLD >= lots", wantErr: true},
+		{name: "missing field", expr: "goal kill-10 == completed", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := ParseAssertion(tt.expr)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error for %q", tt.expr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if a.String() != tt.expr {
+				t.Errorf("Expected round-trip '%s', got '%s'", tt.expr, a.String())
+			}
+		})
+	}
+}
+
+func TestScenario_Run_Assertions(t *testing.T) {
+	deps := Deps{APIClient: newTestAPI(), RewardVerifier: ags.NewMockRewardVerifier()}
+
+	tests := []struct {
+		name         string
+		assert       string
+		wantExitCode int
+	}{
+		{name: "goal status passes", assert: "goal daily/kill-10 status == in_progress", wantExitCode: 0},
+		{name: "goal status ordering passes", assert: "goal kill-10 status < completed", wantExitCode: 0},
+		{name: "goal status fails", assert: "goal kill-10 status == completed", wantExitCode: 1},
+		{name: "wallet passes", assert: "wallet GOLD >= 100", wantExitCode: 0},
+		{name: "wallet fails", assert: "wallet GEMS >= 100", wantExitCode: 1},
+		{name: "item passes", assert: "item bronze_shield == 2", wantExitCode: 0},
+		{name: "missing item fails", assert: "item golden_crown >= 1", wantExitCode: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := &Scenario{Name: "assertions", Steps: []Step{{Type: StepAssert, Assert: tt.assert}}}
+
+			result, err := sc.Run(context.Background(), deps)
+			if result == nil {
+				t.Fatalf("Expected a result, got error %v", err)
+			}
+
+			if code := result.ExitCode(); code != tt.wantExitCode {
+				t.Errorf("Expected exit code %d, got %d (err: %v)", tt.wantExitCode, code, err)
+			}
+			if (err != nil) != (tt.wantExitCode != 0) {
+				t.Errorf("Expected error only for failing assertions, got %v", err)
+			}
+		})
+	}
+}
+
+func TestScenario_Run_AssertionFailureSkipsRemainingSteps(t *testing.T) {
+	sc := &Scenario{Name: "gate", Steps: []Step{
+		{Type: StepAssert, Assert: "wallet GOLD >= 100"},
+		{Type: StepAssert, Assert: "goal kill-10 status == claimed"},
+		{Type: StepClaim, Challenge: "daily", Goal: "kill-10"},
+	}}
+
+	result, err := sc.Run(context.Background(), Deps{APIClient: newTestAPI(), RewardVerifier: ags.NewMockRewardVerifier()})
+	if !errors.Is(err, ErrAssertionFailed) {
+		t.Fatalf("Expected ErrAssertionFailed, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "actual: in_progress") {
+		t.Errorf("Expected error to report the actual status, got %v", err)
+	}
+
+	if result.Succeeded != 1 || result.Failed != 1 || result.Skipped != 1 || result.ExitCode() != 1 {
+		t.Errorf("Expected 1 succeeded, 1 failed, 1 skipped and exit code 1, got %d/%d/%d and %d",
+			result.Succeeded, result.Failed, result.Skipped, result.ExitCode())
+	}
+}
+
+func TestScenarioResult_JUnitSuite(t *testing.T) {
+	result := &ScenarioResult{Name: "gate"}
+	result.add(StepResult{Index: 0, Step: "assert wallet GOLD >= 100", Status: StatusSuccess, DurationMs: 12})
+	result.add(StepResult{Index: 1, Step: "assert wallet GEMS >= 100", Status: StatusError,
+		Error: errors.Join(ErrAssertionFailed, errors.New("actual: 25"))})
+	result.add(StepResult{Index: 2, Step: "claim daily/kill-10", Status: StatusError, Error: errors.New("HTTP 500")})
+	result.add(StepResult{Index: 3, Step: "verify daily/kill-10", Status: StatusSkipped})
+
+	var buf bytes.Buffer
+	if err := result.JUnitSuite().Write(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	report := buf.String()
+	for _, want := range []string{
+		`<testsuite name="gate" tests="4" failures="1" errors="1" skipped="1"`,
+		`<testcase name="01 assert wallet GOLD &gt;= 100" classname="gate" time="0.012">`,
+		`<failure message="assertion failed`,
+		`<error message="HTTP 500">`,
+		`<skipped message="skipped after an earlier failure">`,
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected report to contain '%s', got:\n%s", want, report)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/junit"
+)
+
+// JUnitSuite converts the result into a JUnit suite, one test case per step
+// Failed assertions are reported as failures; other step errors as errors.
+func (r *ScenarioResult) JUnitSuite() *junit.Suite {
+	name := r.Name
+	if name == "" {
+		name = "scenario"
+	}
+
+	suite := &junit.Suite{Name: name, Cases: []junit.Case{}}
+	for _, step := range r.Steps {
+		tc := junit.Case{
+			Name:     fmt.Sprintf("%02d %s", step.Index+1, step.Step),
+			Duration: time.Duration(step.DurationMs) * time.Millisecond,
+		}
+
+		switch {
+		case step.Status == StatusSkipped:
+			tc.Skipped = "skipped after an earlier failure"
+		case step.Error != nil && errors.Is(step.Error, ErrAssertionFailed):
+			tc.Failure = step.Error.Error()
+		case step.Error != nil:
+			tc.Error = step.Error.Error()
+		}
+
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	return suite
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package scenario
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/events"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/retry"
+)
+
+// Step result statuses
+const (
+	StatusSuccess = "success"
+	StatusError   = "error"
+	StatusSkipped = "skipped"
+)
+
+// Deps are the services a scenario runs against
+// EventTrigger is only required by trigger steps; RewardVerifier by verify steps
+// and wallet/item assertions.
+type Deps struct {
+	APIClient      api.APIClient
+	EventTrigger   events.EventTrigger
+	RewardVerifier ags.RewardVerifier
+	UserID         string
+	Namespace      string
+}
+
+// StepResult is the outcome of a single step
+type StepResult struct {
+	Index      int
+	Step       string // Step description
+	Status     string // success, error, or skipped
+	DurationMs int64
+	Error      error
+}
+
+// ScenarioResult is the outcome of a scenario run
+type ScenarioResult struct {
+	Name      string
+	Steps     []StepResult
+	Succeeded int
+	Failed    int
+	Skipped   int
+}
+
+// ExitCode returns the process exit code for CI: 0 if every step passed, 1 otherwise
+func (r *ScenarioResult) ExitCode() int {
+	if r.Failed > 0 || r.Skipped > 0 {
+		return 1
+	}
+	return 0
+}
+
+// add appends a step result and updates the counters
+func (r *ScenarioResult) add(step StepResult) {
+	r.Steps = append(r.Steps, step)
+	switch step.Status {
+	case StatusSuccess:
+		r.Succeeded++
+	case StatusError:
+		r.Failed++
+	case StatusSkipped:
+		r.Skipped++
+	}
+}
+
+// Run validates the scenario and executes its steps in order
+//
+// Without ContinueOnError, steps after the first failure are skipped.
+//
+// Returns:
+//   - *ScenarioResult: Per-step outcomes (nil if validation failed)
+//   - error: Non-nil if the scenario is invalid, a required dependency is
+//     missing, or any step failed (wrapping the first step error)
+func (s *Scenario) Run(ctx context.Context, deps Deps) (*ScenarioResult, error) {
+	if err := s.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario: %w", err)
+	}
+	if err := s.checkDeps(deps); err != nil {
+		return nil, err
+	}
+
+	result := &ScenarioResult{Name: s.Name, Steps: []StepResult{}}
+
+	var firstErr error
+	for i, step := range s.Steps {
+		if firstErr != nil && !s.ContinueOnError {
+			result.add(StepResult{Index: i, Step: step.String(), Status: StatusSkipped})
+			continue
+		}
+
+		start := time.Now()
+		err := runStep(ctx, deps, step)
+
+		item := StepResult{
+			Index:      i,
+			Step:       step.String(),
+			Status:     StatusSuccess,
+			DurationMs: time.Since(start).Milliseconds(),
+		}
+		if err != nil {
+			item.Status = StatusError
+			item.Error = err
+			if firstErr == nil {
+				firstErr = fmt.Errorf("step %d (%s) failed: %w", i, step, err)
+			}
+		}
+		result.add(item)
+	}
+
+	return result, firstErr
+}
+
+// checkDeps verifies the services needed by the scenario's steps are present
+func (s *Scenario) checkDeps(deps Deps) error {
+	if deps.APIClient == nil {
+		return fmt.Errorf("scenario requires an API client")
+	}
+
+	for _, step := range s.Steps {
+		switch {
+		case step.Type == StepTrigger && deps.EventTrigger == nil:
+			return fmt.Errorf("scenario triggers events but the event handler is not connected")
+		case step.Type == StepVerify && deps.RewardVerifier == nil:
+			return fmt.Errorf("scenario verifies rewards but no reward verifier is configured")
+		case step.Type == StepAssert && deps.RewardVerifier == nil:
+			if a, err := ParseAssertion(step.Assert); err == nil && a.Subject != SubjectGoal {
+				return fmt.Errorf("scenario asserts wallet or item state but no reward verifier is configured")
+			}
+		}
+	}
+
+	return nil
+}
+
+// runStep executes a single validated step
+func runStep(ctx context.Context, deps Deps, step Step) error {
+	switch step.Type {
+	case StepInitialize:
+		_, err := deps.APIClient.InitializePlayer(ctx)
+		return err
+
+	case StepSetActive:
+		_, err := deps.APIClient.SetGoalActive(ctx, step.Challenge, step.Goal, step.IsActive())
+		return err
+
+	case StepTrigger:
+		var err error
+		if step.Event == EventLogin {
+			err = deps.EventTrigger.TriggerLogin(ctx, deps.UserID, deps.Namespace)
+		} else {
+			err = deps.EventTrigger.TriggerStatUpdate(ctx, deps.UserID, deps.Namespace, step.Stat, step.Value, 0)
+		}
+		if err == nil {
+			api.EventSent(deps.APIClient)
+		}
+		return err
+
+	case StepClaim:
+		_, err := deps.APIClient.ClaimReward(ctx, step.Challenge, step.Goal)
+		return err
+
+	case StepVerify:
+		return verifyGoalReward(ctx, deps, step.Challenge, step.Goal)
+
+	case StepWait:
+		if step.Until == nil {
+			return sleep(ctx, step.Duration)
+		}
+		return waitUntil(ctx, deps.APIClient, *step.Until, step.Timeout, step.Interval)
+
+	case StepAssert:
+		assertion, err := ParseAssertion(step.Assert)
+		if err != nil {
+			return err
+		}
+		return assertion.Evaluate(ctx, deps)
+	}
+
+	return fmt.Errorf("unknown step type %q", step.Type)
+}
+
+// verifyGoalReward checks that the goal's reward quantity is present in AGS Platform
+func verifyGoalReward(ctx context.Context, deps Deps, challengeID, goalID string) error {
+	goal, err := getGoal(ctx, deps.APIClient, challengeID, goalID)
+	if err != nil {
+		return err
+	}
+
+	// A missing entitlement or wallet counts as 0, as in verify-reward
+	reward := goal.Reward
+	actual, lookupErr := ags.GrantedQuantity(ctx, deps.RewardVerifier, reward.Type, reward.RewardID)
+	if errors.Is(lookupErr, ags.ErrUnsupportedRewardType) {
+		return lookupErr
+	}
+
+	if actual < int64(reward.Quantity) {
+		if lookupErr != nil {
+			return fmt.Errorf("reward %s %s not granted (expected %d, got %d: %v)",
+				reward.Type, reward.RewardID, reward.Quantity, actual, lookupErr)
+		}
+		return fmt.Errorf("reward %s %s not granted (expected %d, got %d)",
+			reward.Type, reward.RewardID, reward.Quantity, actual)
+	}
+
+	return nil
+}
+
+// waitUntil polls the goal until the condition holds or the timeout elapses
+// Each poll bypasses any read cache, so progress made by events is seen.
+func waitUntil(ctx context.Context, apiClient api.APIClient, cond Condition, timeout, interval time.Duration) error {
+	if timeout == 0 {
+		timeout = DefaultWaitTimeout
+	}
+	if interval == 0 {
+		interval = DefaultPollInterval
+	}
+
+	var goal *api.Goal
+	err := retry.Poll(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		api.InvalidateCache(apiClient)
+		goal, err = getGoal(ctx, apiClient, cond.Challenge, cond.Goal)
+		if err != nil {
+			return false, err
+		}
+		return cond.met(goal), nil
+	})
+	if errors.Is(err, retry.ErrPollTimeout) && goal != nil {
+		return fmt.Errorf("%w waiting for %s (status: %s, progress: %d)",
+			err, cond, goal.Status, goal.Progress)
+	}
+	if errors.Is(err, retry.ErrPollTimeout) {
+		return fmt.Errorf("waiting for %s: %w", cond, err)
+	}
+
+	return err
+}
+
+// met reports whether the goal satisfies the condition
+func (c Condition) met(goal *api.Goal) bool {
+	if c.Status != "" && !compareStatus(">=", goal.Status, c.Status) {
+		return false
+	}
+	return compareInt(">=", int64(goal.Progress), int64(c.Progress))
+}
+
+// getGoal fetches a single goal from the challenge service
+func getGoal(ctx context.Context, apiClient api.APIClient, challengeID, goalID string) (*api.Goal, error) {
+	challenge, err := apiClient.GetChallenge(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	for i := range challenge.Goals {
+		if challenge.Goals[i].ID == goalID {
+			return &challenge.Goals[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("goal %s not found in challenge %s", goalID, challengeID)
+}
+
+// sleep waits for d or until the context is cancelled
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+// Package scenario runs ordered, reproducible sequences of demo actions
+// (initialize, set-active, trigger, claim, verify, wait, assert) against the challenge
+// service, the event handler, and AGS Platform.
+package scenario
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Step types
+const (
+	StepInitialize = "initialize"
+	StepSetActive  = "set-active"
+	StepTrigger    = "trigger"
+	StepClaim      = "claim"
+	StepVerify     = "verify"
+	StepWait       = "wait"
+	StepAssert     = "assert"
+)
+
+// Event types for trigger steps
+const (
+	EventLogin      = "login"
+	EventStatUpdate = "stat-update"
+)
+
+// Defaults for conditional waits
+const (
+	DefaultWaitTimeout  = 30 * time.Second
+	DefaultPollInterval = 1 * time.Second
+)
+
+// Scenario is an ordered list of steps
+//
+// Example (YAML):
+//
+//	name: daily-kills
+//	steps:
+//	  - type: initialize
+//	  - type: set-active
+//	    challenge: daily-quests
+//	    goal: kill-10
+//	  - type: trigger
+//	    event: stat-update
+//	    stat: kills
+//	    value: 10
+//	  - type: wait
+//	    until: {challenge: daily-quests, goal: kill-10, status: completed}
+//	    timeout: 10s
+//	  - type: claim
+//	    challenge: daily-quests
+//	    goal: kill-10
+//	  - type: verify
+//	    challenge: daily-quests
+//	    goal: kill-10
+//	  - type: assert
+//	    assert: wallet GOLD >= 100
+type Scenario struct {
+	Name            string `yaml:"name"`
+	ContinueOnError bool   `yaml:"continue_on_error"` // Keep running steps after a failure
+	Steps           []Step `yaml:"steps"`
+}
+
+// Step is a single scenario action
+// Which fields apply depends on Type.
+type Step struct {
+	Type string `yaml:"type"`
+
+	// set-active, claim, verify
+	Challenge string `yaml:"challenge"`
+	Goal      string `yaml:"goal"`
+	Active    *bool  `yaml:"active"` // set-active only; defaults to true
+
+	// trigger
+	Event string `yaml:"event"` // login or stat-update
+	Stat  string `yaml:"stat"`
+	Value int    `yaml:"value"`
+
+	// wait: either a fixed Duration or polling Until a condition holds
+	Duration time.Duration `yaml:"duration"`
+	Until    *Condition    `yaml:"until"`
+	Timeout  time.Duration `yaml:"timeout"`  // Defaults to DefaultWaitTimeout
+	Interval time.Duration `yaml:"interval"` // Defaults to DefaultPollInterval
+
+	// assert: expression checked against live state (see Assertion)
+	Assert string `yaml:"assert"`
+}
+
+// Condition is a goal state a wait step polls for
+// Status is met once the goal reaches it or a later status
+// (not_started < in_progress < completed < claimed).
+type Condition struct {
+	Challenge string `yaml:"challenge"`
+	Goal      string `yaml:"goal"`
+	Status    string `yaml:"status"`
+	Progress  int32  `yaml:"progress"` // Minimum progress value
+}
+
+// statusRank orders goal statuses for Condition matching
+var statusRank = map[string]int{
+	"not_started": 0,
+	"in_progress": 1,
+	"completed":   2,
+	"claimed":     3,
+}
+
+// Parse decodes and validates a YAML scenario
+//
+// Returns:
+//   - *Scenario: The decoded scenario
+//   - error: Non-nil if the YAML has unknown fields or a step is invalid
+func Parse(data []byte) (*Scenario, error) {
+	var sc Scenario
+	if err := yaml.UnmarshalStrict(data, &sc); err != nil {
+		return nil, err
+	}
+
+	if err := sc.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &sc, nil
+}
+
+// IsActive returns the requested active state for a set-active step
+func (s Step) IsActive() bool {
+	return s.Active == nil || *s.Active
+}
+
+// String describes the step for results and logs
+func (s Step) String() string {
+	switch s.Type {
+	case StepSetActive:
+		return fmt.Sprintf("set-active %s/%s=%t", s.Challenge, s.Goal, s.IsActive())
+	case StepTrigger:
+		if s.Event == EventStatUpdate {
+			return fmt.Sprintf("trigger stat-update %s=%d", s.Stat, s.Value)
+		}
+		return "trigger " + s.Event
+	case StepClaim, StepVerify:
+		return fmt.Sprintf("%s %s/%s", s.Type, s.Challenge, s.Goal)
+	case StepWait:
+		if s.Until != nil {
+			return "wait until " + s.Until.String()
+		}
+		return "wait " + s.Duration.String()
+	case StepAssert:
+		return "assert " + s.Assert
+	}
+	return s.Type
+}
+
+// String describes the condition
+func (c Condition) String() string {
+	desc := c.Challenge + "/" + c.Goal
+	if c.Status != "" {
+		desc += " status>=" + c.Status
+	}
+	if c.Progress > 0 {
+		desc += fmt.Sprintf(" progress>=%d", c.Progress)
+	}
+	return desc
+}
+
+// Validate checks every step before anything runs
+//
+// Returns:
+//   - error: Non-nil describing the first invalid step
+func (s *Scenario) Validate() error {
+	if len(s.Steps) == 0 {
+		return fmt.Errorf("scenario has no steps")
+	}
+
+	for i, step := range s.Steps {
+		if err := step.validate(); err != nil {
+			return fmt.Errorf("steps[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validate checks a single step's fields for its type
+func (s Step) validate() error {
+	switch s.Type {
+	case StepInitialize:
+		return nil
+
+	case StepSetActive, StepClaim, StepVerify:
+		if s.Challenge == "" || s.Goal == "" {
+			return fmt.Errorf("%s requires challenge and goal", s.Type)
+		}
+		return nil
+
+	case StepTrigger:
+		switch s.Event {
+		case EventLogin:
+			return nil
+		case EventStatUpdate:
+			if s.Stat == "" {
+				return fmt.Errorf("stat is required for stat-update")
+			}
+			return nil
+		}
+		return fmt.Errorf("unknown event type %q (expected login or stat-update)", s.Event)
+
+	case StepWait:
+		if s.Until == nil {
+			if s.Duration <= 0 {
+				return fmt.Errorf("wait requires a positive duration or an until condition")
+			}
+			return nil
+		}
+		if s.Duration != 0 {
+			return fmt.Errorf("wait accepts either duration or until, not both")
+		}
+		if s.Timeout < 0 || s.Interval < 0 {
+			return fmt.Errorf("wait timeout and interval cannot be negative")
+		}
+		return s.Until.validate()
+
+	case StepAssert:
+		_, err := ParseAssertion(s.Assert)
+		return err
+	}
+
+	return fmt.Errorf("unknown step type %q", s.Type)
+}
+
+// validate checks that the condition names a goal and something to wait for
+func (c Condition) validate() error {
+	if c.Challenge == "" || c.Goal == "" {
+		return fmt.Errorf("until requires challenge and goal")
+	}
+	if c.Status == "" && c.Progress <= 0 {
+		return fmt.Errorf("until requires a status or a positive progress")
+	}
+	if _, ok := statusRank[c.Status]; c.Status != "" && !ok {
+		return fmt.Errorf("unknown status %q", c.Status)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package scenario
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// completingTrigger records events and completes a goal on each stat update
+type completingTrigger struct {
+	apiClient *api.MockAPIClient
+	calls     []string
+	err       error
+	mu        sync.Mutex
+}
+
+func (c *completingTrigger) TriggerLogin(ctx context.Context, userID, namespace string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, "login")
+	return c.err
+}
+
+func (c *completingTrigger) TriggerStatUpdate(ctx context.Context, userID, namespace, statCode string, value, inc int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, fmt.Sprintf("stat-update %s=%d", statCode, value))
+	if c.err != nil {
+		return c.err
+	}
+
+	goal := &c.apiClient.Challenges[0].Goals[0]
+	goal.Progress = int32(value)
+	if goal.Progress >= goal.Requirement.TargetValue {
+		goal.Status = "completed"
+	}
+	return nil
+}
+
+func (c *completingTrigger) Close() error {
+	return nil
+}
+
+func newTestAPI() *api.MockAPIClient {
+	return api.NewMockAPIClient([]api.Challenge{
+		{ID: "daily", Goals: []api.Goal{
+			{
+				ID:          "kill-10",
+				Status:      "in_progress",
+				Requirement: api.Requirement{StatCode: "kills", TargetValue: 10},
+				Reward:      api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 100},
+			},
+		}},
+	})
+}
+
+const testScenarioYAML = `
+name: daily-kills
+steps:
+  - type: initialize
+  - type: set-active
+    challenge: daily
+    goal: kill-10
+  - type: trigger
+    event: login
+  - type: trigger
+    event: stat-update
+    stat: kills
+    value: 10
+  - type: wait
+    until: {challenge: daily, goal: kill-10, status: completed}
+    timeout: 200ms
+    interval: 10ms
+  - type: claim
+    challenge: daily
+    goal: kill-10
+  - type: verify
+    challenge: daily
+    goal: kill-10
+`
+
+func loadTestScenario(t *testing.T) *Scenario {
+	sc, err := Parse([]byte(testScenarioYAML))
+	if err != nil {
+		t.Fatalf("Failed to parse scenario: %v", err)
+	}
+	return sc
+}
+
+func TestScenario_Run_StepOrdering(t *testing.T) {
+	sc := loadTestScenario(t)
+	apiClient := newTestAPI()
+	trigger := &completingTrigger{apiClient: apiClient}
+
+	result, err := sc.Run(context.Background(), Deps{
+		APIClient:      apiClient,
+		EventTrigger:   trigger,
+		RewardVerifier: ags.NewMockRewardVerifier(),
+		UserID:         "user-1",
+		Namespace:      "demo",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"initialize",
+		"set-active daily/kill-10=true",
+		"trigger login",
+		"trigger stat-update kills=10",
+		"wait until daily/kill-10 status>=completed",
+		"claim daily/kill-10",
+		"verify daily/kill-10",
+	}
+
+	if result.Name != "daily-kills" || result.Succeeded != len(expected) {
+		t.Fatalf("Expected %d successful steps, got %d (%+v)", len(expected), result.Succeeded, result.Steps)
+	}
+
+	for i, step := range expected {
+		if result.Steps[i].Step != step {
+			t.Errorf("Step %d: expected '%s', got '%s'", i, step, result.Steps[i].Step)
+		}
+	}
+
+	if len(apiClient.ClaimCalls) != 1 || apiClient.ClaimCalls[0] != "daily/kill-10" {
+		t.Errorf("Expected a single claim for daily/kill-10, got %v", apiClient.ClaimCalls)
+	}
+}
+
+func TestScenario_Run_FailurePropagation(t *testing.T) {
+	sc := loadTestScenario(t)
+	apiClient := newTestAPI()
+	trigger := &completingTrigger{apiClient: apiClient, err: errors.New("connection refused")}
+
+	result, err := sc.Run(context.Background(), Deps{
+		APIClient:      apiClient,
+		EventTrigger:   trigger,
+		RewardVerifier: ags.NewMockRewardVerifier(),
+	})
+	if err == nil || !strings.Contains(err.Error(), "step 2 (trigger login)") {
+		t.Fatalf("Expected error naming the failed step, got %v", err)
+	}
+	if !errors.Is(err, trigger.err) {
+		t.Errorf("Expected error to wrap the trigger error, got %v", err)
+	}
+
+	if result.Succeeded != 2 || result.Failed != 1 || result.Skipped != 4 {
+		t.Errorf("Expected 2 succeeded, 1 failed, 4 skipped, got %d/%d/%d", result.Succeeded, result.Failed, result.Skipped)
+	}
+	if len(trigger.calls) != 1 || len(apiClient.ClaimCalls) != 0 {
+		t.Errorf("Expected steps after the failure not to run, got calls %v and claims %v", trigger.calls, apiClient.ClaimCalls)
+	}
+
+	// With ContinueOnError every step runs and the first failure is still reported
+	sc.ContinueOnError = true
+	trigger.calls = nil
+
+	result, err = sc.Run(context.Background(), Deps{
+		APIClient:      newTestAPI(),
+		EventTrigger:   trigger,
+		RewardVerifier: ags.NewMockRewardVerifier(),
+	})
+	if err == nil {
+		t.Fatal("Expected error with ContinueOnError")
+	}
+	if result.Skipped != 0 || len(trigger.calls) != 2 {
+		t.Errorf("Expected all steps to run, got %d skipped and calls %v", result.Skipped, trigger.calls)
+	}
+}
+
+func TestScenario_Run_WaitTimeout(t *testing.T) {
+	sc := &Scenario{Steps: []Step{
+		{
+			Type:     StepWait,
+			Until:    &Condition{Challenge: "daily", Goal: "kill-10", Status: "claimed"},
+			Timeout:  50 * time.Millisecond,
+			Interval: 10 * time.Millisecond,
+		},
+	}}
+
+	result, err := sc.Run(context.Background(), Deps{APIClient: newTestAPI()})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("Expected timeout error, got %v", err)
+	}
+	if result.Failed != 1 {
+		t.Errorf("Expected 1 failed step, got %d", result.Failed)
+	}
+}
+
+func TestScenario_Run_WaitWithCache(t *testing.T) {
+	// The first wait caches the in-progress goal; the second must still see the event's progress
+	sc := &Scenario{Steps: []Step{
+		{Type: StepWait, Until: &Condition{Challenge: "daily", Goal: "kill-10", Status: "in_progress"}, Timeout: 50 * time.Millisecond, Interval: 5 * time.Millisecond},
+		{Type: StepTrigger, Event: EventStatUpdate, Stat: "kills", Value: 10},
+		{Type: StepWait, Until: &Condition{Challenge: "daily", Goal: "kill-10", Status: "completed"}, Timeout: 50 * time.Millisecond, Interval: 5 * time.Millisecond},
+	}}
+	backend := newTestAPI()
+
+	result, err := sc.Run(context.Background(), Deps{
+		APIClient:    api.NewCachingAPIClient(backend, time.Hour),
+		EventTrigger: &completingTrigger{apiClient: backend},
+	})
+	if err != nil {
+		t.Fatalf("Expected the wait to see the completed goal through the cache, got %v", err)
+	}
+	if result.Succeeded != 3 {
+		t.Errorf("Expected 3 successful steps, got %d", result.Succeeded)
+	}
+}
+
+func TestScenario_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		step Step
+	}{
+		{name: "unknown type", step: Step{Type: "teleport"}},
+		{name: "claim without goal", step: Step{Type: StepClaim, Challenge: "daily"}},
+		{name: "unknown event", step: Step{Type: StepTrigger, Event: "logout"}},
+		{name: "stat-update without stat", step: Step{Type: StepTrigger, Event: EventStatUpdate}},
+		{name: "wait without duration or condition", step: Step{Type: StepWait}},
+		{name: "wait with both", step: Step{Type: StepWait, Duration: time.Second, Until: &Condition{Challenge: "c", Goal: "g", Status: "completed"}}},
+		{name: "condition without target", step: Step{Type: StepWait, Until: &Condition{Challenge: "c", Goal: "g"}}},
+		{name: "condition with unknown status", step: Step{Type: StepWait, Until: &Condition{Challenge: "c", Goal: "g", Status: "done"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := &Scenario{Steps: []Step{{Type: StepInitialize}, tt.step}}
+			err := sc.Validate()
+			if err == nil || !strings.Contains(err.Error(), "steps[1]") {
+				t.Errorf("Expected validation error for steps[1], got %v", err)
+			}
+		})
+	}
+
+	// Nothing runs when validation fails
+	apiClient := newTestAPI()
+	sc := &Scenario{Steps: []Step{{Type: StepClaim, Challenge: "daily", Goal: "kill-10"}, {Type: "teleport"}}}
+	if _, err := sc.Run(context.Background(), Deps{APIClient: apiClient}); err == nil {
+		t.Fatal("Expected invalid scenario error")
+	}
+	if len(apiClient.ClaimCalls) != 0 {
+		t.Errorf("Expected no calls for an invalid scenario, got %v", apiClient.ClaimCalls)
+	}
+}
+
+func TestScenario_Run_MissingDeps(t *testing.T) {
+	sc := &Scenario{Steps: []Step{{Type: StepTrigger, Event: EventLogin}}}
+
+	if _, err := sc.Run(context.Background(), Deps{APIClient: newTestAPI()}); err == nil {
+		t.Error("Expected error when the event trigger is missing")
+	}
+}
@@ -7,12 +7,16 @@ package tui
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 )
 
 // TickMsg is sent periodically for token refresh checks
@@ -27,6 +31,7 @@ const (
 	ScreenDashboard Screen = iota
 	ScreenEventSimulator
 	ScreenInventory
+	ScreenGoalSelection
 )
 
 // AppModel is the root model containing all screen models
@@ -35,9 +40,14 @@ type AppModel struct {
 	dashboard      *DashboardModel
 	eventSimulator *EventSimulatorModel
 	inventory      *InventoryModel
+	goalSelection  *GoalSelectionModel
+	help           *HelpModel // Keybindings overlay drawn over the current screen
 	currentScreen  Screen
 	width          int
 	height         int
+	fixedWidth     bool     // Width forced by --output-width; window resizes don't change it
+	users          []string // Users 'u' cycles through (mock auth), starting with --user-id
+	notice         string   // Result of the last user switch, cleared by the next key
 	quitting       bool
 }
 
@@ -53,6 +63,8 @@ func NewAppModel(container *app.Container) AppModel {
 		dashboard:      NewDashboardModel(container.APIClient),
 		eventSimulator: eventSimulator,
 		inventory:      NewInventoryModel(container.RewardVerifier),
+		goalSelection:  NewGoalSelectionModel(container.APIClient),
+		help:           NewHelpModel(),
 		currentScreen:  ScreenDashboard,
 		width:          80,
 		height:         24,
@@ -80,6 +92,11 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.currentScreen == ScreenEventSimulator && m.eventSimulator != nil {
 			skipGlobalShortcuts = m.eventSimulator.IsInputFocused()
 		}
+		if m.currentScreen == ScreenDashboard && m.dashboard != nil {
+			skipGlobalShortcuts = m.dashboard.IsInputFocused()
+		}
+
+		m.notice = ""
 
 		// Always allow Ctrl+C to quit (unconditional escape hatch)
 		if msg.String() == "ctrl+c" {
@@ -87,6 +104,13 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		}
 
+		// Help overlay captures all keys until closed; the screen underneath keeps its state
+		if m.help.Visible() {
+			newHelp, cmd := m.help.Update(msg)
+			m.help = newHelp.(*HelpModel)
+			return m, cmd
+		}
+
 		// Skip navigation shortcuts (including 'q') if input is focused
 		if !skipGlobalShortcuts {
 			switch msg.String() {
@@ -95,6 +119,15 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.quitting = true
 				return m, tea.Quit
 
+			case "?":
+				// Show keybindings overlay
+				m.help.Toggle()
+				return m, nil
+
+			case "u":
+				// Act as the next user from --users
+				return m, m.switchUser()
+
 			case "1":
 				// Switch to dashboard
 				m.currentScreen = ScreenDashboard
@@ -113,6 +146,14 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Load inventory data when entering screen
 				return m, func() tea.Msg { return LoadInventoryMsg{} }
 
+			case "g":
+				// Switch to goal selection for the challenge selected on the dashboard
+				if m.currentScreen != ScreenGoalSelection {
+					m.goalSelection.SetChallenge(m.dashboard.selectedChallenge())
+					m.currentScreen = ScreenGoalSelection
+					return m, nil
+				}
+
 			case "esc":
 				// Return to dashboard (only from other screens, not from dashboard itself)
 				if m.currentScreen != ScreenDashboard {
@@ -124,13 +165,46 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.WindowSizeMsg:
-		m.width = msg.Width
+		if !m.fixedWidth {
+			m.width = msg.Width
+		}
 		m.height = msg.Height
 		return m, nil
 
 	case TickMsg:
 		// Handle token refresh check (every 1 minute)
 		return m, tokenRefreshTickCmd()
+
+	case AutoRefreshTickMsg:
+		// Always route to dashboard so the timer keeps running on other screens
+		newDashboard, cmd := m.dashboard.Update(msg)
+		m.dashboard = newDashboard.(*DashboardModel)
+		return m, cmd
+
+	case ReloadChallengesMsg:
+		// Always route to dashboard (sent from other screens after goals or progress change)
+		newDashboard, cmd := m.dashboard.Update(msg)
+		m.dashboard = newDashboard.(*DashboardModel)
+		return m, cmd
+
+	case ChallengeDetailLoadedMsg:
+		// Always route to dashboard so prefetches finish on other screens
+		newDashboard, cmd := m.dashboard.Update(msg)
+		m.dashboard = newDashboard.(*DashboardModel)
+		return m, cmd
+
+	case ChallengesLoadedMsg:
+		// Always route to dashboard so reloads finish on other screens, then refresh goal selection
+		newDashboard, cmd := m.dashboard.Update(msg)
+		m.dashboard = newDashboard.(*DashboardModel)
+		if msg.err == nil {
+			m.goalSelection.SyncChallenge(m.dashboard.challenges)
+		}
+		return m, cmd
+	}
+
+	if triggered, ok := msg.(eventTriggeredMsg); ok && triggered.err == nil {
+		api.EventSent(m.container.APIClient)
 	}
 
 	// Route message to current screen
@@ -151,6 +225,11 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		newInventory, cmd := m.inventory.Update(msg)
 		m.inventory = newInventory.(*InventoryModel)
 		return m, cmd
+
+	case ScreenGoalSelection:
+		newGoalSelection, cmd := m.goalSelection.Update(msg)
+		m.goalSelection = newGoalSelection.(*GoalSelectionModel)
+		return m, cmd
 	}
 
 	return m, cmd
@@ -178,13 +257,21 @@ func (m AppModel) View() string {
 		}
 	case ScreenInventory:
 		content = m.inventory.View()
+	case ScreenGoalSelection:
+		content = m.goalSelection.View()
+	}
+	if m.help.Visible() {
+		content = m.help.View()
 	}
 
 	// Render footer
 	footer := m.renderFooter()
+	if m.notice != "" {
+		footer = boldStyle.Render(m.notice) + "\n" + footer
+	}
 
 	// Combine with spacing
-	return lipgloss.JoinVertical(
+	view := lipgloss.JoinVertical(
 		lipgloss.Left,
 		header,
 		"\n",
@@ -192,6 +279,78 @@ func (m AppModel) View() string {
 		"\n",
 		footer,
 	)
+
+	// Wrap at the forced width so output is identical on any terminal
+	if m.fixedWidth {
+		view = lipgloss.NewStyle().Width(m.width).Render(view)
+	}
+	return view
+}
+
+// SetWidth forces the render width (<= 0 follows the terminal size)
+func (m *AppModel) SetWidth(width int) {
+	if width <= 0 {
+		m.fixedWidth = false
+		return
+	}
+	m.width = width
+	m.fixedWidth = true
+}
+
+// SetUsers sets the users 'u' switches between, after the container's current user
+func (m *AppModel) SetUsers(users []string) {
+	m.users = []string{m.container.UserID}
+	for _, user := range users {
+		if user != "" && !slices.Contains(m.users, user) {
+			m.users = append(m.users, user)
+		}
+	}
+}
+
+// switchUser makes the app act as the next configured user and reloads the dashboard and
+// inventory as them. Only mock auth can switch; other modes leave the user unchanged and show
+// a warning, as does a pending claim or request.
+func (m *AppModel) switchUser() tea.Cmd {
+	g := glyph.Current()
+	if len(m.users) < 2 {
+		m.notice = g.Warning + " No other users to switch to (pass --users)"
+		return nil
+	}
+
+	if m.requestInFlight() {
+		m.notice = g.Warning + " Finish the pending claim or request before switching users"
+		return nil
+	}
+
+	next := m.users[0]
+	if i := slices.Index(m.users, m.container.UserID); i >= 0 && i+1 < len(m.users) {
+		next = m.users[i+1]
+	}
+	if err := m.container.SwitchUser(next); err != nil {
+		m.notice = g.Warning + " " + err.Error()
+		return nil
+	}
+
+	if m.eventSimulator != nil {
+		m.eventSimulator.SetUser(next)
+	}
+	m.notice = g.Success + " Now acting as " + next
+	return tea.Batch(
+		func() tea.Msg { return ReloadChallengesMsg{} },
+		func() tea.Msg { return LoadInventoryMsg{} },
+	)
+}
+
+// requestInFlight returns true if a claim awaits confirmation or any screen waits on a request
+// Switching users then would claim for, or show the response of, the wrong user.
+func (m *AppModel) requestInFlight() bool {
+	if m.dashboard.pendingClaim != nil || m.dashboard.claiming || m.dashboard.loading || m.dashboard.refreshing {
+		return true
+	}
+	if m.eventSimulator != nil && m.eventSimulator.loading {
+		return true
+	}
+	return m.inventory.loading || m.inventory.loadingMore || m.goalSelection.submitting
 }
 
 // renderHeader renders the status bar
@@ -204,16 +363,30 @@ func (m AppModel) renderHeader() string {
 		screen = "Event Simulator"
 	case ScreenInventory:
 		screen = "Inventory & Wallets"
+	case ScreenGoalSelection:
+		screen = "Goal Selection"
 	}
 
 	// Get token status (user + optional admin)
-	authStatus := "Auth: ✗ No token"
+	g := glyph.Current()
+	authStatus := "Auth: " + g.Failure + " No token"
 	ctx := context.Background()
 
 	// User token status
 	userTokenStatus := ""
+	userID, namespace := m.container.UserID, m.container.Namespace
 	token, err := m.container.AuthProvider.GetToken(ctx)
 	if err == nil && token != nil {
+		// Show who the token actually acts as, not just the flag values
+		if claims, err := auth.ParseJWTClaims(token.AccessToken); err == nil {
+			if claims.Subject != "" {
+				userID = claims.Subject
+			}
+			if claims.Namespace != "" {
+				namespace = claims.Namespace
+			}
+		}
+
 		if m.container.AuthProvider.IsTokenValid(token) {
 			expiresIn := time.Until(token.ExpiresAt)
 			if expiresIn > 0 {
@@ -258,7 +431,7 @@ func (m AppModel) renderHeader() string {
 
 	// Combine user and admin token status
 	if userTokenStatus != "" {
-		authStatus = "Auth: ✓ " + userTokenStatus + adminTokenStatus
+		authStatus = "Auth: " + g.Success + " " + userTokenStatus + adminTokenStatus
 	}
 
 	// Check if input is focused (affects quit shortcut display)
@@ -272,12 +445,19 @@ func (m AppModel) renderHeader() string {
 		quitHint = "[Ctrl+C] Quit"
 	}
 
-	return headerStyle.Render(fmt.Sprintf("Challenge Demo App - %s | %s | User: %s | %s", screen, authStatus, m.container.UserID, quitHint))
+	// Auto-refresh indicator
+	refreshStatus := ""
+	if m.dashboard.AutoRefreshEnabled() {
+		refreshStatus = fmt.Sprintf(" | %s Auto (%s)", g.Refresh, m.dashboard.RefreshInterval())
+	}
+
+	return headerStyle.Render(fmt.Sprintf("Challenge Demo App - %s | %s | User: %s @ %s%s | %s", screen, authStatus, userID, namespace, refreshStatus, quitHint))
 }
 
 // renderFooter renders keyboard shortcuts (context-aware based on screen and focus state)
 func (m AppModel) renderFooter() string {
 	var shortcuts string
+	g := glyph.Current()
 
 	// Check if input is focused (affects available shortcuts)
 	inputFocused := false
@@ -285,9 +465,11 @@ func (m AppModel) renderFooter() string {
 		inputFocused = m.eventSimulator.IsInputFocused()
 	}
 
-	if inputFocused {
+	if m.help.Visible() {
+		shortcuts = "[?/Esc] Close Help  [Ctrl+C] Quit"
+	} else if inputFocused {
 		// When input is focused, only Ctrl+C works for quit, other navigation disabled
-		shortcuts = "⚠ Input Mode: Navigation disabled | [Esc] Unfocus | [Ctrl+C] Quit"
+		shortcuts = g.Warning + " Input Mode: Navigation disabled | [Esc] Unfocus | [Ctrl+C] Quit"
 	} else {
 		// Normal navigation mode - add screen-specific shortcuts
 		baseShortcuts := "[1] Dashboard"
@@ -295,11 +477,19 @@ func (m AppModel) renderFooter() string {
 			baseShortcuts += "  [2/e] Event Simulator"
 		}
 		baseShortcuts += "  [3/i] Inventory"
+		if len(m.users) > 1 {
+			baseShortcuts += "  [u] Switch User"
+		}
+		baseShortcuts += "  [?] Help"
 
 		// Add screen-specific shortcuts
 		switch m.currentScreen {
 		case ScreenInventory:
-			shortcuts = baseShortcuts + "  [Tab] Switch Panel  [↑↓] Scroll  [r] Refresh  [Esc] Back  [q] Quit"
+			shortcuts = baseShortcuts + "  [Tab] Switch Panel  [" + g.UpDown + "] Select  [h] Hide Empty  [r] Refresh  [Esc] Back  [q] Quit"
+		case ScreenDashboard:
+			shortcuts = baseShortcuts + "  [g] Select Goals  [r] Refresh  [f] Auto-refresh  [q] Quit"
+		case ScreenGoalSelection:
+			shortcuts = baseShortcuts + "  [" + g.UpDown + "] Navigate  [Space] Check  [Enter] Activate  [n] Random  [+/-] Count  [x] Replace  [Esc] Back  [q] Quit"
 		default:
 			shortcuts = baseShortcuts + "  [r] Refresh  [q] Quit"
 		}
@@ -317,7 +507,12 @@ func tokenRefreshTickCmd() tea.Cmd {
 
 // App is the root Bubble Tea application
 type App struct {
-	container *app.Container
+	container       *app.Container
+	refreshInterval time.Duration // Dashboard auto-refresh interval (0 = off)
+	refreshOnEvent  bool          // Reload the dashboard after each successful simulator trigger
+	maxStatValue    int           // Simulator stat value bound (0 = DefaultMaxStatValue)
+	users           []string      // Extra users 'u' can switch to (mock auth)
+	width           int           // Forced render width (0 = terminal size)
 }
 
 // NewApp creates a new TUI app
@@ -325,10 +520,42 @@ func NewApp(container *app.Container) *App {
 	return &App{container: container}
 }
 
+// SetRefreshInterval enables dashboard auto-refresh on startup with the given interval
+func (a *App) SetRefreshInterval(interval time.Duration) {
+	a.refreshInterval = interval
+}
+
+// SetRefreshOnEvent reloads the dashboard's challenges after each successful simulator trigger
+func (a *App) SetRefreshOnEvent(enabled bool) {
+	a.refreshOnEvent = enabled
+}
+
+// SetMaxStatValue bounds the stat values the event simulator accepts (0 = DefaultMaxStatValue)
+func (a *App) SetMaxStatValue(limit int) {
+	a.maxStatValue = limit
+}
+
+// SetUsers lists the extra users the TUI can switch to with 'u' (mock auth only)
+func (a *App) SetUsers(users []string) {
+	a.users = users
+}
+
+// SetWidth forces the render width instead of following the terminal size (0 = terminal size)
+func (a *App) SetWidth(width int) {
+	a.width = width
+}
+
 // Run starts the TUI application
 func (a *App) Run() error {
 	// Create initial model
 	model := NewAppModel(a.container)
+	model.dashboard.SetRefreshInterval(a.refreshInterval)
+	if model.eventSimulator != nil {
+		model.eventSimulator.SetRefreshOnEvent(a.refreshOnEvent)
+		model.eventSimulator.SetMaxStatValue(a.maxStatValue)
+	}
+	model.SetWidth(a.width)
+	model.SetUsers(a.users)
 
 	// Configure Bubble Tea program
 	p := tea.NewProgram(
@@ -336,6 +563,10 @@ func (a *App) Run() error {
 		tea.WithAltScreen(), // Use alternate screen buffer
 	)
 
+	// Keep SDK log lines from drawing over the alternate screen
+	restoreLogs := redirectSDKLogs()
+	defer restoreLogs()
+
 	// Start program
 	finalModel, err := p.Run()
 	if err != nil {
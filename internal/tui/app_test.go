@@ -5,15 +5,23 @@
 package tui
 
 import (
+	"context"
+	"strings"
 	"testing"
+	"time"
+	"unicode"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 )
 
 func TestNewAppModel(t *testing.T) {
-	container := app.NewContainer("http://localhost:8080", "mock", "", "test-user", "demo", "", "", "", "", "", "", "", "")
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
 	model := NewAppModel(container)
 
 	if model.container == nil {
@@ -34,7 +42,7 @@ func TestNewAppModel(t *testing.T) {
 }
 
 func TestAppModel_Update_Quit(t *testing.T) {
-	container := app.NewContainer("http://localhost:8080", "mock", "", "test-user", "demo", "", "", "", "", "", "", "", "")
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
 	model := NewAppModel(container)
 
 	// Send quit key
@@ -50,8 +58,25 @@ func TestAppModel_Update_Quit(t *testing.T) {
 	}
 }
 
+func TestAppModel_Update_QuitIgnoredWhileFiltering(t *testing.T) {
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
+	model := NewAppModel(container)
+
+	// Open the dashboard filter, then type 'q'
+	newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	newModel, _ = newModel.(AppModel).Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	updatedModel := newModel.(AppModel)
+
+	if updatedModel.quitting {
+		t.Error("Expected 'q' to be typed into the filter, not quit")
+	}
+	if got := updatedModel.dashboard.filterInput.Value(); got != "q" {
+		t.Errorf("Expected filter 'q', got %q", got)
+	}
+}
+
 func TestAppModel_Update_WindowSize(t *testing.T) {
-	container := app.NewContainer("http://localhost:8080", "mock", "", "test-user", "demo", "", "", "", "", "", "", "", "")
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
 	model := NewAppModel(container)
 
 	// Send window size message
@@ -68,7 +93,7 @@ func TestAppModel_Update_WindowSize(t *testing.T) {
 }
 
 func TestAppModel_View(t *testing.T) {
-	container := app.NewContainer("http://localhost:8080", "mock", "", "test-user", "demo", "", "", "", "", "", "", "", "")
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
 	model := NewAppModel(container)
 
 	view := model.View()
@@ -78,7 +103,7 @@ func TestAppModel_View(t *testing.T) {
 }
 
 func TestAppModel_View_Quitting(t *testing.T) {
-	container := app.NewContainer("http://localhost:8080", "mock", "", "test-user", "demo", "", "", "", "", "", "", "", "")
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
 	model := NewAppModel(container)
 	model.quitting = true
 
@@ -89,7 +114,7 @@ func TestAppModel_View_Quitting(t *testing.T) {
 }
 
 func TestNewApp(t *testing.T) {
-	container := app.NewContainer("http://localhost:8080", "mock", "", "test-user", "demo", "", "", "", "", "", "", "", "")
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
 	application := NewApp(container)
 
 	if application == nil {
@@ -102,17 +127,20 @@ func TestNewApp(t *testing.T) {
 }
 
 func TestAppModel_RenderHeader(t *testing.T) {
-	container := app.NewContainer("http://localhost:8080", "mock", "", "test-user", "demo", "", "", "", "", "", "", "", "")
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
 	model := NewAppModel(container)
 
 	header := model.renderHeader()
 	if header == "" {
 		t.Error("Expected non-empty header")
 	}
+	if !strings.Contains(header, "User: test-user @ demo") {
+		t.Errorf("Expected the token user and namespace in the header, got %q", header)
+	}
 }
 
 func TestAppModel_RenderFooter(t *testing.T) {
-	container := app.NewContainer("http://localhost:8080", "mock", "", "test-user", "demo", "", "", "", "", "", "", "", "")
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
 	model := NewAppModel(container)
 
 	footer := model.renderFooter()
@@ -120,3 +148,172 @@ func TestAppModel_RenderFooter(t *testing.T) {
 		t.Error("Expected non-empty footer")
 	}
 }
+
+// nopEventTrigger is a no-op EventTrigger for rendering tests
+type nopEventTrigger struct{}
+
+func (nopEventTrigger) TriggerLogin(ctx context.Context, userID, namespace string) error {
+	return nil
+}
+
+func (nopEventTrigger) TriggerStatUpdate(ctx context.Context, userID, namespace, statCode string, value, inc int) error {
+	return nil
+}
+
+func (nopEventTrigger) Close() error {
+	return nil
+}
+
+func TestASCIIMode_NoMultiByteGlyphs(t *testing.T) {
+	previous := glyph.Current()
+	glyph.Use(glyph.ASCII)
+	defer glyph.Use(previous)
+
+	assertASCII := func(name, rendered string) {
+		t.Helper()
+		for i, r := range rendered {
+			if r > unicode.MaxASCII {
+				t.Errorf("%s: found non-ASCII glyph %q at byte %d", name, r, i)
+				return
+			}
+		}
+	}
+
+	// Dashboard (list, detail, claim preview)
+	dashboard := NewDashboardModel(api.NewMockAPIClient(nil))
+	dashboard.challenges = []api.Challenge{
+		{ID: "c1", Name: "Challenge 1", Goals: []api.Goal{
+			{ID: "g1", Name: "Done", Status: "completed", Progress: 10, Requirement: api.Requirement{TargetValue: 10}, Reward: api.Reward{Type: "ITEM", RewardID: "sword", Quantity: 1}},
+			{ID: "g2", Name: "Started", Status: "in_progress", Progress: 3, Requirement: api.Requirement{TargetValue: 10}},
+			{ID: "g3", Name: "New", Status: "not_started", Requirement: api.Requirement{TargetValue: 5}},
+			{ID: "g4", Name: "Claimed", Status: "claimed", Progress: 1, Requirement: api.Requirement{TargetValue: 1}},
+		}},
+	}
+	assertASCII("dashboard list", dashboard.View())
+
+	dashboard.viewMode = ViewModeDetail
+	assertASCII("dashboard detail", dashboard.View())
+
+	dashboard.pendingClaim = &pendingClaim{challengeID: "c1", goal: dashboard.challenges[0].Goals[0]}
+	assertASCII("claim preview", dashboard.View())
+
+	// Inventory
+	inventory := NewInventoryModel(ags.NewMockRewardVerifier())
+	verifier := ags.NewMockRewardVerifier()
+	inventory.Update(InventoryLoadedMsg{Entitlements: verifier.Entitlements, Wallets: append(verifier.Wallets, &ags.Wallet{CurrencyCode: "OLD", Status: "INACTIVE"})})
+	assertASCII("inventory", inventory.View())
+
+	// Event simulator (with history)
+	simulator := NewEventSimulatorModel(nopEventTrigger{}, "test-user", "demo")
+	simulator.history = []EventHistoryEntry{
+		{EventType: EventTypeLogin, Success: true},
+		{EventType: EventTypeStatUpdate, StatCode: "kills", Value: 5, Success: false},
+	}
+	assertASCII("event simulator", simulator.View())
+
+	// Help overlay
+	assertASCII("help", NewHelpModel().View())
+
+	// App header and footer on each screen
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
+	model := NewAppModel(container)
+	model.dashboard.SetRefreshInterval(time.Second)
+	for _, screen := range []Screen{ScreenDashboard, ScreenInventory} {
+		model.currentScreen = screen
+		assertASCII("app header", model.renderHeader())
+		assertASCII("app footer", model.renderFooter())
+	}
+}
+
+func TestAppModel_SetWidth_IgnoresWindowSize(t *testing.T) {
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
+	model := NewAppModel(container)
+	model.SetWidth(100)
+
+	newModel, _ := model.Update(tea.WindowSizeMsg{Width: 60, Height: 40})
+	updatedModel := newModel.(AppModel)
+
+	if updatedModel.width != 100 {
+		t.Errorf("Expected forced width 100 to survive a resize, got %d", updatedModel.width)
+	}
+	if updatedModel.height != 40 {
+		t.Errorf("Expected height 40, got %d", updatedModel.height)
+	}
+
+	// Every rendered line is padded/wrapped to exactly the forced width
+	for _, line := range strings.Split(updatedModel.View(), "\n") {
+		if w := lipgloss.Width(line); w != 100 {
+			t.Errorf("Expected line width 100, got %d: %q", w, line)
+			break
+		}
+	}
+
+	// Without a forced width, resizes are followed
+	model = NewAppModel(container)
+	model.SetWidth(0)
+	newModel, _ = model.Update(tea.WindowSizeMsg{Width: 60, Height: 40})
+	if w := newModel.(AppModel).width; w != 60 {
+		t.Errorf("Expected width to follow the window (60), got %d", w)
+	}
+}
+
+func TestAppModel_Update_SwitchUser(t *testing.T) {
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
+	model := NewAppModel(container)
+	model.eventSimulator = NewEventSimulatorModel(nopEventTrigger{}, "test-user", "demo")
+	switchKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}}
+
+	// Without --users there is no one to switch to
+	newModel, cmd := model.Update(switchKey)
+	if cmd != nil || !strings.Contains(newModel.(AppModel).View(), "No other users to switch to") {
+		t.Error("Expected a warning when no other users are configured")
+	}
+
+	model.SetUsers([]string{"bob", "test-user", ""})
+	if len(model.users) != 2 {
+		t.Fatalf("Expected users [test-user bob], got %v", model.users)
+	}
+
+	newModel, cmd = model.Update(switchKey)
+	model = newModel.(AppModel)
+	if container.UserID != "bob" || model.eventSimulator.userID != "bob" {
+		t.Errorf("Expected to act as bob, got container %q, simulator %q", container.UserID, model.eventSimulator.userID)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a dashboard and inventory reload")
+	}
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("Expected a batch of two reloads, got %T", cmd())
+	}
+	if _, ok := batch[0]().(ReloadChallengesMsg); !ok {
+		t.Error("Expected ReloadChallengesMsg")
+	}
+	if _, ok := batch[1]().(LoadInventoryMsg); !ok {
+		t.Error("Expected LoadInventoryMsg")
+	}
+	if header := model.renderHeader(); !strings.Contains(header, "User: bob @ demo") {
+		t.Errorf("Expected the header to show bob, got %q", header)
+	}
+
+	// No switching while a claim awaits confirmation or a request is in flight
+	model.dashboard.pendingClaim = &pendingClaim{challengeID: "c1", goal: api.Goal{ID: "g1"}}
+	model.currentScreen = ScreenInventory
+	newModel, cmd = model.Update(switchKey)
+	if cmd != nil || container.UserID != "bob" || !strings.Contains(newModel.(AppModel).View(), "before switching users") {
+		t.Errorf("Expected the switch to be refused during a claim confirmation, got user %q", container.UserID)
+	}
+	model.dashboard.pendingClaim = nil
+
+	model.inventory.loading = true
+	if model.Update(switchKey); container.UserID != "bob" {
+		t.Errorf("Expected the switch to be refused while the inventory loads, got user %q", container.UserID)
+	}
+	model.inventory.loading = false
+
+	// The list wraps around to the starting user
+	model.Update(switchKey)
+	if container.UserID != "test-user" {
+		t.Errorf("Expected to switch back to test-user, got %q", container.UserID)
+	}
+}
@@ -10,9 +10,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 )
 
 // ViewMode represents the dashboard view mode
@@ -29,6 +32,19 @@ type ChallengesLoadedMsg struct {
 	err        error
 }
 
+// AutoRefreshTickMsg is sent when the dashboard auto-refresh timer fires
+type AutoRefreshTickMsg struct {
+	generation int // Ticks from a previous toggle are ignored
+}
+
+// ChallengeDetailLoadedMsg is sent when a prefetched challenge detail arrives
+type ChallengeDetailLoadedMsg struct {
+	challengeID string
+	challenge   *api.Challenge
+	err         error
+	generation  int // Details requested before the last reload are dropped
+}
+
 // ClaimGoalMsg is sent when a goal claim is attempted
 type ClaimGoalMsg struct {
 	result *api.ClaimResult
@@ -41,27 +57,111 @@ type DashboardModel struct {
 	challenges      []api.Challenge
 	viewMode        ViewMode
 	challengeCursor int
-	goalCursor      int // Selected goal index in detail view
+	goalCursor      int            // Selected goal index in detail view
+	goalCursors     map[string]int // Last goalCursor per challenge ID, restored on re-entry
 	loading         bool
-	claiming        bool   // True when claiming a reward
-	successMsg      string // Success message to display
+	claiming        bool          // True when claiming a reward
+	pendingClaim    *pendingClaim // Goal awaiting confirmation while the reward preview is shown
+	successMsg      string        // Success message to display
 	errorMsg        string
+
+	// Challenge list filter ('/'); challengeCursor indexes the filtered list
+	filterInput textinput.Model
+	filtering   bool // True while the filter input has focus
+
+	// Goal status filter ('s' in detail view); goalCursor indexes the filtered goals
+	goalStatusFilter string // Empty shows all goals
+
+	// Challenge list order ('s' in list view); one of api.ChallengeSortModes, empty keeps backend order
+	sortMode string
+
+	// Auto-refresh state
+	autoRefresh       bool          // True when challenges are reloaded on a timer
+	refreshing        bool          // True while a background reload is in flight
+	refreshInterval   time.Duration // Interval between automatic reloads
+	refreshGeneration int           // Incremented on each toggle to invalidate pending ticks
+
+	// Detail prefetch: GetChallenge for the challenges around the cursor, merged into challenges
+	prefetched         map[string]bool // Challenge IDs fetched, failed, or in flight since the last reload
+	prefetchGeneration int             // Incremented on each reload to drop details fetched before it
+	prefetchSlots      chan struct{}   // Shared by all fetches; bounds them to prefetchConcurrency
+
+	progressBar ProgressBarTheme // Runes and default width for goal progress bars
+}
+
+// pendingClaim is the goal captured when the claim confirmation opened
+// Confirming claims exactly this goal, even if a reload moves the cursor meanwhile.
+type pendingClaim struct {
+	challengeID string
+	goal        api.Goal // Snapshot shown in the reward preview
 }
 
+// goalStatusFilters is the cycle order of the detail view status filter ("" = all)
+var goalStatusFilters = []string{"", "not_started", "in_progress", "completed", "claimed"}
+
+// defaultRefreshInterval is used when auto-refresh is toggled on without a configured interval
+const defaultRefreshInterval = 5 * time.Second
+
+// Detail prefetch limits
+const (
+	prefetchRadius      = 3 // Challenges prefetched above and below the cursor
+	prefetchConcurrency = 4 // GetChallenge calls in flight at once
+)
+
 // NewDashboardModel creates a new dashboard model
 func NewDashboardModel(apiClient api.APIClient) *DashboardModel {
+	filterInput := textinput.New()
+	filterInput.Placeholder = "challenge name"
+	filterInput.CharLimit = 50
+	filterInput.Width = 30
+
 	return &DashboardModel{
 		apiClient:       apiClient,
 		viewMode:        ViewModeList,
 		challengeCursor: 0,
 		goalCursor:      0,
+		goalCursors:     make(map[string]int),
 		loading:         false,
+		filterInput:     filterInput,
+		refreshInterval: defaultRefreshInterval,
+		prefetched:      make(map[string]bool),
+		prefetchSlots:   make(chan struct{}, prefetchConcurrency),
+		progressBar:     DefaultProgressBarTheme(),
 	}
 }
 
+// SetProgressBarTheme overrides the progress bar runes and default width
+func (m *DashboardModel) SetProgressBarTheme(theme ProgressBarTheme) {
+	m.progressBar = theme
+}
+
+// SetRefreshInterval configures auto-refresh; a positive interval enables it on startup
+func (m *DashboardModel) SetRefreshInterval(interval time.Duration) {
+	if interval <= 0 {
+		m.autoRefresh = false
+		return
+	}
+
+	m.refreshInterval = interval
+	m.autoRefresh = true
+}
+
+// AutoRefreshEnabled returns true if challenges are reloaded on a timer
+func (m *DashboardModel) AutoRefreshEnabled() bool {
+	return m.autoRefresh
+}
+
+// RefreshInterval returns the interval between automatic reloads
+func (m *DashboardModel) RefreshInterval() time.Duration {
+	return m.refreshInterval
+}
+
 // Init loads challenges
 func (m *DashboardModel) Init() tea.Cmd {
 	m.loading = true
+	if m.autoRefresh {
+		return tea.Batch(m.loadChallengesCmd(), m.autoRefreshTickCmd())
+	}
 	return m.loadChallengesCmd()
 }
 
@@ -69,6 +169,16 @@ func (m *DashboardModel) Init() tea.Cmd {
 func (m *DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Claim confirmation captures all keys until answered
+		if m.pendingClaim != nil {
+			return m.updateClaimConfirmation(msg)
+		}
+
+		// Filter input captures all keys until confirmed or cancelled
+		if m.filtering {
+			return m.updateFilter(msg)
+		}
+
 		switch msg.String() {
 		case "up", "k":
 			if m.viewMode == ViewModeList {
@@ -82,37 +192,48 @@ func (m *DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.goalCursor--
 				}
 			}
-			return m, nil
+			return m, m.prefetchDetailsCmd()
 
 		case "down", "j":
 			if m.viewMode == ViewModeList {
 				// Navigate challenge list
-				if m.challengeCursor < len(m.challenges)-1 {
+				if m.challengeCursor < len(m.visibleChallenges())-1 {
 					m.challengeCursor++
 				}
 			} else {
 				// Navigate goal list in detail view
-				if m.challengeCursor < len(m.challenges) {
-					challenge := m.challenges[m.challengeCursor]
-					if m.goalCursor < len(challenge.Goals)-1 {
+				if challenge := m.selectedChallenge(); challenge != nil {
+					if m.goalCursor < len(filterGoals(challenge.Goals, m.goalStatusFilter))-1 {
 						m.goalCursor++
 					}
 				}
 			}
-			return m, nil
+			return m, m.prefetchDetailsCmd()
 
 		case "enter":
 			// Drill down into selected challenge
-			if m.viewMode == ViewModeList && len(m.challenges) > 0 {
+			if m.viewMode == ViewModeList && m.selectedChallenge() != nil {
 				m.viewMode = ViewModeDetail
-				m.goalCursor = 0 // Reset goal cursor
+				m.restoreGoalCursor()
 			}
 			return m, nil
 
 		case "esc":
-			// Go back to challenge list
+			// Go back to challenge list, or clear the filter in the list
 			if m.viewMode == ViewModeDetail {
+				m.rememberGoalCursor()
 				m.viewMode = ViewModeList
+			} else if m.filterInput.Value() != "" {
+				m.clearFilter()
+				return m, m.prefetchDetailsCmd()
+			}
+			return m, nil
+
+		case "/":
+			// Filter the challenge list by name
+			if m.viewMode == ViewModeList {
+				m.filtering = true
+				return m, m.filterInput.Focus()
 			}
 			return m, nil
 
@@ -122,36 +243,90 @@ func (m *DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.successMsg = "" // Clear success message on refresh
 			return m, m.loadChallengesCmd()
 
+		case "f":
+			// Toggle auto-refresh
+			m.autoRefresh = !m.autoRefresh
+			m.refreshGeneration++
+			if m.autoRefresh {
+				return m, m.autoRefreshTickCmd()
+			}
+			return m, nil
+
+		case "s":
+			// Detail view: cycle the goal status filter (kept while navigating between challenges)
+			// List view: cycle the challenge sort order
+			if m.viewMode == ViewModeDetail {
+				m.goalStatusFilter = nextGoalStatusFilter(m.goalStatusFilter)
+				m.goalCursor = 0
+			} else {
+				m.sortMode = nextSortMode(m.sortMode)
+				_ = api.SortChallenges(m.challenges, m.sortMode)
+				m.challengeCursor = 0
+				return m, m.prefetchDetailsCmd()
+			}
+			return m, nil
+
 		case "c":
-			// Claim reward for selected goal
-			if m.viewMode == ViewModeDetail && m.challengeCursor < len(m.challenges) {
-				challenge := m.challenges[m.challengeCursor]
-				if m.goalCursor < len(challenge.Goals) {
-					goal := challenge.Goals[m.goalCursor]
-					if goal.Status == "completed" {
-						m.claiming = true
-						m.errorMsg = ""
-						m.successMsg = ""
-						return m, m.claimGoalCmd(challenge.ID, goal.ID)
-					}
-				}
+			// Show reward preview for selected goal before claiming
+			if goal := m.selectedGoal(); goal != nil && goal.Status == "completed" {
+				m.pendingClaim = &pendingClaim{challengeID: m.selectedChallenge().ID, goal: *goal}
+				m.errorMsg = ""
+				m.successMsg = ""
 			}
 			return m, nil
 		}
 
+	case AutoRefreshTickMsg:
+		// Ignore ticks from a previous toggle (or after auto-refresh was turned off)
+		if !m.autoRefresh || msg.generation != m.refreshGeneration {
+			return m, nil
+		}
+
+		// Don't reload while a claim is being confirmed or in flight, or another load is; try again next tick
+		if m.pendingClaim != nil || m.claiming || m.loading || m.refreshing {
+			return m, m.autoRefreshTickCmd()
+		}
+
+		// Reload in the background without switching to the loading view
+		m.refreshing = true
+		return m, tea.Batch(m.loadChallengesCmd(), m.autoRefreshTickCmd())
+
+	case ReloadChallengesMsg:
+		// Reload in the background after goals were changed on another screen
+		if m.loading {
+			return m, nil
+		}
+		m.refreshing = true
+		return m, m.loadChallengesCmd()
+
 	case ChallengesLoadedMsg:
 		m.loading = false
+		m.refreshing = false
 		if msg.err != nil {
 			m.errorMsg = fmt.Sprintf("Failed to load challenges: %v", msg.err)
 			return m, nil
 		}
 
+		m.forgetChangedGoalCursors(msg.challenges)
 		m.challenges = msg.challenges
+		_ = api.SortChallenges(m.challenges, m.sortMode)
 		m.errorMsg = ""
 		// Reset cursor if out of bounds
-		if m.challengeCursor >= len(m.challenges) {
+		if m.challengeCursor >= len(m.visibleChallenges()) {
 			m.challengeCursor = 0
 		}
+
+		// Details fetched before this reload may be older than it; fetch them again
+		m.prefetchGeneration++
+		m.prefetched = make(map[string]bool)
+		return m, m.prefetchDetailsCmd()
+
+	case ChallengeDetailLoadedMsg:
+		// A failed prefetch is not retried before the next reload; the list data stays in use
+		if msg.generation != m.prefetchGeneration || msg.err != nil {
+			return m, nil
+		}
+		m.mergeChallengeDetail(msg.challenge)
 		return m, nil
 
 	case ClaimGoalMsg:
@@ -163,7 +338,7 @@ func (m *DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Show success message
-		m.successMsg = "✓ Reward claimed successfully!"
+		m.successMsg = glyph.Current().Success + " Reward claimed successfully!"
 		m.errorMsg = ""
 
 		// Refresh challenges to show updated status
@@ -174,6 +349,195 @@ func (m *DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// updateClaimConfirmation handles keys while the claim confirmation is shown
+func (m *DashboardModel) updateClaimConfirmation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "enter":
+		claim := m.pendingClaim
+		m.pendingClaim = nil
+		m.claiming = true
+		return m, m.claimGoalCmd(claim.challengeID, claim.goal.ID)
+
+	case "n", "esc":
+		m.pendingClaim = nil
+	}
+
+	return m, nil
+}
+
+// updateFilter handles keys while the filter input has focus
+// The list is filtered live; Enter keeps the filter, Esc clears it.
+func (m *DashboardModel) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.filtering = false
+		m.filterInput.Blur()
+		return m, nil
+
+	case "esc":
+		m.clearFilter()
+		return m, m.prefetchDetailsCmd()
+	}
+
+	var cmd tea.Cmd
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	m.challengeCursor = 0
+	return m, tea.Batch(cmd, m.prefetchDetailsCmd())
+}
+
+// clearFilter removes the filter and shows all challenges again
+func (m *DashboardModel) clearFilter() {
+	m.filtering = false
+	m.filterInput.Blur()
+	m.filterInput.SetValue("")
+	m.challengeCursor = 0
+}
+
+// IsInputFocused returns true if the filter input has focus or the claim confirmation is
+// shown (global shortcuts must not fire)
+func (m *DashboardModel) IsInputFocused() bool {
+	return m.filtering || m.pendingClaim != nil
+}
+
+// visibleChallenges returns pointers to the challenges whose name matches the filter (case-insensitive)
+func (m *DashboardModel) visibleChallenges() []*api.Challenge {
+	filter := strings.ToLower(strings.TrimSpace(m.filterInput.Value()))
+
+	visible := make([]*api.Challenge, 0, len(m.challenges))
+	for i := range m.challenges {
+		if filter == "" || strings.Contains(strings.ToLower(m.challenges[i].Name), filter) {
+			visible = append(visible, &m.challenges[i])
+		}
+	}
+	return visible
+}
+
+// selectedChallenge returns the challenge under the cursor in the filtered list, or nil
+func (m *DashboardModel) selectedChallenge() *api.Challenge {
+	visible := m.visibleChallenges()
+	if m.challengeCursor >= len(visible) {
+		return nil
+	}
+	return visible[m.challengeCursor]
+}
+
+// rememberGoalCursor saves the goal cursor of the selected challenge for re-entry
+func (m *DashboardModel) rememberGoalCursor() {
+	if challenge := m.selectedChallenge(); challenge != nil {
+		m.goalCursors[challenge.ID] = m.goalCursor
+	}
+}
+
+// restoreGoalCursor restores the saved goal cursor of the selected challenge (0 if none)
+// The saved position is clamped in case the status filter now shows fewer goals.
+func (m *DashboardModel) restoreGoalCursor() {
+	m.goalCursor = 0
+
+	challenge := m.selectedChallenge()
+	if challenge == nil {
+		return
+	}
+
+	cursor, ok := m.goalCursors[challenge.ID]
+	if !ok {
+		return
+	}
+	if n := len(filterGoals(challenge.Goals, m.goalStatusFilter)); cursor >= n {
+		cursor = n - 1
+	}
+	if cursor > 0 {
+		m.goalCursor = cursor
+	}
+}
+
+// forgetChangedGoalCursors drops saved goal cursors for challenges whose goal set changed
+// (or that disappeared) in the reloaded challenges, since the saved index no longer applies.
+func (m *DashboardModel) forgetChangedGoalCursors(reloaded []api.Challenge) {
+	if len(m.goalCursors) == 0 {
+		return
+	}
+
+	previous := make(map[string][]api.Goal, len(m.challenges))
+	for _, c := range m.challenges {
+		previous[c.ID] = c.Goals
+	}
+	current := make(map[string][]api.Goal, len(reloaded))
+	for _, c := range reloaded {
+		current[c.ID] = c.Goals
+	}
+
+	for id := range m.goalCursors {
+		goals, ok := current[id]
+		if !ok || !sameGoalIDs(previous[id], goals) {
+			delete(m.goalCursors, id)
+		}
+	}
+}
+
+// sameGoalIDs reports whether both goal lists hold the same goal IDs in the same order
+func sameGoalIDs(a, b []api.Goal) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			return false
+		}
+	}
+	return true
+}
+
+// selectedGoal returns the goal under the cursor in detail view, or nil
+func (m *DashboardModel) selectedGoal() *api.Goal {
+	if m.viewMode != ViewModeDetail {
+		return nil
+	}
+
+	challenge := m.selectedChallenge()
+	if challenge == nil {
+		return nil
+	}
+
+	goals := filterGoals(challenge.Goals, m.goalStatusFilter)
+	if m.goalCursor >= len(goals) {
+		return nil
+	}
+
+	return goals[m.goalCursor]
+}
+
+// filterGoals returns pointers to the goals with the given status (all goals if status is empty)
+func filterGoals(goals []api.Goal, status string) []*api.Goal {
+	filtered := make([]*api.Goal, 0, len(goals))
+	for i := range goals {
+		if status == "" || goals[i].Status == status {
+			filtered = append(filtered, &goals[i])
+		}
+	}
+	return filtered
+}
+
+// nextGoalStatusFilter returns the status filter after current in the cycle
+func nextGoalStatusFilter(current string) string {
+	for i, status := range goalStatusFilters {
+		if status == current {
+			return goalStatusFilters[(i+1)%len(goalStatusFilters)]
+		}
+	}
+	return ""
+}
+
+// nextSortMode returns the challenge sort mode after current ("" first, then api.ChallengeSortModes)
+func nextSortMode(current string) string {
+	modes := append([]string{""}, api.ChallengeSortModes...)
+	for i, mode := range modes {
+		if mode == current {
+			return modes[(i+1)%len(modes)]
+		}
+	}
+	return ""
+}
+
 // View renders the dashboard
 func (m *DashboardModel) View() string {
 	var b strings.Builder
@@ -194,6 +558,12 @@ func (m *DashboardModel) View() string {
 		return b.String()
 	}
 
+	// Claim confirmation with reward preview
+	if m.pendingClaim != nil {
+		b.WriteString(renderClaimPreview(m.pendingClaim.goal))
+		return b.String()
+	}
+
 	// Success message
 	if m.successMsg != "" {
 		b.WriteString(completedStyle.Render(m.successMsg))
@@ -225,8 +595,24 @@ func (m *DashboardModel) View() string {
 func (m *DashboardModel) renderChallengeList() string {
 	var b strings.Builder
 
+	if m.sortMode != "" {
+		b.WriteString(dimStyle.Render("Sort: " + m.sortMode))
+		b.WriteString("\n\n")
+	}
+
+	// Filter input
+	visible := m.visibleChallenges()
+	if m.filtering || m.filterInput.Value() != "" {
+		b.WriteString("Filter: " + m.filterInput.View())
+		b.WriteString("\n\n")
+		if len(visible) == 0 {
+			b.WriteString(subtitleStyle.Render("No challenges match the filter"))
+			b.WriteString("\n")
+		}
+	}
+
 	// Challenge list
-	for i, challenge := range m.challenges {
+	for i, challenge := range visible {
 		cursor := " "
 		style := itemStyle
 		if i == m.challengeCursor {
@@ -234,33 +620,29 @@ func (m *DashboardModel) renderChallengeList() string {
 			style = selectedStyle
 		}
 
-		// Count completed goals
-		completed := 0
-		total := len(challenge.Goals)
-		for _, goal := range challenge.Goals {
-			if goal.Status == "completed" || goal.Status == "claimed" {
-				completed++
-			}
-		}
-
-		line := fmt.Sprintf("%s %s [%d/%d]", cursor, challenge.Name, completed, total)
+		line := fmt.Sprintf("%s %s [%d/%d]", cursor, challenge.Name, challenge.CompletedGoals(), len(challenge.Goals))
 		b.WriteString(style.Render(line))
 		b.WriteString("\n")
 	}
 
 	b.WriteString("\n")
-	b.WriteString(subtitleStyle.Render("Use ↑↓ to navigate, Enter to view details, 'r' to refresh, 'q' to quit"))
+	if m.filtering {
+		b.WriteString(subtitleStyle.Render("Type to filter, Enter to keep the filter, Esc to clear"))
+	} else {
+		b.WriteString(subtitleStyle.Render("Use " + glyph.Current().UpDown + " to navigate, Enter to view details, '/' to filter, 's' to sort, 'r' to refresh, 'f' to toggle auto-refresh, 'q' to quit"))
+	}
 
 	return b.String()
 }
 
 // renderChallengeDetail renders the detail view for selected challenge
 func (m *DashboardModel) renderChallengeDetail() string {
-	if m.challengeCursor >= len(m.challenges) {
+	selected := m.selectedChallenge()
+	if selected == nil {
 		return ""
 	}
 
-	challenge := m.challenges[m.challengeCursor]
+	challenge := *selected
 
 	var b strings.Builder
 	b.WriteString(titleStyle.Render(challenge.Name))
@@ -268,49 +650,56 @@ func (m *DashboardModel) renderChallengeDetail() string {
 	b.WriteString(subtitleStyle.Render(challenge.Description))
 	b.WriteString("\n\n")
 
-	b.WriteString(subtitleStyle.Render("Goals:"))
+	goals := filterGoals(challenge.Goals, m.goalStatusFilter)
+	if m.goalStatusFilter != "" {
+		b.WriteString(subtitleStyle.Render(fmt.Sprintf("Goals (%s, %d of %d):", m.goalStatusFilter, len(goals), len(challenge.Goals))))
+	} else {
+		b.WriteString(subtitleStyle.Render("Goals:"))
+	}
 	b.WriteString("\n\n")
 
-	for i, goal := range challenge.Goals {
-		b.WriteString(m.renderGoalDetailed(goal, i == m.goalCursor))
+	if len(goals) == 0 {
+		b.WriteString(dimStyle.Render(fmt.Sprintf("  No %s goals", m.goalStatusFilter)))
+		b.WriteString("\n")
+	}
+	for i, goal := range goals {
+		b.WriteString(m.renderGoalDetailed(&challenge, *goal, i == m.goalCursor))
 	}
 
 	b.WriteString("\n")
-	b.WriteString(subtitleStyle.Render("Use ↑↓ to navigate goals, Esc to go back, 'r' to refresh"))
+	b.WriteString(subtitleStyle.Render("Use " + glyph.Current().UpDown + " to navigate goals, 's' to filter by status, Esc to go back, 'r' to refresh"))
 
 	return b.String()
 }
 
 // renderGoalDetailed renders a single goal with full details
-func (m *DashboardModel) renderGoalDetailed(goal api.Goal, selected bool) string {
+// The challenge is used to resolve prerequisite IDs to goal names.
+func (m *DashboardModel) renderGoalDetailed(challenge *api.Challenge, goal api.Goal, selected bool) string {
 	var b strings.Builder
 
 	// Status icon and styling
-	var icon string
+	g := glyph.Current()
+	icon := output.StatusIcon(goal.Status)
 	var statusStyle = itemStyle
 	switch goal.Status {
 	case "not_started":
-		icon = "○"
 		statusStyle = subtitleStyle
 	case "in_progress":
-		icon = "●"
 		statusStyle = progressStyle
 	case "completed":
-		icon = "✓"
 		statusStyle = completedStyle
 	case "claimed":
-		icon = "⚡"
 		statusStyle = claimedStyle
 	}
 
 	// Cursor indicator
 	cursor := " "
 	if selected {
-		cursor = "►"
+		cursor = g.Cursor
 	}
 
-	// Progress bar (20 characters for detail view)
-	progressBar := m.renderProgressBar(int(goal.Progress), int(goal.Requirement.TargetValue), 20)
+	// Progress bar (theme default width)
+	progressBar := m.renderProgressBar(int(goal.Progress), int(goal.Requirement.TargetValue), 0)
 
 	// Claim button hint
 	claimHint := ""
@@ -329,22 +718,17 @@ func (m *DashboardModel) renderGoalDetailed(goal api.Goal, selected bool) string
 
 	// Show requirement details (stat code and operator)
 	if goal.Requirement.StatCode != "" {
-		operatorSymbol := goal.Requirement.Operator
-		switch goal.Requirement.Operator {
-		case "gte":
-			operatorSymbol = ">="
-		case "lte":
-			operatorSymbol = "<="
-		case "eq":
-			operatorSymbol = "=="
-		}
-		requirementInfo := fmt.Sprintf("Requirement: %s %s %d",
-			goal.Requirement.StatCode, operatorSymbol, goal.Requirement.TargetValue)
+		requirementInfo := "Requirement: " + goal.Requirement.String()
 		b.WriteString(fmt.Sprintf("  %s\n", dimStyle.Render(requirementInfo)))
 	}
 
 	b.WriteString(fmt.Sprintf("  %s %d/%d%s\n", progressBar, goal.Progress, goal.Requirement.TargetValue, claimHint))
 
+	// Show which prerequisites are blocking a locked goal
+	if goal.Locked {
+		b.WriteString(fmt.Sprintf("  %s\n", dimStyle.Render(lockedByLine(challenge, &goal))))
+	}
+
 	// Show reward info
 	if goal.Reward.Type != "" {
 		rewardInfo := fmt.Sprintf("Reward: %s %s", goal.Reward.Type, goal.Reward.RewardID)
@@ -358,20 +742,107 @@ func (m *DashboardModel) renderGoalDetailed(goal api.Goal, selected bool) string
 	return b.String()
 }
 
-// renderProgressBar renders a progress bar using block characters
+// lockedByLine describes the unmet prerequisites of a locked goal
+func lockedByLine(challenge *api.Challenge, goal *api.Goal) string {
+	blocking := challenge.BlockingGoalNames(goal)
+	if len(blocking) == 0 {
+		return glyph.Current().Locked + " locked by prerequisites"
+	}
+	return fmt.Sprintf("%s locked by: %s", glyph.Current().Locked, strings.Join(blocking, ", "))
+}
+
+// renderClaimPreview renders the claim confirmation prompt with full reward details
+func renderClaimPreview(goal api.Goal) string {
+	var b strings.Builder
+
+	b.WriteString(highlightStyle.Render(fmt.Sprintf("Claim reward for \"%s\"?", goal.Name)))
+	b.WriteString("\n\n")
+
+	idLabel := "Reward ID"
+	switch goal.Reward.Type {
+	case "ITEM":
+		idLabel = "Item ID"
+	case "WALLET":
+		idLabel = "Currency"
+	}
+
+	b.WriteString(fmt.Sprintf("  %s %s\n", boldStyle.Render("Type:     "), goal.Reward.Type))
+	b.WriteString(fmt.Sprintf("  %s %s\n", boldStyle.Render(fmt.Sprintf("%-10s", idLabel+":")), goal.Reward.RewardID))
+	b.WriteString(fmt.Sprintf("  %s %d\n", boldStyle.Render("Quantity: "), goal.Reward.Quantity))
+	b.WriteString("\n")
+	b.WriteString(subtitleStyle.Render("[y/Enter] Confirm  [n/Esc] Cancel"))
+
+	return claimPreviewStyle.Border(panelBorder()).Render(b.String())
+}
+
+// renderProgressBar renders a progress bar using the theme's runes
+// A width <= 0 uses the theme's default width.
 func (m *DashboardModel) renderProgressBar(current, target, width int) string {
-	if target == 0 {
-		return "[" + strings.Repeat("░", width) + "]"
+	if width <= 0 {
+		width = m.progressBar.Width
 	}
 
-	filled := (current * width) / target
-	if filled > width {
-		filled = width
+	return output.ProgressBar(current, target, width, m.progressBar.Fill, m.progressBar.Empty)
+}
+
+// prefetchDetailsCmd fetches the details of the challenges around the cursor not fetched yet
+// Returns nil if there is nothing to fetch.
+func (m *DashboardModel) prefetchDetailsCmd() tea.Cmd {
+	visible := m.visibleChallenges()
+	start := max(m.challengeCursor-prefetchRadius, 0)
+	end := min(m.challengeCursor+prefetchRadius+1, len(visible))
+
+	var cmds []tea.Cmd
+	for i := start; i < end; i++ {
+		id := visible[i].ID
+		if m.prefetched[id] {
+			continue
+		}
+		m.prefetched[id] = true
+		cmds = append(cmds, m.fetchDetailCmd(id))
 	}
 
-	return fmt.Sprintf("[%s%s]",
-		strings.Repeat("█", filled),
-		strings.Repeat("░", width-filled))
+	if len(cmds) == 0 {
+		return nil
+	}
+	return tea.Batch(cmds...)
+}
+
+// fetchDetailCmd returns a command to fetch one challenge's detail
+// The command waits for a free slot, so at most prefetchConcurrency fetches run at once;
+// Bubble Tea runs commands in their own goroutines, so waiting never blocks the UI.
+func (m *DashboardModel) fetchDetailCmd(challengeID string) tea.Cmd {
+	apiClient, slots, generation := m.apiClient, m.prefetchSlots, m.prefetchGeneration
+	return func() tea.Msg {
+		slots <- struct{}{}
+		defer func() { <-slots }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		challenge, err := apiClient.GetChallenge(ctx, challengeID)
+		return ChallengeDetailLoadedMsg{challengeID: challengeID, challenge: challenge, err: err, generation: generation}
+	}
+}
+
+// mergeChallengeDetail replaces the listed challenge with its fetched detail
+// The goal cursor is clamped in case the open challenge now shows fewer goals.
+func (m *DashboardModel) mergeChallengeDetail(detail *api.Challenge) {
+	for i := range m.challenges {
+		if m.challenges[i].ID == detail.ID {
+			m.challenges[i] = *detail
+			break
+		}
+	}
+
+	if m.viewMode != ViewModeDetail {
+		return
+	}
+	if challenge := m.selectedChallenge(); challenge != nil && challenge.ID == detail.ID {
+		if n := len(filterGoals(challenge.Goals, m.goalStatusFilter)); m.goalCursor >= n {
+			m.goalCursor = max(n-1, 0)
+		}
+	}
 }
 
 // loadChallengesCmd returns a command to fetch challenges
@@ -385,6 +856,14 @@ func (m *DashboardModel) loadChallengesCmd() tea.Cmd {
 	}
 }
 
+// autoRefreshTickCmd returns a command that fires after the refresh interval
+func (m *DashboardModel) autoRefreshTickCmd() tea.Cmd {
+	generation := m.refreshGeneration
+	return tea.Tick(m.refreshInterval, func(t time.Time) tea.Msg {
+		return AutoRefreshTickMsg{generation: generation}
+	})
+}
+
 // claimGoalCmd returns a command to claim a goal reward
 func (m *DashboardModel) claimGoalCmd(challengeID, goalID string) tea.Cmd {
 	return func() tea.Msg {
@@ -5,13 +5,18 @@
 package tui
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/auth"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 )
 
 func TestNewDashboardModel(t *testing.T) {
@@ -236,3 +241,806 @@ func TestDashboardModel_Init(t *testing.T) {
 		t.Error("Expected init command")
 	}
 }
+
+func TestDashboardModel_Update_KeyF_TogglesAutoRefresh(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	apiClient := api.NewHTTPAPIClient("http://localhost:8080", mockAuth)
+	model := NewDashboardModel(apiClient)
+
+	if model.AutoRefreshEnabled() {
+		t.Fatal("Expected auto-refresh to be off by default")
+	}
+
+	// Toggle on
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	updatedModel := newModel.(*DashboardModel)
+
+	if !updatedModel.AutoRefreshEnabled() {
+		t.Error("Expected auto-refresh to be on after toggle")
+	}
+
+	if cmd == nil {
+		t.Error("Expected tick command when enabling auto-refresh")
+	}
+
+	// Toggle off
+	newModel, cmd = updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	updatedModel = newModel.(*DashboardModel)
+
+	if updatedModel.AutoRefreshEnabled() {
+		t.Error("Expected auto-refresh to be off after second toggle")
+	}
+
+	if cmd != nil {
+		t.Error("Expected no command when disabling auto-refresh")
+	}
+}
+
+func TestDashboardModel_Update_AutoRefreshTick(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	apiClient := api.NewHTTPAPIClient("http://localhost:8080", mockAuth)
+	model := NewDashboardModel(apiClient)
+	model.SetRefreshInterval(2 * time.Second)
+
+	// Tick while a claim is in flight should not reload
+	model.claiming = true
+	newModel, cmd := model.Update(AutoRefreshTickMsg{generation: model.refreshGeneration})
+	updatedModel := newModel.(*DashboardModel)
+
+	if updatedModel.refreshing {
+		t.Error("Expected no reload while claiming")
+	}
+
+	if cmd == nil {
+		t.Error("Expected tick to be rescheduled while claiming")
+	}
+
+	// Tick when idle should reload in the background
+	updatedModel.claiming = false
+	newModel, cmd = updatedModel.Update(AutoRefreshTickMsg{generation: updatedModel.refreshGeneration})
+	updatedModel = newModel.(*DashboardModel)
+
+	if !updatedModel.refreshing {
+		t.Error("Expected background reload when idle")
+	}
+
+	if updatedModel.loading {
+		t.Error("Expected background reload not to show loading view")
+	}
+
+	if cmd == nil {
+		t.Error("Expected reload command")
+	}
+
+	// Stale tick from a previous toggle should be ignored
+	updatedModel.refreshing = false
+	newModel, cmd = updatedModel.Update(AutoRefreshTickMsg{generation: updatedModel.refreshGeneration - 1})
+	updatedModel = newModel.(*DashboardModel)
+
+	if updatedModel.refreshing || cmd != nil {
+		t.Error("Expected stale tick to be ignored")
+	}
+
+	// Tick while the claim confirmation is shown should not reload
+	updatedModel.pendingClaim = &pendingClaim{challengeID: "c1", goal: api.Goal{ID: "g1"}}
+	newModel, cmd = updatedModel.Update(AutoRefreshTickMsg{generation: updatedModel.refreshGeneration})
+	updatedModel = newModel.(*DashboardModel)
+
+	if updatedModel.refreshing {
+		t.Error("Expected no reload while confirming a claim")
+	}
+
+	if cmd == nil {
+		t.Error("Expected tick to be rescheduled while confirming a claim")
+	}
+}
+
+func TestRenderClaimPreview(t *testing.T) {
+	tests := []struct {
+		name     string
+		reward   api.Reward
+		contains []string
+	}{
+		{
+			name:     "item reward",
+			reward:   api.Reward{Type: "ITEM", RewardID: "winter_sword", Quantity: 1},
+			contains: []string{"ITEM", "Item ID", "winter_sword", "Quantity", "1"},
+		},
+		{
+			name:     "wallet reward",
+			reward:   api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 250},
+			contains: []string{"WALLET", "Currency", "GOLD", "250"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preview := renderClaimPreview(api.Goal{ID: "g1", Name: "Goal 1", Reward: tt.reward})
+
+			for _, want := range tt.contains {
+				if !strings.Contains(preview, want) {
+					t.Errorf("Expected preview to contain '%s', got:\n%s", want, preview)
+				}
+			}
+		})
+	}
+}
+
+func TestDashboardModel_Update_ClaimConfirmation(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	apiClient := api.NewHTTPAPIClient("http://localhost:8080", mockAuth)
+	model := NewDashboardModel(apiClient)
+
+	model.challenges = []api.Challenge{
+		{ID: "c1", Name: "Challenge 1", Goals: []api.Goal{
+			{ID: "g1", Name: "Goal 1", Status: "completed", Reward: api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 50}},
+		}},
+	}
+	model.viewMode = ViewModeDetail
+
+	// 'c' shows the preview instead of claiming immediately
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	updatedModel := newModel.(*DashboardModel)
+
+	if updatedModel.pendingClaim == nil || updatedModel.claiming || cmd != nil {
+		t.Fatal("Expected confirmation prompt without claiming")
+	}
+
+	// The prompt is modal: global shortcuts must not fire behind it
+	if !updatedModel.IsInputFocused() {
+		t.Error("Expected input focus while the confirmation is shown")
+	}
+
+	if view := updatedModel.View(); !strings.Contains(view, "GOLD") || !strings.Contains(view, "50") {
+		t.Errorf("Expected view to show reward preview, got:\n%s", view)
+	}
+
+	// 'n' cancels
+	newModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	updatedModel = newModel.(*DashboardModel)
+
+	if updatedModel.pendingClaim != nil || updatedModel.claiming || updatedModel.IsInputFocused() {
+		t.Error("Expected claim to be cancelled")
+	}
+
+	// 'c' then 'y' claims
+	newModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	updatedModel = newModel.(*DashboardModel)
+	newModel, cmd = updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	updatedModel = newModel.(*DashboardModel)
+
+	if !updatedModel.claiming || cmd == nil {
+		t.Error("Expected claim to start after confirmation")
+	}
+}
+
+func TestDashboardModel_Update_ClaimConfirmationClaimsCapturedGoal(t *testing.T) {
+	apiClient := api.NewMockAPIClient([]api.Challenge{
+		{ID: "c1", Name: "Challenge 1", Goals: []api.Goal{
+			{ID: "g0", Name: "Goal 0", Status: "completed"},
+			{ID: "g1", Name: "Goal 1", Status: "completed"},
+		}},
+	})
+	model := NewDashboardModel(apiClient)
+	model.challenges = []api.Challenge{
+		{ID: "c1", Name: "Challenge 1", Goals: []api.Goal{
+			{ID: "g1", Name: "Goal 1", Status: "completed"},
+		}},
+	}
+	model.viewMode = ViewModeDetail
+
+	newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	updatedModel := newModel.(*DashboardModel)
+
+	// A reload while the prompt is open puts another goal under the cursor
+	newModel, _ = updatedModel.Update(ChallengesLoadedMsg{challenges: apiClient.Challenges})
+	updatedModel = newModel.(*DashboardModel)
+	if goal := updatedModel.selectedGoal(); goal == nil || goal.ID != "g0" {
+		t.Fatalf("Expected g0 under the cursor after the reload, got %+v", goal)
+	}
+
+	newModel, cmd := updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	updatedModel = newModel.(*DashboardModel)
+	if cmd == nil {
+		t.Fatal("Expected claim command after confirmation")
+	}
+	cmd()
+
+	if len(apiClient.ClaimCalls) != 1 || apiClient.ClaimCalls[0] != "c1/g1" {
+		t.Errorf("Expected the confirmed goal c1/g1 to be claimed, got %v", apiClient.ClaimCalls)
+	}
+}
+
+func TestDashboardModel_Update_Filter(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	apiClient := api.NewHTTPAPIClient("http://localhost:8080", mockAuth)
+	model := NewDashboardModel(apiClient)
+
+	model.challenges = []api.Challenge{
+		{ID: "daily", Name: "Daily Quests"},
+		{ID: "winter", Name: "Winter Event"},
+		{ID: "weekly", Name: "Weekly Quests"},
+	}
+	model.challengeCursor = 1
+
+	// '/' opens the filter input
+	newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	updatedModel := newModel.(*DashboardModel)
+	if !updatedModel.IsInputFocused() {
+		t.Fatal("Expected filter input to be focused")
+	}
+
+	// Typing filters live (case-insensitive) and resets the cursor
+	for _, r := range "QUEST" {
+		newModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		updatedModel = newModel.(*DashboardModel)
+	}
+
+	visible := updatedModel.visibleChallenges()
+	if len(visible) != 2 || visible[0].ID != "daily" || visible[1].ID != "weekly" {
+		t.Errorf("Expected [daily weekly], got %d challenges", len(visible))
+	}
+	if updatedModel.challengeCursor != 0 {
+		t.Errorf("Expected cursor reset to 0, got %d", updatedModel.challengeCursor)
+	}
+
+	view := updatedModel.View()
+	if strings.Contains(view, "Winter Event") || !strings.Contains(view, "Weekly Quests") {
+		t.Errorf("Expected filtered list in view, got:\n%s", view)
+	}
+
+	// Enter keeps the filter; navigation and drill-down use the filtered list
+	newModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updatedModel = newModel.(*DashboardModel)
+	newModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyDown})
+	updatedModel = newModel.(*DashboardModel)
+
+	if updatedModel.IsInputFocused() {
+		t.Error("Expected filter input to lose focus after Enter")
+	}
+	if c := updatedModel.selectedChallenge(); c == nil || c.ID != "weekly" {
+		t.Errorf("Expected 'weekly' selected, got %+v", c)
+	}
+
+	// Esc in the list clears the filter
+	newModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	updatedModel = newModel.(*DashboardModel)
+
+	if len(updatedModel.visibleChallenges()) != 3 || updatedModel.challengeCursor != 0 {
+		t.Errorf("Expected filter cleared with cursor 0, got %d challenges, cursor %d",
+			len(updatedModel.visibleChallenges()), updatedModel.challengeCursor)
+	}
+}
+
+func TestDashboardModel_Update_FilterEscWhileTyping(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	apiClient := api.NewHTTPAPIClient("http://localhost:8080", mockAuth)
+	model := NewDashboardModel(apiClient)
+
+	model.challenges = []api.Challenge{{ID: "c1", Name: "Challenge 1"}}
+
+	newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	updatedModel := newModel.(*DashboardModel)
+	newModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	updatedModel = newModel.(*DashboardModel)
+
+	if view := updatedModel.View(); !strings.Contains(view, "No challenges match") {
+		t.Errorf("Expected no-match message, got:\n%s", view)
+	}
+
+	newModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	updatedModel = newModel.(*DashboardModel)
+
+	if updatedModel.IsInputFocused() || updatedModel.filterInput.Value() != "" {
+		t.Error("Expected Esc to close and clear the filter")
+	}
+}
+
+func TestDashboardModel_RenderProgressBar(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	apiClient := api.NewHTTPAPIClient("http://localhost:8080", mockAuth)
+	model := NewDashboardModel(apiClient)
+	model.SetProgressBarTheme(ASCIIProgressBar)
+
+	tests := []struct {
+		name     string
+		current  int
+		target   int
+		width    int
+		expected string
+	}{
+		{name: "half filled", current: 5, target: 10, width: 10, expected: "[#####-----]"},
+		{name: "over target is capped", current: 15, target: 10, width: 4, expected: "[####]"},
+		{name: "zero target renders empty bar", current: 3, target: 0, width: 5, expected: "[-----]"},
+		{name: "theme default width", current: 0, target: 10, width: 0, expected: "[" + strings.Repeat("-", 20) + "]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bar := model.renderProgressBar(tt.current, tt.target, tt.width)
+			if bar != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, bar)
+			}
+		})
+	}
+
+	model.SetProgressBarTheme(ProgressBarTheme{Fill: '=', Empty: '.', Width: 6})
+	if bar := model.renderProgressBar(1, 2, 0); bar != "[===...]" {
+		t.Errorf("Expected custom theme bar '[===...]', got '%s'", bar)
+	}
+}
+
+func TestDashboardModel_RenderGoalDetailed_Locked(t *testing.T) {
+	mockAuth := auth.NewMockAuthProvider("test-user", "demo")
+	apiClient := api.NewHTTPAPIClient("http://localhost:8080", mockAuth)
+	model := NewDashboardModel(apiClient)
+
+	challenge := &api.Challenge{
+		ID: "daily-quests",
+		Goals: []api.Goal{
+			{ID: "g2", Name: "Login Once", Status: "completed"},
+			{ID: "g5", Name: "Win 3 Matches", Status: "in_progress"},
+			{ID: "g7", Name: "Final Boss", Status: "not_started", Locked: true, Prerequisites: []string{"g2", "g5"}},
+		},
+	}
+
+	output := model.renderGoalDetailed(challenge, challenge.Goals[2], false)
+	if !strings.Contains(output, "locked by: Win 3 Matches") {
+		t.Errorf("Expected output to list the blocking prerequisite, got '%s'", output)
+	}
+	if strings.Contains(output, "Login Once") {
+		t.Errorf("Expected completed prerequisite to be omitted, got '%s'", output)
+	}
+
+	unlocked := model.renderGoalDetailed(challenge, challenge.Goals[1], false)
+	if strings.Contains(unlocked, "locked by") {
+		t.Errorf("Expected no lock line for unlocked goal, got '%s'", unlocked)
+	}
+}
+
+// TestDashboardModel_RenderDetail_Golden guards the detail view against rendering changes
+// (e.g. when moving status icons and progress bars into shared helpers)
+func TestDashboardModel_RenderDetail_Golden(t *testing.T) {
+	glyph.Use(glyph.ASCII)
+	defer glyph.Use(glyph.Detect())
+
+	model := NewDashboardModel(nil)
+	model.challenges = []api.Challenge{
+		{
+			ID:          "daily",
+			Name:        "Daily Quests",
+			Description: "Daily tasks",
+			Goals: []api.Goal{
+				{ID: "login", Name: "Login", Description: "Log in", Status: "claimed", Progress: 1,
+					Requirement: api.Requirement{StatCode: "logins", Operator: "gte", TargetValue: 1},
+					Reward:      api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 50}},
+				{ID: "kill-10", Name: "Kill 10", Description: "Defeat enemies", Status: "completed", Progress: 10,
+					Requirement: api.Requirement{StatCode: "kills", Operator: "gte", TargetValue: 10},
+					Reward:      api.Reward{Type: "WALLET", RewardID: "GOLD", Quantity: 100}},
+				{ID: "win-3", Name: "Win 3", Description: "Win matches", Status: "in_progress", Progress: 1,
+					Requirement: api.Requirement{StatCode: "wins", Operator: "gte", TargetValue: 3},
+					Reward:      api.Reward{Type: "ITEM", RewardID: "winter_sword", Quantity: 1}},
+				{ID: "win-10", Name: "Win 10", Description: "Win more", Status: "not_started", Locked: true,
+					Prerequisites: []string{"win-3"},
+					Requirement:   api.Requirement{StatCode: "wins", Operator: "gte", TargetValue: 10}},
+			},
+		},
+	}
+	model.viewMode = ViewModeDetail
+	model.goalCursor = 1
+
+	want := "                   \n" +
+		"Challenge Dashboard\n" +
+		"                   \n" +
+		"\n" +
+		"            \n" +
+		"Daily Quests\n" +
+		"            \n" +
+		"Daily tasks\n" +
+		"\n" +
+		"Goals:\n" +
+		"\n" +
+		"  $ Login\n" +
+		"  Log in\n" +
+		"  Requirement: logins >= 1\n" +
+		"  [####################] 1/1\n" +
+		"  Reward: WALLET GOLD x50\n" +
+		"\n" +
+		"> +  Kill 10 \n" +
+		"  Defeat enemies\n" +
+		"  Requirement: kills >= 10\n" +
+		"  [####################] 10/10 [c] Claim\n" +
+		"  Reward: WALLET GOLD x100\n" +
+		"\n" +
+		"  * Win 3\n" +
+		"  Win matches\n" +
+		"  Requirement: wins >= 3\n" +
+		"  [######--------------] 1/3\n" +
+		"  Reward: ITEM winter_sword x1\n" +
+		"\n" +
+		"  o Win 10\n" +
+		"  Win more\n" +
+		"  Requirement: wins >= 10\n" +
+		"  [--------------------] 0/10\n" +
+		"  [locked] locked by: Win 3\n" +
+		"\n" +
+		"\n" +
+		"Use Up/Down to navigate goals, 's' to filter by status, Esc to go back, 'r' to refresh"
+
+	if got := model.View(); got != want {
+		t.Errorf("Detail view changed.\nExpected: %q\nGot:      %q", want, got)
+	}
+}
+
+func TestDashboardModel_Update_GoalStatusFilter(t *testing.T) {
+	model := NewDashboardModel(nil)
+	model.challenges = []api.Challenge{
+		{ID: "c1", Name: "Challenge 1", Goals: []api.Goal{
+			{ID: "g1", Name: "Goal One", Status: "not_started"},
+			{ID: "g2", Name: "Goal Two", Status: "in_progress"},
+			{ID: "g3", Name: "Goal Three", Status: "completed"},
+			{ID: "g4", Name: "Goal Four", Status: "in_progress"},
+		}},
+		{ID: "c2", Name: "Challenge 2", Goals: []api.Goal{
+			{ID: "h1", Name: "Other Goal", Status: "claimed"},
+			{ID: "h2", Name: "Other Progress", Status: "in_progress"},
+		}},
+	}
+	model.viewMode = ViewModeDetail
+
+	press := func(key string) {
+		var msg tea.KeyMsg
+		switch key {
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		}
+		newModel, _ := model.Update(msg)
+		model = newModel.(*DashboardModel)
+	}
+
+	// Rendered goal names must be exactly the expected set
+	allNames := []string{"Goal One", "Goal Two", "Goal Three", "Goal Four", "Other Goal", "Other Progress"}
+	assertGoals := func(want ...string) {
+		t.Helper()
+		view := model.View()
+		for _, name := range allNames {
+			shown := strings.Contains(view, name)
+			wanted := false
+			for _, w := range want {
+				wanted = wanted || w == name
+			}
+			if shown != wanted {
+				t.Errorf("Filter %q: expected %q shown=%v, got view:\n%s", model.goalStatusFilter, name, wanted, view)
+			}
+		}
+	}
+
+	assertGoals("Goal One", "Goal Two", "Goal Three", "Goal Four")
+
+	press("s")
+	assertGoals("Goal One")
+
+	press("s")
+	assertGoals("Goal Two", "Goal Four")
+
+	// Cursor and claim selection follow the filtered list
+	press("down")
+	if goal := model.selectedGoal(); goal == nil || goal.ID != "g4" {
+		t.Errorf("Expected g4 selected, got %+v", goal)
+	}
+
+	press("s")
+	assertGoals("Goal Three")
+	if model.goalCursor != 0 {
+		t.Errorf("Expected goal cursor reset, got %d", model.goalCursor)
+	}
+
+	// Filter persists when navigating to another challenge
+	press("s") // claimed
+	press("s") // all
+	press("s") // not_started
+	press("s") // in_progress
+	press("esc")
+	press("down")
+	press("enter")
+	if model.goalStatusFilter != "in_progress" {
+		t.Fatalf("Expected filter to persist, got %q", model.goalStatusFilter)
+	}
+	assertGoals("Other Progress")
+
+	// No matches renders a placeholder
+	press("s")
+	assertGoals()
+	if view := model.View(); !strings.Contains(view, "No completed goals") {
+		t.Errorf("Expected empty-filter message, got:\n%s", view)
+	}
+}
+
+func TestFilterGoals(t *testing.T) {
+	goals := []api.Goal{{ID: "a", Status: "completed"}, {ID: "b", Status: "claimed"}, {ID: "c", Status: "completed"}}
+
+	if got := filterGoals(goals, ""); len(got) != 3 {
+		t.Errorf("Expected all 3 goals without a filter, got %d", len(got))
+	}
+
+	got := filterGoals(goals, "completed")
+	if len(got) != 2 || got[0].ID != "a" || got[1].ID != "c" {
+		t.Errorf("Expected [a c], got %d goals", len(got))
+	}
+
+	// Pointers refer to the original goals
+	if got[0] != &goals[0] {
+		t.Error("Expected filtered goals to point into the original slice")
+	}
+}
+
+func TestDashboardModel_Update_SortCycle(t *testing.T) {
+	model := NewDashboardModel(nil)
+	model.challenges = []api.Challenge{
+		{ID: "c1", Name: "Weekly", Goals: []api.Goal{{Status: "completed"}, {Status: "in_progress"}}},
+		{ID: "c2", Name: "Daily", Goals: []api.Goal{{Status: "claimed"}}},
+		{ID: "c3", Name: "Event", Goals: []api.Goal{{Status: "not_started"}}},
+	}
+	model.challengeCursor = 2
+
+	names := func() string {
+		parts := make([]string, len(model.challenges))
+		for i, c := range model.challenges {
+			parts[i] = c.Name
+		}
+		return strings.Join(parts, ",")
+	}
+
+	// "" -> name -> progress -> status -> "" (order is kept when returning to backend order)
+	want := []struct {
+		mode  string
+		order string
+	}{
+		{api.SortByName, "Daily,Event,Weekly"},
+		{api.SortByProgress, "Event,Weekly,Daily"},
+		{api.SortByStatus, "Event,Weekly,Daily"},
+		{"", "Event,Weekly,Daily"},
+	}
+
+	for _, w := range want {
+		newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+		model = newModel.(*DashboardModel)
+
+		if model.sortMode != w.mode {
+			t.Errorf("Expected sort mode %q, got %q", w.mode, model.sortMode)
+		}
+		if got := names(); got != w.order {
+			t.Errorf("Sort %q: expected %s, got %s", w.mode, w.order, got)
+		}
+		if model.challengeCursor != 0 {
+			t.Errorf("Sort %q: expected cursor reset to 0, got %d", w.mode, model.challengeCursor)
+		}
+		if view := model.View(); w.mode != "" && !strings.Contains(view, "Sort: "+w.mode) {
+			t.Errorf("Expected view to show sort mode %q, got:\n%s", w.mode, view)
+		}
+	}
+
+	// Reloaded challenges keep the active sort order
+	model.sortMode = api.SortByName
+	newModel, _ := model.Update(ChallengesLoadedMsg{challenges: []api.Challenge{
+		{ID: "b", Name: "beta"}, {ID: "a", Name: "Alpha"},
+	}})
+	model = newModel.(*DashboardModel)
+	if got := names(); got != "Alpha,beta" {
+		t.Errorf("Expected reload sorted by name, got %s", got)
+	}
+}
+
+func TestDashboardModel_Update_GoalCursorMemory(t *testing.T) {
+	model := NewDashboardModel(nil)
+	model.challenges = []api.Challenge{
+		{ID: "c1", Name: "Challenge 1", Goals: []api.Goal{{ID: "g1"}, {ID: "g2"}, {ID: "g3"}}},
+		{ID: "c2", Name: "Challenge 2", Goals: []api.Goal{{ID: "h1"}, {ID: "h2"}}},
+	}
+
+	press := func(keyType tea.KeyType) {
+		newModel, _ := model.Update(tea.KeyMsg{Type: keyType})
+		model = newModel.(*DashboardModel)
+	}
+
+	// Enter c1, move to the third goal, leave
+	press(tea.KeyEnter)
+	press(tea.KeyDown)
+	press(tea.KeyDown)
+	press(tea.KeyEsc)
+
+	// Enter c2: no memory, starts at the top
+	press(tea.KeyDown)
+	press(tea.KeyEnter)
+	if model.goalCursor != 0 {
+		t.Errorf("Expected goal cursor 0 for unvisited challenge, got %d", model.goalCursor)
+	}
+	press(tea.KeyDown)
+	press(tea.KeyEsc)
+
+	// Re-enter c1: cursor restored
+	press(tea.KeyUp)
+	press(tea.KeyEnter)
+	if model.goalCursor != 2 {
+		t.Errorf("Expected goal cursor restored to 2, got %d", model.goalCursor)
+	}
+	if goal := model.selectedGoal(); goal == nil || goal.ID != "g3" {
+		t.Errorf("Expected selected goal g3, got %+v", goal)
+	}
+	press(tea.KeyEsc)
+
+	// Refresh: c1's goal set changed (memory cleared), c2's did not (memory kept)
+	newModel, _ := model.Update(ChallengesLoadedMsg{challenges: []api.Challenge{
+		{ID: "c1", Name: "Challenge 1", Goals: []api.Goal{{ID: "g1"}, {ID: "g4"}, {ID: "g3"}}},
+		{ID: "c2", Name: "Challenge 2", Goals: []api.Goal{{ID: "h1", Status: "completed"}, {ID: "h2"}}},
+	}})
+	model = newModel.(*DashboardModel)
+
+	press(tea.KeyEnter)
+	if model.goalCursor != 0 {
+		t.Errorf("Expected goal cursor reset after goal set changed, got %d", model.goalCursor)
+	}
+	press(tea.KeyEsc)
+
+	press(tea.KeyDown)
+	press(tea.KeyEnter)
+	if model.goalCursor != 1 {
+		t.Errorf("Expected goal cursor 1 kept for unchanged goal set, got %d", model.goalCursor)
+	}
+}
+
+func TestDashboardModel_RestoreGoalCursor_Clamped(t *testing.T) {
+	model := NewDashboardModel(nil)
+	model.challenges = []api.Challenge{
+		{ID: "c1", Name: "Challenge 1", Goals: []api.Goal{
+			{ID: "g1", Status: "completed"},
+			{ID: "g2", Status: "in_progress"},
+			{ID: "g3", Status: "completed"},
+		}},
+	}
+	model.goalCursors["c1"] = 2
+	model.goalStatusFilter = "completed"
+
+	model.restoreGoalCursor()
+	if model.goalCursor != 1 {
+		t.Errorf("Expected goal cursor clamped to 1, got %d", model.goalCursor)
+	}
+}
+
+// slowDetailAPIClient records how many GetChallenge calls run at once
+type slowDetailAPIClient struct {
+	*api.MockAPIClient
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (c *slowDetailAPIClient) GetChallenge(ctx context.Context, challengeID string) (*api.Challenge, error) {
+	c.mu.Lock()
+	c.inFlight++
+	c.maxInFlight = max(c.maxInFlight, c.inFlight)
+	c.mu.Unlock()
+
+	time.Sleep(20 * time.Millisecond)
+
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+	return c.MockAPIClient.GetChallenge(ctx, challengeID)
+}
+
+func prefetchTestChallenges(n int) []api.Challenge {
+	challenges := make([]api.Challenge, n)
+	for i := range challenges {
+		challenges[i] = api.Challenge{ID: fmt.Sprintf("c%d", i), Name: fmt.Sprintf("Challenge %d", i)}
+	}
+	return challenges
+}
+
+// runBatch runs every command of a batch concurrently, like Bubble Tea does, and collects the messages
+func runBatch(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	// A batch of one command is the command itself
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+
+	msgs := make([]tea.Msg, len(batch))
+	var wg sync.WaitGroup
+	for i, c := range batch {
+		wg.Add(1)
+		go func(i int, c tea.Cmd) {
+			defer wg.Done()
+			msgs[i] = c()
+		}(i, c)
+	}
+	wg.Wait()
+	return msgs
+}
+
+func TestDashboardModel_PrefetchDetails(t *testing.T) {
+	challenges := prefetchTestChallenges(20)
+	apiClient := &slowDetailAPIClient{MockAPIClient: api.NewMockAPIClient(challenges)}
+	model := NewDashboardModel(apiClient)
+
+	// Loading prefetches the cursor and the prefetchRadius challenges below it
+	newModel, cmd := model.Update(ChallengesLoadedMsg{challenges: prefetchTestChallenges(20)})
+	model = newModel.(*DashboardModel)
+	if len(model.prefetched) != prefetchRadius+1 || !model.prefetched["c3"] || model.prefetched["c4"] {
+		t.Errorf("Expected c0-c3 prefetched, got %v", model.prefetched)
+	}
+	msgs := runBatch(cmd)
+	if len(msgs) != prefetchRadius+1 {
+		t.Errorf("Expected %d fetches, got %d", prefetchRadius+1, len(msgs))
+	}
+
+	// Moving down only fetches the challenge entering the window
+	newModel, cmd = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = newModel.(*DashboardModel)
+	if got := len(runBatch(cmd)); got != 1 || !model.prefetched["c4"] {
+		t.Errorf("Expected only c4 fetched after moving down, got %d fetches", got)
+	}
+
+	// Moving back up fetches nothing new
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if cmd != nil {
+		t.Error("Expected no fetches for an already prefetched window")
+	}
+
+	// Jumping the cursor far ahead fetches a whole window, a few at a time
+	model.challengeCursor = 10
+	msgs = runBatch(model.prefetchDetailsCmd())
+	if len(msgs) != 2*prefetchRadius+1 {
+		t.Errorf("Expected %d fetches, got %d", 2*prefetchRadius+1, len(msgs))
+	}
+	if apiClient.maxInFlight > prefetchConcurrency {
+		t.Errorf("Expected at most %d fetches in flight, got %d", prefetchConcurrency, apiClient.maxInFlight)
+	}
+}
+
+func TestDashboardModel_ChallengeDetailLoaded(t *testing.T) {
+	model := NewDashboardModel(nil)
+	newModel, _ := model.Update(ChallengesLoadedMsg{challenges: []api.Challenge{
+		{ID: "c1", Name: "Challenge 1"},
+		{ID: "c2", Name: "Challenge 2"},
+	}})
+	model = newModel.(*DashboardModel)
+
+	detail := &api.Challenge{ID: "c2", Name: "Challenge 2", Description: "Full", Goals: []api.Goal{{ID: "g1"}}}
+
+	// Details requested before a reload are dropped
+	stale := ChallengeDetailLoadedMsg{challengeID: "c2", challenge: detail, generation: model.prefetchGeneration - 1}
+	newModel, _ = model.Update(stale)
+	model = newModel.(*DashboardModel)
+	if len(model.challenges[1].Goals) != 0 {
+		t.Error("Expected a stale detail to be dropped")
+	}
+
+	// Failed prefetches keep the listed challenge
+	failed := ChallengeDetailLoadedMsg{challengeID: "c2", err: fmt.Errorf("timeout"), generation: model.prefetchGeneration}
+	newModel, _ = model.Update(failed)
+	model = newModel.(*DashboardModel)
+	if model.errorMsg != "" || model.challenges[1].Description != "" {
+		t.Errorf("Expected a failed prefetch to change nothing, got error %q", model.errorMsg)
+	}
+
+	newModel, _ = model.Update(ChallengeDetailLoadedMsg{challengeID: "c2", challenge: detail, generation: model.prefetchGeneration})
+	model = newModel.(*DashboardModel)
+	if model.challenges[1].Description != "Full" || len(model.challenges[1].Goals) != 1 {
+		t.Errorf("Expected the detail merged into the list, got %+v", model.challenges[1])
+	}
+	if model.challenges[0].ID != "c1" {
+		t.Errorf("Expected other challenges untouched, got %+v", model.challenges[0])
+	}
+}
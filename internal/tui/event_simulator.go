@@ -6,8 +6,10 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -15,6 +17,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/events"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 )
 
 // EventType represents the type of event to trigger
@@ -23,16 +26,40 @@ type EventType int
 const (
 	EventTypeLogin EventType = iota
 	EventTypeStatUpdate
+
+	eventTypeCount = iota // Number of event types (selector length)
 )
 
+// Label returns the event type's name in the selector and history
+func (t EventType) Label() string {
+	switch t {
+	case EventTypeLogin:
+		return "Login Event"
+	case EventTypeStatUpdate:
+		return "Stat Update Event"
+	default:
+		return "Unknown Event"
+	}
+}
+
+// DefaultMaxStatValue bounds the stat value input unless SetMaxStatValue changes it
+const DefaultMaxStatValue = 1000000
+
+// defaultStatValue is sent when the stat value input is left empty
+const defaultStatValue = 10
+
 // EventHistoryEntry represents a single event trigger in history
 type EventHistoryEntry struct {
 	EventType EventType
 	StatCode  string
 	Value     int
+	Increment int // Amount added in increment mode (0 for absolute sends)
 	Success   bool
 	Duration  time.Duration
 	Error     string
+	Code      string // gRPC status code of a failed RPC (empty if the event never reached it)
+	Retryable bool   // The code suggests sending the event again may succeed
+	Rejected  bool   // Refused by the simulator's input checks, so never sent
 	Timestamp time.Time
 }
 
@@ -43,17 +70,28 @@ type EventSimulatorModel struct {
 	namespace    string
 
 	// UI state
-	selectedType EventType
-	statCodeInput textinput.Model
+	selectedType   EventType
+	statCodeInput  textinput.Model
 	statValueInput textinput.Model
-	focusedInput  int // 0 = event type, 1 = stat code, 2 = stat value
+	focusedInput   int // 0 = event type, 1 = stat code, 2 = stat value
+
+	// Increment mode: the value input is added to a locally tracked total
+	incrementMode bool
+	statTracker   *events.StatTracker
+
+	// Largest stat value (or increment magnitude) the trigger accepts
+	maxStatValue int
 
 	// Event history (last 10 events)
 	history []EventHistoryEntry
 
+	// Reload the dashboard's challenges after each successful trigger
+	refreshOnEvent bool
+
 	// Status
-	loading bool
-	err     error
+	loading   bool
+	err       error
+	replaying string // Confirmation of the last 'r' replay, cleared by the next Enter trigger
 }
 
 // NewEventSimulatorModel creates a new event simulator model
@@ -64,10 +102,13 @@ func NewEventSimulatorModel(eventTrigger events.EventTrigger, userID, namespace
 	statCodeInput.Width = 30
 
 	statValueInput := textinput.New()
-	statValueInput.Placeholder = "10"
+	statValueInput.Placeholder = strconv.Itoa(defaultStatValue)
 	statValueInput.CharLimit = 10
 	statValueInput.Width = 30
 
+	// In-memory tracker never fails to load
+	statTracker, _ := events.NewStatTracker("")
+
 	return &EventSimulatorModel{
 		eventTrigger:   eventTrigger,
 		userID:         userID,
@@ -76,10 +117,29 @@ func NewEventSimulatorModel(eventTrigger events.EventTrigger, userID, namespace
 		statCodeInput:  statCodeInput,
 		statValueInput: statValueInput,
 		focusedInput:   0,
+		statTracker:    statTracker,
+		maxStatValue:   DefaultMaxStatValue,
 		history:        make([]EventHistoryEntry, 0, 10),
 	}
 }
 
+// SetMaxStatValue bounds the stat value input (non-positive values keep DefaultMaxStatValue)
+func (m *EventSimulatorModel) SetMaxStatValue(limit int) {
+	if limit > 0 {
+		m.maxStatValue = limit
+	}
+}
+
+// SetUser sends later events as another user (the app's 'u' user switch)
+func (m *EventSimulatorModel) SetUser(userID string) {
+	m.userID = userID
+}
+
+// SetRefreshOnEvent makes successful triggers reload the dashboard's challenges
+func (m *EventSimulatorModel) SetRefreshOnEvent(enabled bool) {
+	m.refreshOnEvent = enabled
+}
+
 // Init initializes the model
 func (m *EventSimulatorModel) Init() tea.Cmd {
 	return nil
@@ -109,19 +169,28 @@ func (m *EventSimulatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "up":
-				// Toggle event type
-				if m.selectedType == EventTypeStatUpdate {
-					m.selectedType = EventTypeLogin
+				// Previous event type
+				if m.selectedType > 0 {
+					m.selectedType--
 				}
 				return m, nil
 
 			case "down":
-				// Toggle event type
-				if m.selectedType == EventTypeLogin {
-					m.selectedType = EventTypeStatUpdate
+				// Next event type
+				if m.selectedType < eventTypeCount-1 {
+					m.selectedType++
 				}
 				return m, nil
 
+			case "m":
+				// Toggle absolute/increment mode for stat updates
+				m.incrementMode = !m.incrementMode
+				return m, nil
+
+			case "r":
+				// Re-fire the most recent event that was sent
+				return m, m.replayLastEvent()
+
 			case "enter":
 				// Trigger event
 				if m.eventTrigger == nil {
@@ -129,8 +198,14 @@ func (m *EventSimulatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, nil
 				}
 
+				// Disabled until the stat value is valid (the error shows under the field)
+				if m.statValueError() != nil {
+					return m, nil
+				}
+
 				m.loading = true
 				m.err = nil
+				m.replaying = ""
 				return m, m.triggerEventCmd()
 			}
 		} else {
@@ -149,8 +224,14 @@ func (m *EventSimulatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, nil
 				}
 
+				// Disabled until the stat value is valid (the error shows under the field)
+				if m.statValueError() != nil {
+					return m, nil
+				}
+
 				m.loading = true
 				m.err = nil
+				m.replaying = ""
 				return m, m.triggerEventCmd()
 			}
 		}
@@ -164,12 +245,19 @@ func (m *EventSimulatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			EventType: msg.eventType,
 			StatCode:  msg.statCode,
 			Value:     msg.value,
+			Increment: msg.increment,
 			Success:   msg.err == nil,
+			Rejected:  msg.rejected,
 			Duration:  msg.duration,
 			Timestamp: time.Now(),
 		}
 		if msg.err != nil {
 			entry.Error = msg.err.Error()
+			var triggerErr *events.TriggerError
+			if errors.As(msg.err, &triggerErr) {
+				entry.Code = triggerErr.Code.String()
+				entry.Retryable = triggerErr.Retryable()
+			}
 		}
 
 		// Prepend to history (newest first)
@@ -180,6 +268,12 @@ func (m *EventSimulatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		if msg.err != nil {
 			m.err = msg.err
+			return m, nil
+		}
+
+		// Queue a dashboard reload so switching back shows the new progress
+		if m.refreshOnEvent {
+			return m, func() tea.Msg { return ReloadChallengesMsg{} }
 		}
 
 		return m, nil
@@ -201,13 +295,14 @@ func (m *EventSimulatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // View renders the event simulator screen
 func (m *EventSimulatorModel) View() string {
 	var s string
+	g := glyph.Current()
 
 	// Title
 	s += titleStyle.Render("Event Simulator") + "\n\n"
 
 	// Event trigger availability check
 	if m.eventTrigger == nil {
-		s += errorStyle.Render("⚠ Event Handler Not Connected") + "\n"
+		s += errorStyle.Render(g.Warning+" Event Handler Not Connected") + "\n"
 		s += dimStyle.Render("Start the event handler service to enable event simulation.") + "\n\n"
 		return s
 	}
@@ -217,38 +312,55 @@ func (m *EventSimulatorModel) View() string {
 
 	// Event type selector
 	s += boldStyle.Render("Event Type:") + "\n"
-	if m.selectedType == EventTypeLogin {
-		s += selectedStyle.Render("▶ Login Event") + "\n"
-		s += "  Stat Update Event\n"
-	} else {
-		s += "  Login Event\n"
-		s += selectedStyle.Render("▶ Stat Update Event") + "\n"
+	for t := EventType(0); t < eventTypeCount; t++ {
+		if t == m.selectedType {
+			s += selectedStyle.Render(g.Active+" "+t.Label()) + "\n"
+		} else {
+			s += "  " + t.Label() + "\n"
+		}
 	}
 	s += "\n"
 
 	// Stat update inputs (only show for stat update events)
 	if m.selectedType == EventTypeStatUpdate {
+		if m.incrementMode {
+			s += boldStyle.Render("Mode:") + " Increment " + dimStyle.Render("(value is added to the local running total) [m] Toggle") + "\n\n"
+		} else {
+			s += boldStyle.Render("Mode:") + " Absolute " + dimStyle.Render("(value is sent as the new stat total) [m] Toggle") + "\n\n"
+		}
+
 		s += boldStyle.Render("Stat Code:") + "\n"
 		if m.focusedInput == 1 {
-			s += focusedInputStyle.Render(m.statCodeInput.View()) + "\n\n"
+			s += focusedInputStyle.BorderStyle(panelBorder()).Render(m.statCodeInput.View()) + "\n\n"
 		} else {
 			s += m.statCodeInput.View() + "\n\n"
 		}
 
-		s += boldStyle.Render("Value:") + "\n"
+		if m.incrementMode {
+			current := m.statTracker.Current(m.userID, m.namespace, m.statCodeValue())
+			s += boldStyle.Render(fmt.Sprintf("Increment (current total: %d):", current)) + "\n"
+		} else {
+			s += boldStyle.Render("Value:") + "\n"
+		}
 		if m.focusedInput == 2 {
-			s += focusedInputStyle.Render(m.statValueInput.View()) + "\n\n"
+			s += focusedInputStyle.BorderStyle(panelBorder()).Render(m.statValueInput.View()) + "\n"
 		} else {
-			s += m.statValueInput.View() + "\n\n"
+			s += m.statValueInput.View() + "\n"
 		}
+		s += m.statValueHint() + "\n"
 	}
 
 	// Trigger button
 	if m.loading {
-		s += loadingStyle.Render("⏳ Triggering event...") + "\n\n"
+		s += loadingStyle.Render(g.Pending+" Triggering event...") + "\n\n"
+	} else if m.statValueError() != nil {
+		s += dimStyle.Render("[Enter] Trigger Event (disabled until the value is valid)") + "\n\n"
 	} else {
 		s += successStyle.Render("[Enter] Trigger Event") + "\n\n"
 	}
+	if m.replaying != "" {
+		s += dimStyle.Render(m.replaying) + "\n\n"
+	}
 
 	// Error message
 	if m.err != nil {
@@ -268,9 +380,9 @@ func (m *EventSimulatorModel) View() string {
 	s += "\n"
 	// Show context-aware shortcuts based on focus state
 	if m.IsInputFocused() {
-		s += dimStyle.Render("[←→] Move Cursor  [Tab] Next Field  [Enter] Trigger  [Esc] Unfocus  [Ctrl+C] Quit") + "\n"
+		s += dimStyle.Render("["+g.LeftRight+"] Move Cursor  [Tab] Next Field  [Enter] Trigger  [Esc] Unfocus  [Ctrl+C] Quit") + "\n"
 	} else {
-		s += dimStyle.Render("[↑↓] Select  [Tab] Next Field  [Enter] Trigger  [Esc] Back  [q] Quit") + "\n"
+		s += dimStyle.Render("["+g.UpDown+"] Select  [m] Mode  [Tab] Next Field  [Enter] Trigger  [r] Replay Last  [Esc] Back  [q] Quit") + "\n"
 	}
 
 	return s
@@ -282,21 +394,26 @@ func (m *EventSimulatorModel) renderHistoryEntry(entry EventHistoryEntry) string
 
 	// Success/failure indicator
 	if entry.Success {
-		s += successStyle.Render("✓")
+		s += successStyle.Render(glyph.Current().Success)
 	} else {
-		s += errorStyle.Render("✗")
+		s += errorStyle.Render(glyph.Current().Failure)
 	}
 
 	// Event type and details
-	if entry.EventType == EventTypeLogin {
-		s += " Login Event"
-	} else {
-		s += fmt.Sprintf(" Stat Update: %s = %d", entry.StatCode, entry.Value)
-	}
+	s += " " + historyEntrySummary(entry)
 
 	// Duration
 	s += dimStyle.Render(fmt.Sprintf(" (%dms)", entry.Duration.Milliseconds()))
 
+	// gRPC status code, so an unreachable handler stands apart from a rejected event
+	if entry.Code != "" {
+		code := entry.Code
+		if entry.Retryable {
+			code += ", retryable"
+		}
+		s += " " + errorStyle.Render("["+code+"]")
+	}
+
 	// Error (if any)
 	if !entry.Success && entry.Error != "" {
 		s += "\n  " + errorStyle.Render(entry.Error)
@@ -305,6 +422,60 @@ func (m *EventSimulatorModel) renderHistoryEntry(entry EventHistoryEntry) string
 	return s
 }
 
+// historyEntrySummary describes a history entry's event type and details
+func historyEntrySummary(entry EventHistoryEntry) string {
+	switch entry.EventType {
+	case EventTypeLogin:
+		return "Login Event"
+	case EventTypeStatUpdate:
+		s := fmt.Sprintf("Stat Update: %s = %d", entry.StatCode, entry.Value)
+		if entry.Increment != 0 {
+			s += fmt.Sprintf(" (%+d)", entry.Increment)
+		}
+		return s
+	}
+	return ""
+}
+
+// replayLastEvent re-fires the most recent sent event with the same type, stat code, and value
+// Entries the input checks rejected are skipped; an increment is replayed as the same increment
+// on the current total, so repeats show whether the handler accumulates. No-op without history.
+func (m *EventSimulatorModel) replayLastEvent() tea.Cmd {
+	if m.loading {
+		return nil
+	}
+	if m.eventTrigger == nil {
+		m.err = fmt.Errorf("event trigger not available (event handler not connected)")
+		return nil
+	}
+
+	for _, entry := range m.history {
+		if entry.Rejected {
+			continue
+		}
+
+		// Trigger from a copy of the form holding the entry's inputs, leaving the visible form as typed
+		replay := *m
+		replay.selectedType = entry.EventType
+		replay.incrementMode = entry.Increment != 0
+		replay.statCodeInput.SetValue(entry.StatCode)
+		replay.statValueInput.SetValue(strconv.Itoa(entry.Value))
+
+		summary := historyEntrySummary(entry)
+		if replay.incrementMode {
+			replay.statValueInput.SetValue(strconv.Itoa(entry.Increment))
+			summary = fmt.Sprintf("Stat Update: %s %+d", entry.StatCode, entry.Increment)
+		}
+
+		m.loading = true
+		m.err = nil
+		m.replaying = glyph.Current().Refresh + " Replaying " + summary
+		return replay.triggerEventCmd()
+	}
+
+	return nil
+}
+
 // updateInputFocus updates which input is focused
 func (m *EventSimulatorModel) updateInputFocus() {
 	switch m.focusedInput {
@@ -336,6 +507,7 @@ func (m *EventSimulatorModel) triggerEventCmd() tea.Cmd {
 		var eventType EventType
 		var statCode string
 		var value int
+		var increment int
 
 		switch m.selectedType {
 		case EventTypeLogin:
@@ -344,26 +516,42 @@ func (m *EventSimulatorModel) triggerEventCmd() tea.Cmd {
 
 		case EventTypeStatUpdate:
 			eventType = EventTypeStatUpdate
-			statCode = m.statCodeInput.Value()
-			if statCode == "" {
-				statCode = "kills" // Default
+			statCode = m.statCodeValue()
+
+			value, err = m.statValue()
+			if err != nil {
+				return eventTriggeredMsg{
+					eventType: eventType,
+					duration:  time.Since(startTime),
+					err:       fmt.Errorf("invalid value: %w", err),
+					rejected:  true,
+				}
 			}
 
-			valueStr := m.statValueInput.Value()
-			if valueStr == "" {
-				value = 10 // Default
-			} else {
-				value, err = strconv.Atoi(valueStr)
-				if err != nil {
+			// Increment mode: send running total + value, with value as inc
+			if m.incrementMode {
+				increment = value
+				total, incErr := m.statTracker.Increment(m.userID, m.namespace, statCode, increment)
+				if incErr != nil {
 					return eventTriggeredMsg{
 						eventType: eventType,
+						statCode:  statCode,
 						duration:  time.Since(startTime),
-						err:       fmt.Errorf("invalid value: %w", err),
+						err:       fmt.Errorf("invalid increment: %w", incErr),
+						rejected:  true,
 					}
 				}
+				value = total
 			}
 
-			err = m.eventTrigger.TriggerStatUpdate(ctx, m.userID, m.namespace, statCode, value, 0)
+			err = m.eventTrigger.TriggerStatUpdate(ctx, m.userID, m.namespace, statCode, value, increment)
+
+			// Keep the tracker in sync with what the handler actually received
+			if err != nil && m.incrementMode {
+				m.statTracker.Set(m.userID, m.namespace, statCode, value-increment)
+			} else if err == nil && !m.incrementMode {
+				m.statTracker.Set(m.userID, m.namespace, statCode, value)
+			}
 		}
 
 		duration := time.Since(startTime)
@@ -372,25 +560,82 @@ func (m *EventSimulatorModel) triggerEventCmd() tea.Cmd {
 			eventType: eventType,
 			statCode:  statCode,
 			value:     value,
+			increment: increment,
 			duration:  duration,
 			err:       err,
 		}
 	}
 }
 
+// statValue parses the stat value input, or returns defaultStatValue if it is empty
+// Absolute values must be within 0..maxStatValue. Increments may be negative (to lower the
+// running total) but not zero, within -maxStatValue..maxStatValue.
+func (m *EventSimulatorModel) statValue() (int, error) {
+	input := strings.TrimSpace(m.statValueInput.Value())
+	if input == "" {
+		return defaultStatValue, nil
+	}
+
+	value, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a whole number", input)
+	}
+
+	switch {
+	case m.incrementMode && value == 0:
+		return 0, fmt.Errorf("increment cannot be zero")
+	case m.incrementMode && (value < -m.maxStatValue || value > m.maxStatValue):
+		return 0, fmt.Errorf("increment must be between -%d and %d", m.maxStatValue, m.maxStatValue)
+	case !m.incrementMode && (value < 0 || value > m.maxStatValue):
+		return 0, fmt.Errorf("value must be between 0 and %d", m.maxStatValue)
+	}
+	return value, nil
+}
+
+// statValueError returns the stat value input's validation error (nil for other event types)
+func (m *EventSimulatorModel) statValueError() error {
+	if m.selectedType != EventTypeStatUpdate {
+		return nil
+	}
+	_, err := m.statValue()
+	return err
+}
+
+// statValueHint renders the line under the stat value input: its error, or the default used when empty
+func (m *EventSimulatorModel) statValueHint() string {
+	if err := m.statValueError(); err != nil {
+		return errorStyle.Render(glyph.Current().Failure+" "+err.Error()) + "\n"
+	}
+	if strings.TrimSpace(m.statValueInput.Value()) == "" {
+		return dimStyle.Render(fmt.Sprintf("Empty: sends the default %d", defaultStatValue)) + "\n"
+	}
+	return ""
+}
+
+// statCodeValue returns the entered stat code or the default
+func (m *EventSimulatorModel) statCodeValue() string {
+	statCode := m.statCodeInput.Value()
+	if statCode == "" {
+		statCode = "kills" // Default
+	}
+	return statCode
+}
+
 // eventTriggeredMsg is sent when an event trigger completes
 type eventTriggeredMsg struct {
 	eventType EventType
 	statCode  string
 	value     int
+	increment int
 	duration  time.Duration
 	err       error
+	rejected  bool // Failed the simulator's input checks before anything was sent
 }
 
 // Additional styles for event simulator
 var (
+	// Border is applied at render time (see panelBorder)
 	focusedInputStyle = lipgloss.NewStyle().
-		BorderStyle(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color("62")). // green
 		Padding(0, 1)
 )
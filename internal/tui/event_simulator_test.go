@@ -0,0 +1,270 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/events"
+)
+
+func TestEventSimulatorModel_Update_RefreshOnEvent(t *testing.T) {
+	tests := []struct {
+		name           string
+		refreshOnEvent bool
+		err            error
+		expectReload   bool
+	}{
+		{"enabled, success", true, nil, true},
+		{"enabled, failure", true, errors.New("connection refused"), false},
+		{"disabled, success", false, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := NewEventSimulatorModel(nopEventTrigger{}, "test-user", "demo")
+			model.SetRefreshOnEvent(tt.refreshOnEvent)
+
+			_, cmd := model.Update(eventTriggeredMsg{eventType: EventTypeLogin, err: tt.err})
+
+			reload := false
+			if cmd != nil {
+				_, reload = cmd().(ReloadChallengesMsg)
+			}
+			if reload != tt.expectReload {
+				t.Errorf("Expected reload %v, got %v", tt.expectReload, reload)
+			}
+		})
+	}
+}
+
+func TestAppModel_Update_ReloadFromSimulator(t *testing.T) {
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
+	model := NewAppModel(container)
+	model.eventSimulator = NewEventSimulatorModel(nopEventTrigger{}, "test-user", "demo")
+	model.eventSimulator.SetRefreshOnEvent(true)
+	model.currentScreen = ScreenEventSimulator
+
+	// A successful trigger queues a reload...
+	newModel, cmd := model.Update(eventTriggeredMsg{eventType: EventTypeLogin})
+	model = newModel.(AppModel)
+	if cmd == nil {
+		t.Fatal("Expected reload command")
+	}
+	msg := cmd()
+	if _, ok := msg.(ReloadChallengesMsg); !ok {
+		t.Fatalf("Expected ReloadChallengesMsg, got %T", msg)
+	}
+
+	// ...which reaches the dashboard while the simulator stays on screen
+	newModel, cmd = model.Update(msg)
+	model = newModel.(AppModel)
+	if model.currentScreen != ScreenEventSimulator {
+		t.Errorf("Expected to stay on the simulator, got %d", model.currentScreen)
+	}
+	if !model.dashboard.refreshing || cmd == nil {
+		t.Error("Expected dashboard to start a background reload")
+	}
+}
+
+func TestEventSimulatorModel_Update_SelectType(t *testing.T) {
+	model := NewEventSimulatorModel(nopEventTrigger{}, "test-user", "demo")
+
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	for i := 0; i < int(eventTypeCount)+1; i++ {
+		model.Update(down)
+	}
+	if model.selectedType != EventTypeStatUpdate {
+		t.Fatalf("Expected selection to stop at the last type, got %d", model.selectedType)
+	}
+	if !strings.Contains(model.View(), "Stat Code:") {
+		t.Error("Expected the stat code input in the view")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if model.selectedType != EventTypeLogin {
+		t.Errorf("Expected login after moving up, got %d", model.selectedType)
+	}
+}
+
+func TestEventSimulatorModel_StatValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		incrementMode bool
+		expectValue   int
+		expectError   string
+	}{
+		{"empty uses default", "", false, 10, ""},
+		{"valid", "250", false, 250, ""},
+		{"at max", "500", false, 500, ""},
+		{"zero", "0", false, 0, ""},
+		{"above max", "501", false, 0, "between 0 and 500"},
+		{"negative", "-5", false, 0, "between 0 and 500"},
+		{"non-numeric", "ten", false, 0, "not a whole number"},
+		{"negative increment", "-5", true, -5, ""},
+		{"zero increment", "0", true, 0, "cannot be zero"},
+		{"increment above max", "-501", true, 0, "between -500 and 500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := NewEventSimulatorModel(nopEventTrigger{}, "test-user", "demo")
+			model.SetMaxStatValue(500)
+			model.incrementMode = tt.incrementMode
+			model.statValueInput.SetValue(tt.value)
+
+			value, err := model.statValue()
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if value != tt.expectValue {
+				t.Errorf("Expected %d, got %d", tt.expectValue, value)
+			}
+		})
+	}
+}
+
+func TestEventSimulatorModel_Update_InvalidStatValueDisablesTrigger(t *testing.T) {
+	model := NewEventSimulatorModel(nopEventTrigger{}, "test-user", "demo")
+	model.selectedType = EventTypeStatUpdate
+	model.statValueInput.SetValue("-5")
+
+	view := model.View()
+	if !strings.Contains(view, "value must be between 0 and 1000000") {
+		t.Errorf("Expected the validation error under the field, got:\n%s", view)
+	}
+	if !strings.Contains(view, "disabled until the value is valid") {
+		t.Errorf("Expected a disabled trigger button, got:\n%s", view)
+	}
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || newModel.(*EventSimulatorModel).loading {
+		t.Error("Expected Enter to be ignored while the stat value is invalid")
+	}
+
+	model.statValueInput.SetValue("")
+	if view := model.View(); !strings.Contains(view, "Empty: sends the default 10") {
+		t.Errorf("Expected the default-on-empty hint, got:\n%s", view)
+	}
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil {
+		t.Error("Expected Enter to trigger with the default value")
+	}
+}
+
+// statRecorder records the stat updates sent to it
+type statRecorder struct {
+	nopEventTrigger
+	sent [][2]int // value, inc
+}
+
+func (r *statRecorder) TriggerStatUpdate(ctx context.Context, userID, namespace, statCode string, value, inc int) error {
+	r.sent = append(r.sent, [2]int{value, inc})
+	return nil
+}
+
+func TestEventSimulatorModel_Update_ReplayLastEvent(t *testing.T) {
+	recorder := &statRecorder{}
+	model := NewEventSimulatorModel(recorder, "test-user", "demo")
+	replay := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}}
+
+	// Nothing to replay yet
+	if _, cmd := model.Update(replay); cmd != nil {
+		t.Fatal("Expected replay to be a no-op without history")
+	}
+
+	// Send +5 in increment mode
+	model.selectedType = EventTypeStatUpdate
+	model.incrementMode = true
+	model.statValueInput.SetValue("5")
+	model.Update(model.triggerEventCmd()())
+
+	// A rejected entry on top of the history is skipped
+	model.Update(eventTriggeredMsg{eventType: EventTypeStatUpdate, err: errors.New("invalid value"), rejected: true})
+
+	// The form's current inputs don't affect the replay
+	model.incrementMode = false
+	model.statValueInput.SetValue("999")
+
+	_, cmd := model.Update(replay)
+	if cmd == nil {
+		t.Fatal("Expected replay to trigger the last sent event")
+	}
+	if !strings.Contains(model.View(), "Replaying Stat Update: kills +5") {
+		t.Errorf("Expected a replay confirmation, got:\n%s", model.View())
+	}
+	model.Update(cmd())
+
+	expected := [][2]int{{5, 5}, {10, 5}}
+	if len(recorder.sent) != len(expected) || recorder.sent[0] != expected[0] || recorder.sent[1] != expected[1] {
+		t.Errorf("Expected stat updates %v, got %v", expected, recorder.sent)
+	}
+	if model.statValueInput.Value() != "999" || model.incrementMode {
+		t.Error("Expected the replay to leave the form unchanged")
+	}
+	if len(model.history) != 3 || model.history[0].Value != 10 {
+		t.Errorf("Expected the replay in history with total 10, got %+v", model.history)
+	}
+}
+
+func TestEventSimulatorModel_Update_HistoryStatusCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode string
+		wantText string
+	}{
+		{
+			name:     "unavailable",
+			err:      &events.TriggerError{Event: "login", Code: codes.Unavailable, Err: status.Error(codes.Unavailable, "connection refused")},
+			wantCode: "Unavailable",
+			wantText: "[Unavailable, retryable]",
+		},
+		{
+			name:     "invalid argument",
+			err:      &events.TriggerError{Event: "login", Code: codes.InvalidArgument, Err: status.Error(codes.InvalidArgument, "bad user")},
+			wantCode: "InvalidArgument",
+			wantText: "[InvalidArgument]",
+		},
+		{
+			name: "never reached the RPC",
+			err:  errors.New("userID cannot be empty"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := NewEventSimulatorModel(nopEventTrigger{}, "test-user", "demo")
+			model.Update(eventTriggeredMsg{eventType: EventTypeLogin, err: tt.err})
+
+			entry := model.history[0]
+			if entry.Code != tt.wantCode {
+				t.Errorf("Expected code %q, got %q", tt.wantCode, entry.Code)
+			}
+
+			rendered := model.renderHistoryEntry(entry)
+			if tt.wantText != "" && !strings.Contains(rendered, tt.wantText) {
+				t.Errorf("Expected %q in history entry, got %q", tt.wantText, rendered)
+			}
+			if tt.wantText == "" && strings.Contains(rendered, "[") {
+				t.Errorf("Expected no status code in history entry, got %q", rendered)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+)
+
+// GoalsSelectedMsg is sent when a batch or random goal selection completes
+type GoalsSelectedMsg struct {
+	selected    []api.Goal
+	totalActive int32
+	err         error
+}
+
+// ReloadChallengesMsg asks the dashboard to reload challenges in the background
+type ReloadChallengesMsg struct{}
+
+// GoalSelectionModel activates goals of one challenge, either checked by hand or picked at random
+type GoalSelectionModel struct {
+	apiClient  api.APIClient
+	challenge  *api.Challenge // Copy of the dashboard's selected challenge (nil if none)
+	cursor     int
+	checked    map[string]bool // Goal IDs checked for batch selection
+	submitting bool            // True while a selection request is in flight
+	successMsg string
+	errorMsg   string
+
+	randomCount     int  // Number of goals activated by random selection ('+'/'-')
+	replaceExisting bool // Deactivate goals that are not selected ('x')
+}
+
+// NewGoalSelectionModel creates a new goal selection model
+func NewGoalSelectionModel(apiClient api.APIClient) *GoalSelectionModel {
+	return &GoalSelectionModel{
+		apiClient:   apiClient,
+		checked:     make(map[string]bool),
+		randomCount: 1,
+	}
+}
+
+// Init initializes the goal selection model
+func (m *GoalSelectionModel) Init() tea.Cmd {
+	return nil
+}
+
+// SetChallenge starts a new selection for the challenge (nil clears it)
+func (m *GoalSelectionModel) SetChallenge(challenge *api.Challenge) {
+	m.cursor = 0
+	m.checked = make(map[string]bool)
+	m.successMsg = ""
+	m.errorMsg = ""
+	m.challenge = nil
+	if challenge != nil {
+		copied := *challenge
+		m.challenge = &copied
+	}
+	m.clampRandomCount()
+}
+
+// SyncChallenge refreshes the challenge from reloaded challenges, keeping the checked goals
+func (m *GoalSelectionModel) SyncChallenge(challenges []api.Challenge) {
+	if m.challenge == nil {
+		return
+	}
+
+	for i := range challenges {
+		if challenges[i].ID == m.challenge.ID {
+			copied := challenges[i]
+			m.challenge = &copied
+			break
+		}
+	}
+
+	if m.cursor >= len(m.challenge.Goals) {
+		m.cursor = 0
+	}
+	m.clampRandomCount()
+}
+
+// clampRandomCount keeps the random count between 1 and the number of goals
+func (m *GoalSelectionModel) clampRandomCount() {
+	limit := 1
+	if m.challenge != nil && len(m.challenge.Goals) > 0 {
+		limit = len(m.challenge.Goals)
+	}
+	if m.randomCount > limit {
+		m.randomCount = limit
+	}
+	if m.randomCount < 1 {
+		m.randomCount = 1
+	}
+}
+
+// checkedGoalIDs returns the checked goal IDs in challenge order
+func (m *GoalSelectionModel) checkedGoalIDs() []string {
+	ids := []string{}
+	if m.challenge == nil {
+		return ids
+	}
+	for _, goal := range m.challenge.Goals {
+		if m.checked[goal.ID] {
+			ids = append(ids, goal.ID)
+		}
+	}
+	return ids
+}
+
+// Update handles messages for the goal selection screen
+func (m *GoalSelectionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.challenge == nil || m.submitting {
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+			return m, nil
+
+		case "down", "j":
+			if m.cursor < len(m.challenge.Goals)-1 {
+				m.cursor++
+			}
+			return m, nil
+
+		case " ":
+			// Toggle the goal under the cursor (locked goals can't be activated)
+			if m.cursor >= len(m.challenge.Goals) {
+				return m, nil
+			}
+			goal := m.challenge.Goals[m.cursor]
+			if goal.Locked {
+				m.errorMsg = fmt.Sprintf("Goal %s is locked by prerequisites", goal.Name)
+				return m, nil
+			}
+			m.errorMsg = ""
+			if m.checked[goal.ID] {
+				delete(m.checked, goal.ID)
+			} else {
+				m.checked[goal.ID] = true
+			}
+			return m, nil
+
+		case "+", "=":
+			m.randomCount++
+			m.clampRandomCount()
+			return m, nil
+
+		case "-":
+			m.randomCount--
+			m.clampRandomCount()
+			return m, nil
+
+		case "x":
+			m.replaceExisting = !m.replaceExisting
+			return m, nil
+
+		case "enter":
+			// Activate the checked goals
+			goalIDs := m.checkedGoalIDs()
+			if len(goalIDs) == 0 {
+				m.errorMsg = "No goals checked (press Space to check a goal)"
+				return m, nil
+			}
+			m.submitting = true
+			m.successMsg = ""
+			m.errorMsg = ""
+			return m, m.batchSelectCmd(m.challenge.ID, goalIDs)
+
+		case "n":
+			// Activate randomCount random goals
+			m.submitting = true
+			m.successMsg = ""
+			m.errorMsg = ""
+			return m, m.randomSelectCmd(m.challenge.ID, m.randomCount)
+		}
+
+	case GoalsSelectedMsg:
+		m.submitting = false
+		if msg.err != nil {
+			m.errorMsg = fmt.Sprintf("Failed to select goals: %v", msg.err)
+			m.successMsg = ""
+			return m, nil
+		}
+
+		m.checked = make(map[string]bool)
+		m.successMsg = fmt.Sprintf("%s Selected %d goal(s), %d active", glyph.Current().Success, len(msg.selected), msg.totalActive)
+		m.errorMsg = ""
+
+		// Reload challenges so the dashboard (and this screen) show the new active goals
+		return m, func() tea.Msg { return ReloadChallengesMsg{} }
+	}
+
+	return m, nil
+}
+
+// View renders the goal selection screen
+func (m *GoalSelectionModel) View() string {
+	var b strings.Builder
+
+	if m.challenge == nil {
+		b.WriteString(titleStyle.Render("Goal Selection"))
+		b.WriteString("\n\n")
+		b.WriteString(subtitleStyle.Render("No challenge selected. Select a challenge on the dashboard, then press 'g'."))
+		return b.String()
+	}
+
+	b.WriteString(titleStyle.Render("Goal Selection - " + m.challenge.Name))
+	b.WriteString("\n\n")
+
+	if m.submitting {
+		b.WriteString(loadingStyle.Render("Selecting goals..."))
+		return b.String()
+	}
+
+	if m.successMsg != "" {
+		b.WriteString(completedStyle.Render(m.successMsg))
+		b.WriteString("\n\n")
+	}
+	if m.errorMsg != "" {
+		b.WriteString(errorStyle.Render(m.errorMsg))
+		b.WriteString("\n\n")
+	}
+
+	if len(m.challenge.Goals) == 0 {
+		b.WriteString(subtitleStyle.Render("This challenge has no goals"))
+		return b.String()
+	}
+
+	g := glyph.Current()
+	for i, goal := range m.challenge.Goals {
+		b.WriteString(m.renderGoalRow(goal, i == m.cursor, g))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	replace := "off"
+	if m.replaceExisting {
+		replace = "on"
+	}
+	b.WriteString(fmt.Sprintf("Checked: %d  Random count: %d  Replace existing: %s", len(m.checked), m.randomCount, replace))
+	b.WriteString("\n\n")
+	b.WriteString(subtitleStyle.Render("Use " + g.UpDown + " to navigate, Space to check, Enter to activate checked goals, 'n' to activate random goals, '+'/'-' to change the count, 'x' to toggle replace existing"))
+
+	return b.String()
+}
+
+// renderGoalRow renders a goal with its checkbox and active/locked markers
+func (m *GoalSelectionModel) renderGoalRow(goal api.Goal, selected bool, g glyph.Set) string {
+	cursor := " "
+	style := itemStyle
+	if selected {
+		cursor = ">"
+		style = selectedStyle
+	}
+
+	checkbox := "[ ]"
+	if m.checked[goal.ID] {
+		checkbox = "[x]"
+	}
+
+	line := fmt.Sprintf("%s %s %s", cursor, checkbox, goal.Name)
+	if goal.IsActive {
+		line += " (active)"
+	}
+	if goal.Locked {
+		line += " " + g.Locked
+		if !selected {
+			style = dimStyle
+		}
+	}
+
+	return style.Render(line)
+}
+
+// batchSelectCmd activates the given goals
+func (m *GoalSelectionModel) batchSelectCmd(challengeID string, goalIDs []string) tea.Cmd {
+	replaceExisting := m.replaceExisting
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		resp, err := m.apiClient.BatchSelectGoals(ctx, challengeID, &api.BatchSelectRequest{
+			GoalIDs:         goalIDs,
+			ReplaceExisting: replaceExisting,
+		})
+		if err != nil {
+			return GoalsSelectedMsg{err: err}
+		}
+		return GoalsSelectedMsg{selected: resp.SelectedGoals, totalActive: resp.TotalActiveGoals}
+	}
+}
+
+// randomSelectCmd activates count random goals
+func (m *GoalSelectionModel) randomSelectCmd(challengeID string, count int) tea.Cmd {
+	replaceExisting := m.replaceExisting
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		resp, err := m.apiClient.RandomSelectGoals(ctx, challengeID, &api.RandomSelectRequest{
+			Count:           count,
+			ReplaceExisting: replaceExisting,
+		})
+		if err != nil {
+			return GoalsSelectedMsg{err: err}
+		}
+		return GoalsSelectedMsg{selected: resp.SelectedGoals, totalActive: resp.TotalActiveGoals}
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+)
+
+func goalSelectionChallenge() api.Challenge {
+	return api.Challenge{
+		ID:   "daily",
+		Name: "Daily Quests",
+		Goals: []api.Goal{
+			{ID: "g1", Name: "Login", Status: "not_started"},
+			{ID: "g2", Name: "Locked Goal", Status: "not_started", Locked: true},
+			{ID: "g3", Name: "Win Match", Status: "in_progress"},
+		},
+	}
+}
+
+func TestGoalSelectionModel_Update_ToggleSkipsLocked(t *testing.T) {
+	challenge := goalSelectionChallenge()
+	model := NewGoalSelectionModel(api.NewMockAPIClient(nil))
+	model.SetChallenge(&challenge)
+
+	keys := []tea.KeyMsg{
+		{Type: tea.KeySpace},                     // Check g1
+		{Type: tea.KeyDown},                      // Move to g2
+		{Type: tea.KeySpace},                     // Locked, ignored
+		{Type: tea.KeyDown},                      // Move to g3
+		{Type: tea.KeySpace},                     // Check g3
+		{Type: tea.KeySpace},                     // Uncheck g3
+		{Type: tea.KeyRunes, Runes: []rune("x")}, // Replace existing on
+	}
+	for _, key := range keys {
+		newModel, _ := model.Update(key)
+		model = newModel.(*GoalSelectionModel)
+	}
+
+	ids := model.checkedGoalIDs()
+	if len(ids) != 1 || ids[0] != "g1" {
+		t.Errorf("Expected only g1 checked, got %v", ids)
+	}
+	if !model.replaceExisting {
+		t.Error("Expected replace existing to be on")
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "[x] Login") || !strings.Contains(view, "[ ] Locked Goal") {
+		t.Errorf("Expected checkboxes in view, got:\n%s", view)
+	}
+}
+
+func TestGoalSelectionModel_Update_LockedShowsError(t *testing.T) {
+	challenge := goalSelectionChallenge()
+	model := NewGoalSelectionModel(api.NewMockAPIClient(nil))
+	model.SetChallenge(&challenge)
+	model.cursor = 1
+
+	newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	model = newModel.(*GoalSelectionModel)
+
+	if !strings.Contains(model.errorMsg, "locked") {
+		t.Errorf("Expected locked error, got %q", model.errorMsg)
+	}
+}
+
+func TestGoalSelectionModel_Update_RandomCountClamped(t *testing.T) {
+	challenge := goalSelectionChallenge()
+	model := NewGoalSelectionModel(api.NewMockAPIClient(nil))
+	model.SetChallenge(&challenge)
+
+	tests := []struct {
+		key      string
+		expected int
+	}{
+		{"-", 1},
+		{"+", 2},
+		{"+", 3},
+		{"+", 3},
+		{"-", 2},
+	}
+
+	for _, tt := range tests {
+		newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.key)})
+		model = newModel.(*GoalSelectionModel)
+		if model.randomCount != tt.expected {
+			t.Errorf("After %q expected count %d, got %d", tt.key, tt.expected, model.randomCount)
+		}
+	}
+}
+
+func TestGoalSelectionModel_Update_BatchSelect(t *testing.T) {
+	challenge := goalSelectionChallenge()
+	apiClient := api.NewMockAPIClient([]api.Challenge{challenge})
+	model := NewGoalSelectionModel(apiClient)
+	model.SetChallenge(&challenge)
+
+	// Enter with nothing checked is rejected without a request
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = newModel.(*GoalSelectionModel)
+	if cmd != nil || model.errorMsg == "" {
+		t.Fatalf("Expected error and no command, got cmd=%v error=%q", cmd != nil, model.errorMsg)
+	}
+
+	newModel, _ = model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	model = newModel.(*GoalSelectionModel)
+	newModel, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = newModel.(*GoalSelectionModel)
+	if cmd == nil || !model.submitting {
+		t.Fatal("Expected batch select command")
+	}
+
+	msg, ok := cmd().(GoalsSelectedMsg)
+	if !ok {
+		t.Fatal("Expected GoalsSelectedMsg")
+	}
+	if msg.err != nil || len(msg.selected) != 1 || msg.selected[0].ID != "g1" {
+		t.Fatalf("Expected g1 selected, got %+v", msg)
+	}
+
+	newModel, cmd = model.Update(msg)
+	model = newModel.(*GoalSelectionModel)
+	if model.submitting || model.successMsg == "" || len(model.checked) != 0 {
+		t.Errorf("Expected success with checks cleared, got success=%q checked=%v", model.successMsg, model.checked)
+	}
+	if cmd == nil {
+		t.Fatal("Expected reload command")
+	}
+	if _, ok := cmd().(ReloadChallengesMsg); !ok {
+		t.Error("Expected ReloadChallengesMsg")
+	}
+}
+
+func TestGoalSelectionModel_Update_RandomSelect(t *testing.T) {
+	challenge := goalSelectionChallenge()
+	apiClient := api.NewMockAPIClient([]api.Challenge{challenge})
+	model := NewGoalSelectionModel(apiClient)
+	model.SetChallenge(&challenge)
+	model.randomCount = 2
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	model = newModel.(*GoalSelectionModel)
+	if cmd == nil {
+		t.Fatal("Expected random select command")
+	}
+
+	msg := cmd().(GoalsSelectedMsg)
+	if msg.err != nil {
+		t.Fatalf("Unexpected error: %v", msg.err)
+	}
+	for _, goal := range msg.selected {
+		if goal.Locked {
+			t.Errorf("Expected locked goal %s not to be selected", goal.ID)
+		}
+	}
+	if len(msg.selected) != 2 {
+		t.Errorf("Expected 2 goals selected, got %d", len(msg.selected))
+	}
+}
+
+func TestGoalSelectionModel_SyncChallenge(t *testing.T) {
+	challenge := goalSelectionChallenge()
+	model := NewGoalSelectionModel(api.NewMockAPIClient(nil))
+	model.SetChallenge(&challenge)
+	model.checked["g3"] = true
+
+	reloaded := goalSelectionChallenge()
+	reloaded.Goals[0].IsActive = true
+	model.SyncChallenge([]api.Challenge{{ID: "other"}, reloaded})
+
+	if !model.challenge.Goals[0].IsActive {
+		t.Error("Expected synced challenge to show g1 active")
+	}
+	if !model.checked["g3"] {
+		t.Error("Expected checked goals to be kept")
+	}
+	if !strings.Contains(model.View(), "Login (active)") {
+		t.Errorf("Expected active marker in view, got:\n%s", model.View())
+	}
+}
+
+func TestAppModel_Update_GoalSelectionKey(t *testing.T) {
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
+	model := NewAppModel(container)
+	model.dashboard.challenges = []api.Challenge{goalSelectionChallenge()}
+
+	newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	model = newModel.(AppModel)
+
+	if model.currentScreen != ScreenGoalSelection {
+		t.Fatalf("Expected goal selection screen, got %d", model.currentScreen)
+	}
+	if model.goalSelection.challenge == nil || model.goalSelection.challenge.ID != "daily" {
+		t.Errorf("Expected dashboard's selected challenge, got %+v", model.goalSelection.challenge)
+	}
+	if !strings.Contains(model.renderHeader(), "Goal Selection") {
+		t.Errorf("Expected header to name the screen, got %q", model.renderHeader())
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+)
+
+// keyBinding is a key (or key combination) and what it does
+type keyBinding struct {
+	Key         string
+	Description string
+}
+
+// helpSection groups the keybindings of one screen
+type helpSection struct {
+	Title    string
+	Bindings []keyBinding
+}
+
+// helpSections returns all keybindings grouped by screen
+// Arrow glyphs follow the active glyph set so the overlay renders in ASCII mode.
+func helpSections() []helpSection {
+	g := glyph.Current()
+	return []helpSection{
+		{Title: "Global", Bindings: []keyBinding{
+			{"1", "Dashboard"},
+			{"2 / e", "Event Simulator"},
+			{"3 / i", "Inventory & Wallets"},
+			{"g", "Goal Selection for the highlighted challenge"},
+			{"Esc", "Back to the dashboard"},
+			{"u", "Switch to the next --users user (mock auth)"},
+			{"?", "Toggle this help"},
+			{"q", "Quit"},
+			{"Ctrl+C", "Quit (works while typing)"},
+		}},
+		{Title: "Dashboard", Bindings: []keyBinding{
+			{g.UpDown + " / j k", "Navigate challenges or goals"},
+			{"Enter", "View challenge details"},
+			{"Esc", "Back to the challenge list / clear filter"},
+			{"/", "Filter challenges by name"},
+			{"s", "Cycle sort order (list) or goal status filter (details)"},
+			{"c", "Claim the selected completed goal (y/Enter to confirm)"},
+			{"r", "Refresh"},
+			{"f", "Toggle auto-refresh"},
+		}},
+		{Title: "Event Simulator", Bindings: []keyBinding{
+			{g.UpDown, "Choose the event type"},
+			{"Tab", "Cycle through the inputs"},
+			{"m", "Toggle absolute/increment mode"},
+			{"Enter", "Trigger the event"},
+			{"r", "Replay the last sent event"},
+			{"Esc", "Leave the focused input"},
+		}},
+		{Title: "Inventory", Bindings: []keyBinding{
+			{"Tab", "Switch between entitlements and wallets"},
+			{g.UpDown + " / j k", "Select an entry"},
+			{"h", "Hide empty wallets"},
+			{"r", "Refresh"},
+		}},
+		{Title: "Goal Selection", Bindings: []keyBinding{
+			{g.UpDown + " / j k", "Navigate goals"},
+			{"Space", "Check or uncheck a goal (locked goals can't be checked)"},
+			{"Enter", "Activate the checked goals"},
+			{"n", "Activate random goals"},
+			{"+ / -", "Change the random goal count"},
+			{"x", "Toggle replacing the active goals"},
+		}},
+	}
+}
+
+// HelpModel is the full-screen keybindings overlay ('?')
+// It is drawn in place of the current screen, which keeps its state underneath.
+type HelpModel struct {
+	visible bool
+}
+
+// NewHelpModel creates a hidden help overlay
+func NewHelpModel() *HelpModel {
+	return &HelpModel{}
+}
+
+// Visible reports whether the overlay is shown
+func (m *HelpModel) Visible() bool {
+	return m.visible
+}
+
+// Toggle shows or hides the overlay
+func (m *HelpModel) Toggle() {
+	m.visible = !m.visible
+}
+
+// Init initializes the help model
+func (m *HelpModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update closes the overlay on '?' or Esc; other keys are swallowed
+func (m *HelpModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "?", "esc":
+			m.visible = false
+		}
+	}
+	return m, nil
+}
+
+// View renders the keybindings grouped by screen
+func (m *HelpModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render("Keyboard Shortcuts"))
+	b.WriteString("\n")
+
+	for _, section := range helpSections() {
+		b.WriteString("\n")
+		b.WriteString(boldStyle.Render(section.Title))
+		b.WriteString("\n")
+		for _, binding := range section.Bindings {
+			b.WriteString(fmt.Sprintf("  %-12s %s\n", binding.Key, binding.Description))
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(subtitleStyle.Render("Press '?' or Esc to close"))
+
+	return b.String()
+}
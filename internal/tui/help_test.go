@@ -0,0 +1,84 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/app"
+)
+
+func TestHelpModel_View_ListsEverySection(t *testing.T) {
+	view := NewHelpModel().View()
+
+	for _, section := range helpSections() {
+		if !strings.Contains(view, section.Title) {
+			t.Errorf("Expected section %q in help, got:\n%s", section.Title, view)
+		}
+	}
+	for _, key := range []string{"Tab", "Ctrl+C", "Claim"} {
+		if !strings.Contains(view, key) {
+			t.Errorf("Expected %q in help, got:\n%s", key, view)
+		}
+	}
+}
+
+func TestAppModel_Update_HelpOverlay(t *testing.T) {
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
+	model := NewAppModel(container)
+	model.dashboard.challenges = []api.Challenge{
+		{ID: "c1", Name: "Challenge 1"},
+		{ID: "c2", Name: "Challenge 2"},
+	}
+	model.currentScreen = ScreenInventory
+
+	tests := []struct {
+		name        string
+		key         tea.KeyMsg
+		helpVisible bool
+	}{
+		{"? opens help", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")}, true},
+		{"screen keys are swallowed", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")}, true},
+		{"q is swallowed", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}, true},
+		{"esc closes help", tea.KeyMsg{Type: tea.KeyEsc}, false},
+		{"? opens help again", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")}, true},
+		{"? closes help", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")}, false},
+	}
+
+	for _, tt := range tests {
+		newModel, _ := model.Update(tt.key)
+		model = newModel.(AppModel)
+
+		if model.help.Visible() != tt.helpVisible {
+			t.Errorf("%s: expected help visible %v, got %v", tt.name, tt.helpVisible, model.help.Visible())
+		}
+		if model.quitting {
+			t.Fatalf("%s: expected not to quit", tt.name)
+		}
+		if model.currentScreen != ScreenInventory {
+			t.Errorf("%s: expected to stay on the inventory screen, got %d", tt.name, model.currentScreen)
+		}
+		if tt.helpVisible && !strings.Contains(model.View(), "Keyboard Shortcuts") {
+			t.Errorf("%s: expected help overlay in view", tt.name)
+		}
+	}
+}
+
+func TestAppModel_Update_HelpIgnoredWhileFiltering(t *testing.T) {
+	container := app.NewContainer(app.ContainerConfig{BackendURL: "http://localhost:8080", AuthMode: "mock", UserID: "test-user", Namespace: "demo"})
+	model := NewAppModel(container)
+	model.dashboard.filtering = true
+
+	newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	model = newModel.(AppModel)
+
+	if model.help.Visible() {
+		t.Error("Expected '?' to be typed into the filter, not open help")
+	}
+}
@@ -5,6 +5,7 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
 )
 
 // LoadInventoryMsg triggers data loading
@@ -19,10 +22,29 @@ type LoadInventoryMsg struct{}
 
 // InventoryLoadedMsg contains loaded data
 type InventoryLoadedMsg struct {
-	Entitlements []*ags.Entitlement
+	Entitlements []*ags.Entitlement // The first page
 	Wallets      []*ags.Wallet
+	HasMore      bool // More entitlement pages follow
+	NextOffset   int  // Offset of the next entitlement page
+	Total        int  // Total entitlements, or <= 0 if unknown
 }
 
+// EntitlementPageLoadedMsg contains a further page of entitlements
+type EntitlementPageLoadedMsg struct {
+	Offset int // Offset the page was requested at
+	Page   *ags.EntitlementPage
+}
+
+// EntitlementPageErrorMsg contains a failed further page of entitlements
+// Unlike InventoryErrorMsg it keeps the entitlements already loaded on screen.
+type EntitlementPageErrorMsg struct {
+	Offset int // Offset the page was requested at
+	Err    error
+}
+
+// inventoryPageSize is how many entitlements each page query loads
+const inventoryPageSize = 50
+
 // InventoryErrorMsg contains load error
 type InventoryErrorMsg struct {
 	Err error
@@ -36,9 +58,18 @@ type InventoryModel struct {
 	loading      bool
 	err          error
 
-	// UI state
-	scrollOffset int
+	// UI state (each panel keeps its own selection; the rendered window follows it)
+	entCursor    int
+	walletCursor int
 	focusedPanel string // "entitlements" or "wallets"
+	hideEmpty    bool   // Hide zero-balance wallets ('h')
+
+	// Entitlement paging: the next page loads when the selection moves past the last entitlement
+	entHasMore    bool
+	entNextOffset int
+	entTotal      int // <= 0 if unknown
+	loadingMore   bool
+	pageErr       error // Last failed page, shown below the panels until the next page or reload
 }
 
 // NewInventoryModel creates a new inventory model
@@ -46,7 +77,6 @@ func NewInventoryModel(verifier ags.RewardVerifier) *InventoryModel {
 	return &InventoryModel{
 		verifier:     verifier,
 		focusedPanel: "entitlements",
-		scrollOffset: 0,
 	}
 }
 
@@ -63,40 +93,55 @@ func (m *InventoryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "r":
 			// Refresh data
 			m.loading = true
+			m.loadingMore = false
+			m.pageErr = nil
 			m.err = nil
 			return m, m.loadInventoryCmd()
 
 		case "tab":
-			// Switch between panels
+			// Switch between panels (each keeps its selection)
 			if m.focusedPanel == "entitlements" {
 				m.focusedPanel = "wallets"
 			} else {
 				m.focusedPanel = "entitlements"
 			}
-			m.scrollOffset = 0
+			return m, nil
+
+		case "h":
+			// Toggle hiding zero-balance wallets
+			m.hideEmpty = !m.hideEmpty
+			m.walletCursor = 0
 			return m, nil
 
 		case "up", "k":
-			// Scroll up
-			if m.scrollOffset > 0 {
-				m.scrollOffset--
+			// Move the selection up in the focused panel
+			if cursor := m.focusedCursor(); *cursor > 0 {
+				*cursor--
 			}
 			return m, nil
 
 		case "down", "j":
-			// Scroll down
+			// Move the selection down in the focused panel
 			maxItems := len(m.entitlements)
 			if m.focusedPanel == "wallets" {
-				maxItems = len(m.wallets)
+				maxItems = len(m.visibleWallets())
+			}
+			if cursor := m.focusedCursor(); *cursor < maxItems-1 && maxItems > 0 {
+				*cursor++
+				return m, nil
 			}
-			if m.scrollOffset < maxItems-1 && maxItems > 0 {
-				m.scrollOffset++
+			if m.focusedPanel == "entitlements" && m.entHasMore && !m.loadingMore {
+				m.loadingMore = true
+				m.pageErr = nil
+				return m, m.loadEntitlementPageCmd(m.entNextOffset)
 			}
 			return m, nil
 		}
 
 	case LoadInventoryMsg:
 		m.loading = true
+		m.loadingMore = false
+		m.pageErr = nil
 		m.err = nil
 		return m, m.loadInventoryCmd()
 
@@ -104,11 +149,49 @@ func (m *InventoryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.entitlements = msg.Entitlements
 		m.wallets = msg.Wallets
+		m.entHasMore = msg.HasMore
+		m.entNextOffset = msg.NextOffset
+		m.entTotal = msg.Total
 		m.err = nil
+		// Keep selections within the reloaded lists
+		if m.entCursor >= len(m.entitlements) {
+			m.entCursor = 0
+		}
+		if m.walletCursor >= len(m.visibleWallets()) {
+			m.walletCursor = 0
+		}
+		return m, nil
+
+	case EntitlementPageLoadedMsg:
+		// Drop pages requested before a refresh
+		if !m.loadingMore || msg.Offset != m.entNextOffset {
+			return m, nil
+		}
+		m.loadingMore = false
+		m.entHasMore = msg.Page.HasMore
+		m.entNextOffset = msg.Page.NextOffset
+		if msg.Page.Total > 0 {
+			m.entTotal = msg.Page.Total
+		}
+		if len(msg.Page.Entitlements) > 0 {
+			m.entitlements = append(m.entitlements, msg.Page.Entitlements...)
+			// The page was requested by moving down past the last entitlement
+			m.entCursor++
+		}
+		return m, nil
+
+	case EntitlementPageErrorMsg:
+		// Keep the loaded entitlements; moving down past the last one retries the page
+		if !m.loadingMore || msg.Offset != m.entNextOffset {
+			return m, nil
+		}
+		m.loadingMore = false
+		m.pageErr = msg.Err
 		return m, nil
 
 	case InventoryErrorMsg:
 		m.loading = false
+		m.loadingMore = false
 		m.err = msg.Err
 		return m, nil
 	}
@@ -162,145 +245,223 @@ func (m *InventoryModel) renderInventory() string {
 	)
 
 	// Summary
-	summary := fmt.Sprintf("\nShowing %d entitlement(s), %d wallet(s)",
-		len(m.entitlements), len(m.wallets))
+	wallets := m.visibleWallets()
+	summary := fmt.Sprintf("\nShowing %s entitlement(s), %d wallet(s)",
+		m.entitlementCount(), len(wallets))
+	if m.hideEmpty {
+		summary += fmt.Sprintf(" (%d empty hidden, 'h' to show)", len(m.wallets)-len(wallets))
+	}
+	if m.pageErr != nil {
+		summary += "\n" + errorStyle.Render(fmt.Sprintf("%v (move down to retry)", m.pageErr))
+	}
 
 	return panels + summary
 }
 
-// renderEntitlementsPanel renders the entitlements list
-func (m *InventoryModel) renderEntitlementsPanel() string {
-	focused := m.focusedPanel == "entitlements"
+// entitlementCount renders how many entitlements are loaded, out of the total while pages remain
+func (m *InventoryModel) entitlementCount() string {
+	loaded := len(m.entitlements)
+	switch {
+	case m.loadingMore:
+		return fmt.Sprintf("%d (loading more...)", loaded)
+	case !m.entHasMore:
+		return fmt.Sprintf("%d", loaded)
+	case m.entTotal > 0:
+		return fmt.Sprintf("%d of %d", loaded, m.entTotal)
+	default:
+		return fmt.Sprintf("%d+", loaded)
+	}
+}
 
-	// Panel style
-	panelStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		Width(35).
-		Height(15).
-		Padding(1)
+// Inventory panel layout, used to compute how many entries fit in a panel
+const (
+	inventoryPanelHeight  = 15 // Panel height inside the border
+	inventoryPanelPadding = 1  // Padding on every side
+	entitlementLines      = 3  // Lines rendered per entitlement
+	walletLines           = 2  // Lines rendered per wallet
+)
+
+// inventoryPanelStyle returns the bordered panel style, highlighted when focused
+func inventoryPanelStyle(width int, focused bool) lipgloss.Style {
+	style := lipgloss.NewStyle().
+		Border(panelBorder()).
+		Width(width).
+		Height(inventoryPanelHeight).
+		Padding(inventoryPanelPadding)
 
 	if focused {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("12"))
-	} else {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("8"))
+		return style.BorderForeground(lipgloss.Color("12"))
 	}
+	return style.BorderForeground(lipgloss.Color("8"))
+}
 
-	// Header
-	header := lipgloss.NewStyle().
+// inventoryPanelHeader renders a panel title
+func inventoryPanelHeader(title string) string {
+	return lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color("12")).
-		Render("Item Entitlements")
+		Render(title)
+}
 
-	// Content
-	var content strings.Builder
+// panelCapacity returns how many entries of linesPerItem fit below the header and above the position indicator
+func panelCapacity(linesPerItem int) int {
+	available := inventoryPanelHeight - 2*inventoryPanelPadding - 3 // Header, blank line, "x of N"
+	if n := available / linesPerItem; n > 0 {
+		return n
+	}
+	return 1
+}
 
+// visibleWindow returns the [start, end) range of up to capacity items that keeps selected in view
+// The selection is kept near the middle of the window where possible.
+func visibleWindow(selected, total, capacity int) (int, int) {
+	if total <= capacity {
+		return 0, total
+	}
+
+	start := selected - capacity/2
+	if start < 0 {
+		start = 0
+	}
+	if start > total-capacity {
+		start = total - capacity
+	}
+	return start, start + capacity
+}
+
+// renderPanelEntry renders an entry's lines, marking and highlighting the first line when selected
+func renderPanelEntry(lines []string, selected bool) string {
+	var b strings.Builder
+	for i, line := range lines {
+		switch {
+		case i > 0:
+			b.WriteString("  " + line)
+		case selected:
+			b.WriteString(selectedStyle.UnsetPadding().Render("> " + line))
+		default:
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// renderPositionIndicator renders "x of N" for the selected entry
+func renderPositionIndicator(selected, total int) string {
+	return dimStyle.Render(fmt.Sprintf("%d of %d", selected+1, total))
+}
+
+// renderEntitlementsPanel renders the window of entitlements around the selection
+func (m *InventoryModel) renderEntitlementsPanel() string {
+	focused := m.focusedPanel == "entitlements"
+
+	var content strings.Builder
 	if len(m.entitlements) == 0 {
-		content.WriteString("\n(No entitlements)")
+		content.WriteString("(No entitlements)")
 	} else {
-		for i, ent := range m.entitlements {
-			// Skip items before scroll offset
-			if i < m.scrollOffset {
-				continue
-			}
-
-			// Stop if we've rendered enough items
-			if content.Len() > 300 {
-				content.WriteString("\n...")
-				break
-			}
+		start, end := visibleWindow(m.entCursor, len(m.entitlements), panelCapacity(entitlementLines))
+		for i := start; i < end; i++ {
+			ent := m.entitlements[i]
 
 			// Status badge
 			statusColor := "10" // Green for ACTIVE
 			if ent.Status != "ACTIVE" {
 				statusColor = "8" // Gray for INACTIVE
 			}
-
 			statusBadge := lipgloss.NewStyle().
 				Foreground(lipgloss.Color(statusColor)).
 				Render(fmt.Sprintf("[%s]", ent.Status))
 
-			content.WriteString(fmt.Sprintf("\n%s %s\n", statusBadge, ent.ItemID))
-			content.WriteString(fmt.Sprintf("  Quantity: %d\n", ent.Quantity))
-			content.WriteString(fmt.Sprintf("  Granted: %s\n", ent.GrantedAt.Format("2006-01-02 15:04")))
+			content.WriteString(renderPanelEntry([]string{
+				fmt.Sprintf("%s %s", statusBadge, ent.ItemID),
+				fmt.Sprintf("Quantity: %d", ent.Quantity),
+				fmt.Sprintf("Granted: %s", output.FormatTime(ent.GrantedAt)),
+			}, focused && i == m.entCursor))
 		}
+		content.WriteString(renderPositionIndicator(m.entCursor, len(m.entitlements)))
 	}
 
-	return panelStyle.Render(header + "\n" + content.String())
+	return inventoryPanelStyle(35, focused).Render(inventoryPanelHeader("Item Entitlements") + "\n\n" + content.String())
 }
 
-// renderWalletsPanel renders the wallets list
+// renderWalletsPanel renders the window of wallets around the selection
 func (m *InventoryModel) renderWalletsPanel() string {
 	focused := m.focusedPanel == "wallets"
 
-	// Panel style
-	panelStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		Width(30).
-		Height(15).
-		Padding(1)
-
-	if focused {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("12"))
-	} else {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("8"))
-	}
-
-	// Header
-	header := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("12")).
-		Render("Wallet Balances")
-
-	// Content
 	var content strings.Builder
-
-	if len(m.wallets) == 0 {
-		content.WriteString("\n(No wallets)")
+	wallets := m.visibleWallets()
+	if len(wallets) == 0 {
+		content.WriteString("(No wallets)")
 	} else {
-		for i, wallet := range m.wallets {
-			// Skip items before scroll offset
-			if i < m.scrollOffset && focused {
-				continue
-			}
-
-			// Stop if we've rendered enough items
-			if content.Len() > 300 {
-				content.WriteString("\n...")
-				break
-			}
+		start, end := visibleWindow(m.walletCursor, len(wallets), panelCapacity(walletLines))
+		for i := start; i < end; i++ {
+			wallet := wallets[i]
 
 			// Status indicator
-			statusIndicator := "✓"
+			statusIndicator := glyph.Current().Success
 			if wallet.Status != "ACTIVE" {
-				statusIndicator = "✗"
+				statusIndicator = glyph.Current().Failure
 			}
 
-			content.WriteString(fmt.Sprintf("\n%s: %d %s\n", wallet.CurrencyCode, wallet.Balance, statusIndicator))
-			content.WriteString(fmt.Sprintf("  Status: %s\n", wallet.Status))
+			content.WriteString(renderPanelEntry([]string{
+				fmt.Sprintf("%s: %d %s", wallet.CurrencyCode, wallet.Balance, statusIndicator),
+				fmt.Sprintf("Status: %s", wallet.Status),
+			}, focused && i == m.walletCursor))
 		}
+		content.WriteString(renderPositionIndicator(m.walletCursor, len(wallets)))
+	}
+
+	return inventoryPanelStyle(30, focused).Render(inventoryPanelHeader("Wallet Balances") + "\n\n" + content.String())
+}
+
+// focusedCursor returns the selected entry index of the focused panel
+func (m *InventoryModel) focusedCursor() *int {
+	if m.focusedPanel == "wallets" {
+		return &m.walletCursor
 	}
+	return &m.entCursor
+}
 
-	return panelStyle.Render(header + "\n" + content.String())
+// visibleWallets returns the wallets shown in the wallets panel
+func (m *InventoryModel) visibleWallets() []*ags.Wallet {
+	return ags.InventoryFilter{HideEmpty: m.hideEmpty}.Wallets(m.wallets)
 }
 
 // loadInventoryCmd loads entitlements and wallets
 func (m *InventoryModel) loadInventoryCmd() tea.Cmd {
 	return func() tea.Msg {
-		// Query entitlements
-		entitlements, err := m.verifier.QueryUserEntitlements(nil)
+		// The verifier bounds each attempt itself; the TUI handles Ctrl+C as a key, not a signal
+		ctx := context.Background()
+
+		// Query the first page of entitlements
+		page, err := m.verifier.QueryUserEntitlementsPage(ctx, nil, 0, inventoryPageSize)
 		if err != nil {
 			return InventoryErrorMsg{Err: fmt.Errorf("failed to load entitlements: %w", err)}
 		}
 
 		// Query wallets
-		wallets, err := m.verifier.QueryUserWallets()
+		wallets, err := m.verifier.QueryUserWallets(ctx)
 		if err != nil {
 			return InventoryErrorMsg{Err: fmt.Errorf("failed to load wallets: %w", err)}
 		}
 
 		return InventoryLoadedMsg{
-			Entitlements: entitlements,
+			Entitlements: page.Entitlements,
 			Wallets:      wallets,
+			HasMore:      page.HasMore,
+			NextOffset:   page.NextOffset,
+			Total:        page.Total,
+		}
+	}
+}
+
+// loadEntitlementPageCmd loads the page of entitlements starting at offset
+func (m *InventoryModel) loadEntitlementPageCmd(offset int) tea.Cmd {
+	return func() tea.Msg {
+		page, err := m.verifier.QueryUserEntitlementsPage(context.Background(), nil, offset, inventoryPageSize)
+		if err != nil {
+			return EntitlementPageErrorMsg{Offset: offset, Err: fmt.Errorf("failed to load more entitlements: %w", err)}
 		}
+		return EntitlementPageLoadedMsg{Offset: offset, Page: page}
 	}
 }
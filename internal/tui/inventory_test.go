@@ -0,0 +1,271 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/ags"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestInventoryModel_Update_ToggleHideEmpty(t *testing.T) {
+	model := NewInventoryModel(nil)
+	newModel, _ := model.Update(InventoryLoadedMsg{
+		Wallets: []*ags.Wallet{
+			{CurrencyCode: "GOLD", Balance: 150, Status: "ACTIVE"},
+			{CurrencyCode: "GEMS", Balance: 0, Status: "ACTIVE"},
+		},
+	})
+	model = newModel.(*InventoryModel)
+
+	if view := model.View(); !strings.Contains(view, "GEMS") {
+		t.Errorf("Expected empty wallet shown by default, got:\n%s", view)
+	}
+
+	newModel, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+	model = newModel.(*InventoryModel)
+
+	view := model.View()
+	if strings.Contains(view, "GEMS") {
+		t.Errorf("Expected empty wallet hidden, got:\n%s", view)
+	}
+	if !strings.Contains(view, "GOLD") || !strings.Contains(view, "1 empty hidden") {
+		t.Errorf("Expected GOLD and hidden count, got:\n%s", view)
+	}
+
+	newModel, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+	model = newModel.(*InventoryModel)
+	if view := model.View(); !strings.Contains(view, "GEMS") {
+		t.Errorf("Expected empty wallet shown after toggling back, got:\n%s", view)
+	}
+}
+
+func TestInventoryModel_Update_PanelsSelectIndependently(t *testing.T) {
+	model := NewInventoryModel(nil)
+	newModel, _ := model.Update(InventoryLoadedMsg{
+		Entitlements: []*ags.Entitlement{
+			{ItemID: "item_a", Status: "ACTIVE", Quantity: 1},
+			{ItemID: "item_b", Status: "ACTIVE", Quantity: 1},
+			{ItemID: "item_c", Status: "ACTIVE", Quantity: 1},
+		},
+		Wallets: []*ags.Wallet{
+			{CurrencyCode: "GOLD", Balance: 150, Status: "ACTIVE"},
+			{CurrencyCode: "GEMS", Balance: 25, Status: "ACTIVE"},
+		},
+	})
+	model = newModel.(*InventoryModel)
+
+	press := func(key tea.KeyMsg) {
+		newModel, _ := model.Update(key)
+		model = newModel.(*InventoryModel)
+	}
+
+	walletsBefore := model.renderWalletsPanel()
+
+	// Move through the (focused) entitlements panel
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	press(tea.KeyMsg{Type: tea.KeyDown})
+
+	if model.entCursor != 2 {
+		t.Errorf("Expected entitlements cursor 2, got %d", model.entCursor)
+	}
+	if model.walletCursor != 0 {
+		t.Errorf("Expected wallets cursor unchanged, got %d", model.walletCursor)
+	}
+	if got := model.renderWalletsPanel(); got != walletsBefore {
+		t.Errorf("Expected wallets panel unchanged after moving in entitlements, got:\n%s", got)
+	}
+	if ents := model.renderEntitlementsPanel(); !strings.Contains(ents, "> [ACTIVE] item_c") || !strings.Contains(ents, "3 of 3") {
+		t.Errorf("Expected item_c selected, got:\n%s", ents)
+	}
+
+	// Move in wallets; entitlements keep their own selection
+	press(tea.KeyMsg{Type: tea.KeyTab})
+	press(tea.KeyMsg{Type: tea.KeyDown})
+
+	if model.walletCursor != 1 || model.entCursor != 2 {
+		t.Errorf("Expected cursors wallets=1 entitlements=2, got wallets=%d entitlements=%d", model.walletCursor, model.entCursor)
+	}
+	if wallets := model.renderWalletsPanel(); !strings.Contains(wallets, "> GEMS: 25") || !strings.Contains(wallets, "2 of 2") {
+		t.Errorf("Expected GEMS selected, got:\n%s", wallets)
+	}
+	if ents := model.renderEntitlementsPanel(); strings.Contains(ents, "> ") {
+		t.Errorf("Expected no highlighted entitlement while unfocused, got:\n%s", ents)
+	}
+}
+
+func TestInventoryModel_Update_LoadsNextEntitlementPage(t *testing.T) {
+	verifier := ags.NewMockRewardVerifier()
+	verifier.Entitlements = make([]*ags.Entitlement, inventoryPageSize+10)
+	for i := range verifier.Entitlements {
+		verifier.Entitlements[i] = &ags.Entitlement{ItemID: fmt.Sprintf("item_%02d", i), Status: "ACTIVE", Quantity: 1}
+	}
+
+	model := NewInventoryModel(verifier)
+	newModel, _ := model.Update(model.Init()())
+	model = newModel.(*InventoryModel)
+
+	if len(model.entitlements) != inventoryPageSize {
+		t.Fatalf("Expected the first page of %d entitlements, got %d", inventoryPageSize, len(model.entitlements))
+	}
+	if view := model.View(); !strings.Contains(view, fmt.Sprintf("Showing %d of %d entitlement(s)", inventoryPageSize, inventoryPageSize+10)) {
+		t.Errorf("Expected the loaded and total counts, got:\n%s", view)
+	}
+
+	// Scrolling within the loaded page queries nothing
+	var cmd tea.Cmd
+	for i := 0; i < inventoryPageSize-1; i++ {
+		newModel, cmd = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+		model = newModel.(*InventoryModel)
+		if cmd != nil {
+			t.Fatalf("Expected no page load at entitlement %d", model.entCursor)
+		}
+	}
+
+	// Moving past the last loaded entitlement requests the next page
+	newModel, cmd = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = newModel.(*InventoryModel)
+	if cmd == nil {
+		t.Fatal("Expected the next page to be requested")
+	}
+	newModel, _ = model.Update(cmd())
+	model = newModel.(*InventoryModel)
+
+	if len(model.entitlements) != inventoryPageSize+10 || model.entHasMore {
+		t.Errorf("Expected all %d entitlements loaded, got %d (more: %v)", inventoryPageSize+10, len(model.entitlements), model.entHasMore)
+	}
+	if model.entCursor != inventoryPageSize {
+		t.Errorf("Expected the selection on the first new entitlement, got %d", model.entCursor)
+	}
+
+	// The last page is loaded, so moving past the end requests nothing
+	model.entCursor = len(model.entitlements) - 1
+	if _, cmd = model.Update(tea.KeyMsg{Type: tea.KeyDown}); cmd != nil {
+		t.Error("Expected no further page requests after the last page")
+	}
+}
+
+// pageFailingVerifier fails every entitlement page after the first while err is set
+type pageFailingVerifier struct {
+	*ags.MockRewardVerifier
+	err error
+}
+
+func (v *pageFailingVerifier) QueryUserEntitlementsPage(ctx context.Context, filters map[string]string, offset, limit int) (*ags.EntitlementPage, error) {
+	if offset > 0 && v.err != nil {
+		return nil, v.err
+	}
+	return v.MockRewardVerifier.QueryUserEntitlementsPage(ctx, filters, offset, limit)
+}
+
+func TestInventoryModel_Update_FailedPageKeepsEntitlements(t *testing.T) {
+	verifier := &pageFailingVerifier{MockRewardVerifier: ags.NewMockRewardVerifier(), err: errors.New("platform unavailable")}
+	verifier.Entitlements = make([]*ags.Entitlement, inventoryPageSize+10)
+	for i := range verifier.Entitlements {
+		verifier.Entitlements[i] = &ags.Entitlement{ItemID: fmt.Sprintf("item_%02d", i), Status: "ACTIVE", Quantity: 1}
+	}
+
+	model := NewInventoryModel(verifier)
+	newModel, _ := model.Update(model.Init()())
+	model = newModel.(*InventoryModel)
+	model.entCursor = inventoryPageSize - 1
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = newModel.(*InventoryModel)
+	if cmd == nil {
+		t.Fatal("Expected the next page to be requested")
+	}
+	newModel, _ = model.Update(cmd())
+	model = newModel.(*InventoryModel)
+
+	if model.err != nil || model.loadingMore || len(model.entitlements) != inventoryPageSize {
+		t.Fatalf("Expected the first page kept after a failed page, got err=%v loadingMore=%v entitlements=%d", model.err, model.loadingMore, len(model.entitlements))
+	}
+	view := model.View()
+	if !strings.Contains(view, "item_49") || !strings.Contains(view, "platform unavailable") {
+		t.Errorf("Expected the loaded entitlements and the page error inline, got:\n%s", view)
+	}
+
+	// Moving down again retries the page
+	verifier.err = nil
+	newModel, cmd = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model = newModel.(*InventoryModel)
+	if cmd == nil {
+		t.Fatal("Expected the failed page to be retried")
+	}
+	newModel, _ = model.Update(cmd())
+	model = newModel.(*InventoryModel)
+
+	if len(model.entitlements) != inventoryPageSize+10 || model.pageErr != nil {
+		t.Errorf("Expected all entitlements loaded and the page error cleared, got %d (%v)", len(model.entitlements), model.pageErr)
+	}
+}
+
+func TestInventoryModel_RenderEntitlementsPanel_Viewport(t *testing.T) {
+	ents := make([]*ags.Entitlement, 20)
+	for i := range ents {
+		ents[i] = &ags.Entitlement{ItemID: fmt.Sprintf("item_%02d", i), Status: "ACTIVE", Quantity: int32(i)}
+	}
+
+	model := NewInventoryModel(nil)
+	newModel, _ := model.Update(InventoryLoadedMsg{Entitlements: ents})
+	model = newModel.(*InventoryModel)
+
+	for i := 0; i < 10; i++ {
+		newModel, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+		model = newModel.(*InventoryModel)
+	}
+
+	panel := model.renderEntitlementsPanel()
+	if !strings.Contains(panel, "> [ACTIVE] item_10") {
+		t.Errorf("Expected item_10 selected and in view, got:\n%s", panel)
+	}
+	if !strings.Contains(panel, "11 of 20") {
+		t.Errorf("Expected position indicator, got:\n%s", panel)
+	}
+	if strings.Contains(panel, "item_00") || strings.Contains(panel, "item_19") {
+		t.Errorf("Expected only a window around the selection, got:\n%s", panel)
+	}
+
+	// Every rendered entry is complete (no mid-entry cut-off)
+	capacity := panelCapacity(entitlementLines)
+	if got := strings.Count(panel, "Granted:"); got != capacity {
+		t.Errorf("Expected %d complete entries, got %d:\n%s", capacity, got, panel)
+	}
+	if got := strings.Count(panel, "[ACTIVE]"); got != capacity {
+		t.Errorf("Expected %d entry headers, got %d", capacity, got)
+	}
+
+	// The panel keeps its fixed height (border adds 2 lines)
+	if got := lipgloss.Height(panel); got != inventoryPanelHeight+2 {
+		t.Errorf("Expected panel height %d, got %d:\n%s", inventoryPanelHeight+2, got, panel)
+	}
+}
+
+func TestVisibleWindow(t *testing.T) {
+	tests := []struct {
+		selected, total, capacity int
+		wantStart, wantEnd        int
+	}{
+		{selected: 0, total: 2, capacity: 5, wantStart: 0, wantEnd: 2},     // Everything fits
+		{selected: 0, total: 20, capacity: 3, wantStart: 0, wantEnd: 3},    // Top
+		{selected: 10, total: 20, capacity: 3, wantStart: 9, wantEnd: 12},  // Centered on the selection
+		{selected: 19, total: 20, capacity: 3, wantStart: 17, wantEnd: 20}, // Bottom
+	}
+
+	for _, tt := range tests {
+		start, end := visibleWindow(tt.selected, tt.total, tt.capacity)
+		if start != tt.wantStart || end != tt.wantEnd {
+			t.Errorf("visibleWindow(%d, %d, %d): expected [%d, %d), got [%d, %d)",
+				tt.selected, tt.total, tt.capacity, tt.wantStart, tt.wantEnd, start, end)
+		}
+	}
+}
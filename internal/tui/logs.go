@@ -0,0 +1,72 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package tui
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/api"
+)
+
+// slogHook forwards AccelByte SDK (logrus) entries to slog.Default()
+type slogHook struct{}
+
+// Levels implements logrus.Hook
+func (slogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire implements logrus.Hook
+func (slogHook) Fire(entry *logrus.Entry) error {
+	args := make([]any, 0, len(entry.Data)*2)
+	for key, value := range entry.Data {
+		args = append(args, key, value)
+	}
+
+	ctx := entry.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	slog.Default().Log(ctx, slogLevel(entry.Level), entry.Message, args...)
+	return nil
+}
+
+// slogLevel maps a logrus level to the matching slog level
+func slogLevel(level logrus.Level) slog.Level {
+	switch level {
+	case logrus.TraceLevel:
+		return api.LevelTrace
+	case logrus.DebugLevel:
+		return slog.LevelDebug
+	case logrus.InfoLevel:
+		return slog.LevelInfo
+	case logrus.WarnLevel:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
+
+// redirectSDKLogs routes the SDK's logrus output through slog while the TUI runs
+// logrus writes straight to stderr, over the alt-screen; through slog it follows --log-level
+// and --log-file like the container's own logs (the standard log package is already bridged
+// by slog.SetDefault). The returned func restores the previous output and hooks.
+func redirectSDKLogs() (restore func()) {
+	logger := logrus.StandardLogger()
+	previousOut := logger.Out
+	previousHooks := logger.ReplaceHooks(logrus.LevelHooks{})
+
+	logger.SetOutput(io.Discard)
+	logger.AddHook(slogHook{})
+
+	return func() {
+		logger.SetOutput(previousOut)
+		logger.ReplaceHooks(previousHooks)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 AccelByte Inc. All Rights Reserved.
+// This is licensed software from AccelByte Inc, for limitations
+// and restrictions contact your company contract manager.
+
+package tui
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestRedirectSDKLogs(t *testing.T) {
+	previous := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	var logged bytes.Buffer
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logged, &slog.HandlerOptions{Level: slog.LevelWarn})))
+
+	var sdkOut bytes.Buffer
+	previousOut := logrus.StandardLogger().Out
+	logrus.SetOutput(&sdkOut)
+	t.Cleanup(func() { logrus.SetOutput(previousOut) })
+
+	restore := redirectSDKLogs()
+	logrus.WithField("attempt", 2).Warn("Retrying request")
+	logrus.Info("LogRequest")
+	restore()
+
+	if sdkOut.Len() != 0 {
+		t.Errorf("Expected no direct SDK output while redirected, got %q", sdkOut.String())
+	}
+	if !strings.Contains(logged.String(), "level=WARN") || !strings.Contains(logged.String(), "attempt=2") {
+		t.Errorf("Expected the SDK warning logged through slog, got %q", logged.String())
+	}
+	if strings.Contains(logged.String(), "LogRequest") {
+		t.Errorf("Expected SDK info below the slog level dropped, got %q", logged.String())
+	}
+
+	// Restored: logrus writes to its own output again
+	logrus.Warn("after the TUI")
+	if !strings.Contains(sdkOut.String(), "after the TUI") {
+		t.Errorf("Expected logrus output restored, got %q", sdkOut.String())
+	}
+}
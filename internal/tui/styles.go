@@ -4,7 +4,40 @@
 
 package tui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/cli/output"
+	"github.com/AccelByte/extend-challenge/extend-challenge-demo-app/internal/glyph"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ProgressBarTheme defines the runes and default width used to draw progress bars
+type ProgressBarTheme struct {
+	Fill  rune // Rune for the completed portion
+	Empty rune // Rune for the remaining portion
+	Width int  // Default width in runes
+}
+
+var (
+	// UnicodeProgressBar draws bars with block characters
+	UnicodeProgressBar = ProgressBarTheme{Fill: glyph.Unicode.ProgressFill, Empty: glyph.Unicode.ProgressEmpty, Width: output.DefaultProgressBarWidth}
+
+	// ASCIIProgressBar draws bars for terminals without Unicode support
+	ASCIIProgressBar = ProgressBarTheme{Fill: glyph.ASCII.ProgressFill, Empty: glyph.ASCII.ProgressEmpty, Width: output.DefaultProgressBarWidth}
+)
+
+// DefaultProgressBarTheme returns the progress bar theme for the active glyph set
+func DefaultProgressBarTheme() ProgressBarTheme {
+	g := glyph.Current()
+	return ProgressBarTheme{Fill: g.ProgressFill, Empty: g.ProgressEmpty, Width: output.DefaultProgressBarWidth}
+}
+
+// panelBorder returns the border for boxed panels (ASCII when the ASCII glyph set is active)
+func panelBorder() lipgloss.Border {
+	if glyph.IsASCII() {
+		return lipgloss.ASCIIBorder()
+	}
+	return lipgloss.RoundedBorder()
+}
 
 var (
 	// Colors
@@ -88,4 +121,9 @@ var (
 	highlightStyle = lipgloss.NewStyle().
 			Foreground(warningColor).
 			Bold(true)
+
+	// Claim preview box style (border is applied at render time, see panelBorder)
+	claimPreviewStyle = lipgloss.NewStyle().
+				BorderForeground(warningColor).
+				Padding(1, 2)
 )